@@ -11,6 +11,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DatadogDashboardAdoptIDAnnotationKey is the annotation holding the ID of an existing Datadog dashboard for the
+// DatadogDashboard to adopt. The dashboard is then updated from the DatadogDashboard spec instead of being created.
+const DatadogDashboardAdoptIDAnnotationKey = "datadoghq.com/adopt-dashboard-id"
+
 // DatadogDashboardSpec defines the desired state of DatadogDashboard
 // +k8s:openapi-gen=true
 type DatadogDashboardSpec struct {
@@ -44,9 +48,290 @@ type DatadogDashboardSpec struct {
 	TemplateVariables []DashboardTemplateVariable `json:"templateVariables,omitempty"`
 	// Title is the title of the dashboard.
 	Title string `json:"title,omitempty"`
-	// Widgets is a JSON string representation of a list of Datadog API Widgets
+	// TypedWidgets is the list of widgets of the dashboard, validated when the DatadogDashboard is applied.
+	// +listType=atomic
+	// +optional
+	TypedWidgets []DashboardWidget `json:"typedWidgets,omitempty"`
+	// Widgets is a JSON string representation of a list of Datadog API Widgets, for the widgets that aren't
+	// supported by TypedWidgets. They are added to the dashboard after the typed widgets.
 	// +optional
 	Widgets string `json:"widgets,omitempty"`
+	// ConfigMapRef references a ConfigMap key, in the same namespace, containing the JSON of an exported Datadog dashboard.
+	// The dashboard is used as a template: the other fields of the spec, when set, override those of the exported dashboard.
+	// +optional
+	ConfigMapRef *DashboardConfigMapReference `json:"configMapRef,omitempty"`
+	// Parameters are the custom parameters of the dashboard loaded from ConfigMapRef.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// ControllerOptions are the optional parameters in the DatadogDashboard controller.
+	// +optional
+	ControllerOptions *DatadogDashboardControllerOptions `json:"controllerOptions,omitempty"`
+	// CredentialsRef references a Secret with the Datadog credentials used to manage the dashboard, instead of those
+	// of the namespace or of the operator.
+	// +optional
+	CredentialsRef *DatadogCredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy defines what happens to the dashboard in Datadog when the custom resource is deleted: it's deleted
+	// (Delete) or kept, and marked as no longer managed by the operator at the end of its description (Orphan).
+	// Defaults to the deletion policy of the operator, Delete unless configured otherwise.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// DashboardConfigMapReference references a key of a ConfigMap containing an exported Datadog dashboard. Every string
+// of the dashboard JSON is a Go template using `[[` and `]]` as delimiters, so that it doesn't conflict with Datadog
+// template variables. The cluster name, the namespace of the DatadogDashboard and its parameters are available as
+// `.ClusterName`, `.Namespace` and `.Parameters`, for example `kube_namespace:[[ .Namespace ]]`.
+// +k8s:openapi-gen=true
+type DashboardConfigMapReference struct {
+	// Name is the name of the ConfigMap.
+	Name string `json:"name"`
+	// Key is the key of the ConfigMap containing the dashboard JSON.
+	Key string `json:"key"`
+}
+
+// DashboardWidget is a typed dashboard widget. Exactly one widget definition must be set.
+// +k8s:openapi-gen=true
+type DashboardWidget struct {
+	DashboardWidgetDefinition `json:",inline"`
+	// Group is a group widget, containing other widgets.
+	// +optional
+	Group *DashboardGroupWidgetDefinition `json:"group,omitempty"`
+	// Layout is the position and size of the widget. It's required for dashboards with the `free` layout type,
+	// or the `fixed` reflow type.
+	// +optional
+	Layout *DashboardWidgetLayout `json:"layout,omitempty"`
+}
+
+// DashboardWidgetDefinition is the definition of a widget. Exactly one of its fields must be set.
+// +k8s:openapi-gen=true
+type DashboardWidgetDefinition struct {
+	// Timeseries is a timeseries widget.
+	// +optional
+	Timeseries *DashboardTimeseriesWidgetDefinition `json:"timeseries,omitempty"`
+	// QueryValue is a query value widget.
+	// +optional
+	QueryValue *DashboardQueryValueWidgetDefinition `json:"queryValue,omitempty"`
+	// Toplist is a top list widget.
+	// +optional
+	Toplist *DashboardToplistWidgetDefinition `json:"toplist,omitempty"`
+	// Note is a note widget.
+	// +optional
+	Note *DashboardNoteWidgetDefinition `json:"note,omitempty"`
+	// SLO is an SLO widget.
+	// +optional
+	SLO *DashboardSLOWidgetDefinition `json:"slo,omitempty"`
+	// MonitorSummary is a monitor summary widget.
+	// +optional
+	MonitorSummary *DashboardMonitorSummaryWidgetDefinition `json:"monitorSummary,omitempty"`
+}
+
+// DashboardWidgetLayout is the position and size of a widget, in grid units.
+// +k8s:openapi-gen=true
+type DashboardWidgetLayout struct {
+	// X is the position of the widget on the x (horizontal) axis.
+	// +kubebuilder:validation:Minimum=0
+	X int64 `json:"x"`
+	// Y is the position of the widget on the y (vertical) axis.
+	// +kubebuilder:validation:Minimum=0
+	Y int64 `json:"y"`
+	// Width is the width of the widget.
+	// +kubebuilder:validation:Minimum=1
+	Width int64 `json:"width"`
+	// Height is the height of the widget.
+	// +kubebuilder:validation:Minimum=1
+	Height int64 `json:"height"`
+}
+
+// DashboardGroupWidgetDefinition is the definition of a group widget.
+// +k8s:openapi-gen=true
+type DashboardGroupWidgetDefinition struct {
+	// Title is the title of the group.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// BackgroundColor is the background color of the group title, for example `vivid_blue`.
+	// +optional
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// ShowTitle defines whether the title of the group is displayed.
+	// +optional
+	ShowTitle *bool `json:"showTitle,omitempty"`
+	// Widgets is the list of widgets of the group. Groups can't be nested.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Widgets []DashboardGroupWidget `json:"widgets"`
+}
+
+// DashboardGroupWidget is a widget of a group widget. Exactly one widget definition must be set.
+// +k8s:openapi-gen=true
+type DashboardGroupWidget struct {
+	DashboardWidgetDefinition `json:",inline"`
+	// Layout is the position and size of the widget in the group.
+	// +optional
+	Layout *DashboardWidgetLayout `json:"layout,omitempty"`
+}
+
+// DashboardTimeseriesWidgetDefinition is the definition of a timeseries widget.
+// +k8s:openapi-gen=true
+type DashboardTimeseriesWidgetDefinition struct {
+	// Title is the title of the widget.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// ShowLegend defines whether the legend is displayed.
+	// +optional
+	ShowLegend *bool `json:"showLegend,omitempty"`
+	// Requests is the list of queries displayed by the widget.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Requests []DashboardTimeseriesWidgetRequest `json:"requests"`
+}
+
+// DashboardTimeseriesWidgetRequest is a query of a timeseries widget.
+// +k8s:openapi-gen=true
+type DashboardTimeseriesWidgetRequest struct {
+	// Query is the metric query, for example `avg:system.cpu.user{*} by {host}`.
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+	// DisplayType is the type of display of the query: `line` (the default), `area` or `bars`.
+	// +kubebuilder:validation:Enum=line;area;bars
+	// +optional
+	DisplayType datadogV1.WidgetDisplayType `json:"displayType,omitempty"`
+}
+
+// DashboardQueryValueWidgetDefinition is the definition of a query value widget.
+// +k8s:openapi-gen=true
+type DashboardQueryValueWidgetDefinition struct {
+	// Title is the title of the widget.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// Requests is the list of queries displayed by the widget.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Requests []DashboardQueryValueWidgetRequest `json:"requests"`
+	// Precision is the number of decimals of the value.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Precision *int64 `json:"precision,omitempty"`
+	// Autoscale defines whether the unit of the value is scaled automatically.
+	// +optional
+	Autoscale *bool `json:"autoscale,omitempty"`
+	// CustomUnit is the unit displayed with the value.
+	// +optional
+	CustomUnit string `json:"customUnit,omitempty"`
+	// TextAlign is the alignment of the value: `center`, `left` or `right`.
+	// +kubebuilder:validation:Enum=center;left;right
+	// +optional
+	TextAlign datadogV1.WidgetTextAlign `json:"textAlign,omitempty"`
+}
+
+// DashboardQueryValueWidgetRequest is a query of a query value widget.
+// +k8s:openapi-gen=true
+type DashboardQueryValueWidgetRequest struct {
+	// Query is the metric query, for example `avg:system.cpu.user{*}`.
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+	// Aggregator is the aggregation of the query over the time frame: `avg`, `last`, `max`, `min` or `sum`.
+	// +kubebuilder:validation:Enum=avg;last;max;min;sum
+	// +optional
+	Aggregator datadogV1.WidgetAggregator `json:"aggregator,omitempty"`
+}
+
+// DashboardToplistWidgetDefinition is the definition of a top list widget.
+// +k8s:openapi-gen=true
+type DashboardToplistWidgetDefinition struct {
+	// Title is the title of the widget.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// Requests is the list of queries displayed by the widget.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Requests []DashboardToplistWidgetRequest `json:"requests"`
+}
+
+// DashboardToplistWidgetRequest is a query of a top list widget.
+// +k8s:openapi-gen=true
+type DashboardToplistWidgetRequest struct {
+	// Query is the metric query, for example `top(avg:system.cpu.user{*} by {host}, 10, 'mean', 'desc')`.
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+}
+
+// DashboardNoteWidgetDefinition is the definition of a note widget.
+// +k8s:openapi-gen=true
+type DashboardNoteWidgetDefinition struct {
+	// Content is the content of the note, in Markdown.
+	// +kubebuilder:validation:MinLength=1
+	Content string `json:"content"`
+	// BackgroundColor is the background color of the note, for example `yellow`.
+	// +optional
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+	// FontSize is the size of the text, for example `14`.
+	// +optional
+	FontSize string `json:"fontSize,omitempty"`
+	// TextAlign is the alignment of the text: `center`, `left` or `right`.
+	// +kubebuilder:validation:Enum=center;left;right
+	// +optional
+	TextAlign datadogV1.WidgetTextAlign `json:"textAlign,omitempty"`
+	// ShowTick defines whether the note is displayed with a tick.
+	// +optional
+	ShowTick *bool `json:"showTick,omitempty"`
+}
+
+// DashboardSLOWidgetDefinition is the definition of an SLO widget.
+// +k8s:openapi-gen=true
+type DashboardSLOWidgetDefinition struct {
+	// Title is the title of the widget.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// SLOID is the ID of the SLO displayed by the widget.
+	// +kubebuilder:validation:MinLength=1
+	SLOID string `json:"sloID"`
+	// TimeWindows is the list of time windows displayed by the widget, for example `7d` or `month_to_date`.
+	// +listType=set
+	// +optional
+	TimeWindows []DashboardWidgetTimeWindow `json:"timeWindows,omitempty"`
+	// ViewMode defines whether the overall status, the status of the groups, or both are displayed: `overall`,
+	// `component` or `both`.
+	// +kubebuilder:validation:Enum=overall;component;both
+	// +optional
+	ViewMode datadogV1.WidgetViewMode `json:"viewMode,omitempty"`
+	// ShowErrorBudget defines whether the error budget is displayed.
+	// +optional
+	ShowErrorBudget *bool `json:"showErrorBudget,omitempty"`
+}
+
+// DashboardWidgetTimeWindow is a time window of an SLO widget.
+// +kubebuilder:validation:Enum="7d";"30d";"90d";week_to_date;previous_week;month_to_date;previous_month;global_time
+type DashboardWidgetTimeWindow string
+
+// DashboardMonitorSummaryWidgetDefinition is the definition of a monitor summary widget.
+// +k8s:openapi-gen=true
+type DashboardMonitorSummaryWidgetDefinition struct {
+	// Title is the title of the widget.
+	// +optional
+	Title string `json:"title,omitempty"`
+	// Query is the monitor search query, for example `tag:team:example`.
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+	// SummaryType is the type of summary: `monitors`, `groups` or `combined`.
+	// +kubebuilder:validation:Enum=monitors;groups;combined
+	// +optional
+	SummaryType datadogV1.WidgetSummaryType `json:"summaryType,omitempty"`
+	// DisplayFormat is the format of the summary: `counts`, `countsAndList` or `list`.
+	// +kubebuilder:validation:Enum=counts;countsAndList;list
+	// +optional
+	DisplayFormat datadogV1.WidgetMonitorSummaryDisplayFormat `json:"displayFormat,omitempty"`
+	// HideZeroCounts defines whether the states without any monitor are hidden.
+	// +optional
+	HideZeroCounts *bool `json:"hideZeroCounts,omitempty"`
+}
+
+// DatadogDashboardControllerOptions defines options in the DatadogDashboard controller.
+// +k8s:openapi-gen=true
+type DatadogDashboardControllerOptions struct {
+	// DriftPolicy defines how changes made to the dashboard outside of Kubernetes are handled:
+	// reverted as soon as they are detected (enforce, the default) or only reported (observe).
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // DatadogDashboardStatus defines the observed state of DatadogDashboard
@@ -78,12 +363,16 @@ const (
 	DatadogDashboardSyncStatusOK DatadogDashboardSyncStatus = "OK"
 	// DatadogDashboardSyncStatusValidateError means there is a dashboard validation error.
 	DatadogDashboardSyncStatusValidateError DatadogDashboardSyncStatus = "error validating dashboard"
+	// DatadogDashboardSyncStatusConfigMapError means the dashboard can't be loaded from its ConfigMap.
+	DatadogDashboardSyncStatusConfigMapError DatadogDashboardSyncStatus = "error loading dashboard from ConfigMap"
 	// DatadogDashboardSyncStatusUpdateError means there is a dashboard update error.
 	DatadogDashboardSyncStatusUpdateError DatadogDashboardSyncStatus = "error updating dashboard"
 	// DatadogDashboardSyncStatusCreateError means there is an error getting the dashboard.
 	DatadogDashboardSyncStatusCreateError DatadogDashboardSyncStatus = "error creating dashboard"
 	// SyncStatusGetError means there is an error getting the monitor
 	DatadoggDashboardSyncStatusGetError DatadogDashboardSyncStatus = "error getting dashboard"
+	// DatadogDashboardSyncStatusCredentialsError means the Datadog credentials of the dashboard couldn't be loaded.
+	DatadogDashboardSyncStatusCredentialsError DatadogDashboardSyncStatus = "error loading Datadog credentials"
 )
 
 // DatadogDashboard is the Schema for the datadogdashboards API
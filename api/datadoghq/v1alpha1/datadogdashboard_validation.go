@@ -6,8 +6,13 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	utilserrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
@@ -23,5 +28,110 @@ func IsValidDatadogDashboard(spec *DatadogDashboardSpec) error {
 		errs = append(errs, fmt.Errorf("spec.LayoutType must be defined"))
 	}
 
+	for i, widget := range spec.TypedWidgets {
+		path := fmt.Sprintf("spec.TypedWidgets[%d]", i)
+		if widget.Group == nil {
+			errs = append(errs, isValidWidgetDefinition(path, &widget.DashboardWidgetDefinition)...)
+			continue
+		}
+		if widget.DashboardWidgetDefinition.count() != 0 {
+			errs = append(errs, fmt.Errorf("%s must define exactly one widget definition", path))
+		}
+		for j := range widget.Group.Widgets {
+			errs = append(errs, isValidWidgetDefinition(fmt.Sprintf("%s.Group.Widgets[%d]", path, j), &widget.Group.Widgets[j].DashboardWidgetDefinition)...)
+		}
+	}
+
+	if spec.Widgets != "" {
+		errs = append(errs, isValidRawWidgets(spec.Widgets)...)
+	}
+
 	return utilserrors.NewAggregate(errs)
 }
+
+func isValidWidgetDefinition(path string, definition *DashboardWidgetDefinition) []error {
+	if definition.count() != 1 {
+		return []error{fmt.Errorf("%s must define exactly one widget definition", path)}
+	}
+	return nil
+}
+
+// count returns the number of widget definitions that are set.
+func (d *DashboardWidgetDefinition) count() int {
+	count := 0
+	for _, set := range []bool{d.Timeseries != nil, d.QueryValue != nil, d.Toplist != nil, d.Note != nil, d.SLO != nil, d.MonitorSummary != nil} {
+		if set {
+			count++
+		}
+	}
+	return count
+}
+
+// isValidRawWidgets checks that the raw widgets are a JSON list of widgets that only use fields known by the Datadog
+// API client, so that typos are reported when the DatadogDashboard is applied rather than when it's synced.
+func isValidRawWidgets(widgets string) []error {
+	widgetList := []datadogV1.Widget{}
+	if err := json.Unmarshal([]byte(widgets), &widgetList); err != nil {
+		return []error{fmt.Errorf("spec.Widgets must be a JSON list of widgets: %w", err)}
+	}
+
+	var errs []error
+	for i := range widgetList {
+		errs = append(errs, invalidWidgetFields(fmt.Sprintf("spec.Widgets[%d]", i), reflect.ValueOf(widgetList[i]))...)
+	}
+	return errs
+}
+
+// invalidWidgetFields walks a widget parsed by the Datadog API client and returns an error for each unknown field,
+// and for each object that couldn't be parsed, an invalid enum value or an unknown widget type for instance.
+func invalidWidgetFields(path string, value reflect.Value) []error {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return invalidWidgetFields(path, value.Elem())
+	case reflect.Slice:
+		var errs []error
+		for i := 0; i < value.Len(); i++ {
+			errs = append(errs, invalidWidgetFields(fmt.Sprintf("%s[%d]", path, i), value.Index(i))...)
+		}
+		return errs
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	var errs []error
+	unparsed := false
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		switch {
+		case !field.IsExported():
+		case field.Name == "UnparsedObject":
+			unparsed = !value.Field(i).IsNil()
+		case field.Name == "AdditionalProperties":
+			keys := make([]string, 0, value.Field(i).Len())
+			for _, key := range value.Field(i).MapKeys() {
+				keys = append(keys, key.String())
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				errs = append(errs, fmt.Errorf("%s.%s is not a known field", path, key))
+			}
+		default:
+			// The members of the oneOf types, such as WidgetDefinition, don't have a JSON name
+			fieldPath := path
+			if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+				fieldPath = path + "." + name
+			}
+			errs = append(errs, invalidWidgetFields(fieldPath, value.Field(i))...)
+		}
+	}
+	// Report the invalid object itself only if none of its fields explain why it couldn't be parsed
+	if unparsed && len(errs) == 0 {
+		errs = append(errs, fmt.Errorf("%s is not valid", path))
+	}
+
+	return errs
+}
@@ -44,6 +44,66 @@ func TestIsValidDatadogDashboard(t *testing.T) {
 			spec:    missingLayoutType,
 			wantErr: "spec.LayoutType must be defined",
 		},
+		{
+			name: "valid typed widgets",
+			spec: withTypedWidgets(
+				DashboardWidget{DashboardWidgetDefinition: DashboardWidgetDefinition{Note: &DashboardNoteWidgetDefinition{Content: "note"}}},
+				DashboardWidget{Group: &DashboardGroupWidgetDefinition{Widgets: []DashboardGroupWidget{
+					{DashboardWidgetDefinition: DashboardWidgetDefinition{Toplist: &DashboardToplistWidgetDefinition{}}},
+				}}},
+			),
+		},
+		{
+			name: "typed widget without definition",
+			spec: withTypedWidgets(
+				DashboardWidget{Layout: &DashboardWidgetLayout{Width: 1, Height: 1}},
+			),
+			wantErr: "spec.TypedWidgets[0] must define exactly one widget definition",
+		},
+		{
+			name: "typed widget with several definitions",
+			spec: withTypedWidgets(
+				DashboardWidget{
+					DashboardWidgetDefinition: DashboardWidgetDefinition{Note: &DashboardNoteWidgetDefinition{Content: "note"}},
+					Group:                     &DashboardGroupWidgetDefinition{},
+				},
+			),
+			wantErr: "spec.TypedWidgets[0] must define exactly one widget definition",
+		},
+		{
+			name: "group widget with invalid widget",
+			spec: withTypedWidgets(
+				DashboardWidget{Group: &DashboardGroupWidgetDefinition{Widgets: []DashboardGroupWidget{
+					{DashboardWidgetDefinition: DashboardWidgetDefinition{Toplist: &DashboardToplistWidgetDefinition{}}},
+					{},
+				}}},
+			),
+			wantErr: "spec.TypedWidgets[0].Group.Widgets[1] must define exactly one widget definition",
+		},
+		{
+			name: "valid raw widgets",
+			spec: withRawWidgets(`[{"definition": {"type": "note", "content": "note", "text_align": "left"}, "layout": {"x": 0, "y": 0, "width": 2, "height": 2}}]`),
+		},
+		{
+			name:    "raw widgets not in JSON",
+			spec:    withRawWidgets(`{"definition": `),
+			wantErr: "spec.Widgets must be a JSON list of widgets: unexpected end of JSON input",
+		},
+		{
+			name:    "raw widgets with unknown fields",
+			spec:    withRawWidgets(`[{"definition": {"type": "note", "content": "note", "txt_align": "left"}, "sizes": {}}]`),
+			wantErr: "[spec.Widgets[0].definition.txt_align is not a known field, spec.Widgets[0].sizes is not a known field]",
+		},
+		{
+			name:    "raw widgets with unknown widget type",
+			spec:    withRawWidgets(`[{"definition": {"type": "notes", "content": "note"}}]`),
+			wantErr: "spec.Widgets[0].definition is not valid",
+		},
+		{
+			name:    "raw widgets with invalid layout",
+			spec:    withRawWidgets(`[{"definition": {"type": "note", "content": "note"}, "layout": {"x": "left"}}]`),
+			wantErr: "spec.Widgets[0].layout is not valid",
+		},
 	}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
@@ -57,3 +117,19 @@ func TestIsValidDatadogDashboard(t *testing.T) {
 		})
 	}
 }
+
+func withTypedWidgets(widgets ...DashboardWidget) *DatadogDashboardSpec {
+	return &DatadogDashboardSpec{
+		LayoutType:   datadogV1.DASHBOARDLAYOUTTYPE_ORDERED,
+		Title:        "test",
+		TypedWidgets: widgets,
+	}
+}
+
+func withRawWidgets(widgets string) *DatadogDashboardSpec {
+	return &DatadogDashboardSpec{
+		LayoutType: datadogV1.DASHBOARDLAYOUTTYPE_ORDERED,
+		Title:      "test",
+		Widgets:    widgets,
+	}
+}
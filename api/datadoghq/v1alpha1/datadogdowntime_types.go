@@ -0,0 +1,253 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogDowntimeSpec defines the desired state of a DatadogDowntime
+// +k8s:openapi-gen=true
+type DatadogDowntimeSpec struct {
+	// Scope is the Datadog scope query the downtime applies to, for example `env:(staging OR prod) AND datacenter:us-east-1`.
+	// It's combined with KubernetesScope when both are set. The downtime applies to all scopes when both are empty.
+	Scope string `json:"scope,omitempty"`
+
+	// KubernetesScope scopes the downtime to Kubernetes objects, using the tags set by the Datadog Agent.
+	KubernetesScope *DatadogDowntimeKubernetesScope `json:"kubernetesScope,omitempty"`
+
+	// MonitorTags is the list of monitor tags the downtime applies to. Monitors must have all the tags to be muted.
+	// It can't be used with MonitorRefs or MonitorSelector. The downtime applies to all monitors when they're all empty.
+	// +listType=set
+	MonitorTags []string `json:"monitorTags,omitempty"`
+
+	// MonitorRefs references the DatadogMonitors in the same namespace to mute. A downtime is created in Datadog for
+	// each monitor. It can't be used with MonitorTags.
+	// +listType=map
+	// +listMapKey=name
+	MonitorRefs []DatadogDowntimeMonitorReference `json:"monitorRefs,omitempty"`
+
+	// MonitorSelector selects the DatadogMonitors in the same namespace to mute, in addition to MonitorRefs.
+	// It must select at least one DatadogMonitor. It can't be used with MonitorTags.
+	MonitorSelector *metav1.LabelSelector `json:"monitorSelector,omitempty"`
+
+	// Message is the message to include with notifications for this downtime.
+	// Email notifications can be sent to specific users by using the same `@username` notation as events.
+	Message *string `json:"message,omitempty"`
+
+	// Schedule is the schedule of the downtime. The downtime starts immediately and never ends when it isn't set.
+	Schedule *DatadogDowntimeSchedule `json:"schedule,omitempty"`
+
+	// DisplayTimezone is the timezone in which to display the downtime's start and end times in Datadog applications.
+	// This is only used for display purposes.
+	DisplayTimezone string `json:"displayTimezone,omitempty"`
+
+	// MuteFirstRecoveryNotification mutes the first recovery notification of the monitors when the downtime ends.
+	MuteFirstRecoveryNotification *bool `json:"muteFirstRecoveryNotification,omitempty"`
+
+	// NotifyEndStates are the states that will trigger a monitor notification when the downtime ends,
+	// if the monitor is in one of them. Defaults to `alert`, `no data` and `warn`.
+	// +listType=set
+	NotifyEndStates []DatadogDowntimeNotifyEndState `json:"notifyEndStates,omitempty"`
+
+	// NotifyEndTypes are the actions that will trigger a monitor notification if the downtime is in the `notifyEndStates` states.
+	// Defaults to `expired`.
+	// +listType=set
+	NotifyEndTypes []DatadogDowntimeNotifyEndType `json:"notifyEndTypes,omitempty"`
+
+	// DeletionPolicy defines what happens to the downtime in Datadog when the custom resource is deleted: it's deleted
+	// (Delete) or kept (Orphan). Defaults to the deletion policy of the operator, Delete unless configured otherwise.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// DatadogDowntimeKubernetesScope scopes a downtime to Kubernetes objects.
+// Values of a list are combined with OR, and lists are combined with AND.
+// +k8s:openapi-gen=true
+type DatadogDowntimeKubernetesScope struct {
+	// ClusterName is the name of the cluster (`kube_cluster_name` tag).
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Namespaces is the list of namespaces (`kube_namespace` tag).
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Deployments is the list of deployments (`kube_deployment` tag).
+	// +listType=set
+	Deployments []string `json:"deployments,omitempty"`
+
+	// StatefulSets is the list of statefulsets (`kube_stateful_set` tag).
+	// +listType=set
+	StatefulSets []string `json:"statefulSets,omitempty"`
+
+	// DaemonSets is the list of daemonsets (`kube_daemon_set` tag).
+	// +listType=set
+	DaemonSets []string `json:"daemonSets,omitempty"`
+}
+
+// DatadogDowntimeMonitorReference references a DatadogMonitor.
+// +k8s:openapi-gen=true
+type DatadogDowntimeMonitorReference struct {
+	// Name is the name of the DatadogMonitor.
+	Name string `json:"name"`
+}
+
+// DatadogDowntimeSchedule defines a one-time or a recurring downtime schedule.
+// +k8s:openapi-gen=true
+type DatadogDowntimeSchedule struct {
+	// Start is the start time of a one-time downtime. The downtime starts immediately when it isn't set.
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the end time of a one-time downtime. The downtime never ends when it isn't set.
+	End *metav1.Time `json:"end,omitempty"`
+
+	// Recurrences is the list of recurrences of a recurring downtime. It can't be used with Start and End.
+	// +listType=atomic
+	Recurrences []DatadogDowntimeRecurrence `json:"recurrences,omitempty"`
+
+	// Timezone is the timezone in which the recurrences are evaluated, for example `Europe/Paris`. Defaults to `UTC`.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// DatadogDowntimeRecurrence defines a recurrence of a downtime.
+// +k8s:openapi-gen=true
+type DatadogDowntimeRecurrence struct {
+	// Duration is the length of the downtime, for example `1h` or `2d`.
+	Duration string `json:"duration"`
+
+	// Rrule is the recurrence rule of the downtime, as defined by RFC 5545 (only `FREQ`, `INTERVAL`, `COUNT`, `UNTIL` and `BY*` are supported),
+	// for example `FREQ=WEEKLY;BYDAY=SA,SU`.
+	Rrule string `json:"rrule"`
+
+	// Start is the first start of the recurrence in the `YYYY-MM-DDThh:mm` format, in the schedule timezone.
+	// The recurrence starts immediately when it isn't set.
+	Start string `json:"start,omitempty"`
+}
+
+// DatadogDowntimeNotifyEndState is a monitor state that can trigger a notification when a downtime ends.
+type DatadogDowntimeNotifyEndState string
+
+const (
+	// DatadogDowntimeNotifyEndStateAlert the monitor is in the alert state.
+	DatadogDowntimeNotifyEndStateAlert DatadogDowntimeNotifyEndState = "alert"
+	// DatadogDowntimeNotifyEndStateNoData the monitor is in the no data state.
+	DatadogDowntimeNotifyEndStateNoData DatadogDowntimeNotifyEndState = "no data"
+	// DatadogDowntimeNotifyEndStateWarn the monitor is in the warn state.
+	DatadogDowntimeNotifyEndStateWarn DatadogDowntimeNotifyEndState = "warn"
+)
+
+// DatadogDowntimeNotifyEndType is a downtime action that can trigger a notification when a downtime ends.
+type DatadogDowntimeNotifyEndType string
+
+const (
+	// DatadogDowntimeNotifyEndTypeCanceled the downtime is canceled.
+	DatadogDowntimeNotifyEndTypeCanceled DatadogDowntimeNotifyEndType = "canceled"
+	// DatadogDowntimeNotifyEndTypeExpired the downtime expires.
+	DatadogDowntimeNotifyEndTypeExpired DatadogDowntimeNotifyEndType = "expired"
+)
+
+// DatadogDowntimeStatus defines the observed state of a DatadogDowntime
+// +k8s:openapi-gen=true
+type DatadogDowntimeStatus struct {
+	// Conditions represents the latest available observations of the state of a DatadogDowntime.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the downtime ID generated in Datadog. When the downtime mutes DatadogMonitors, it's the comma-separated
+	// list of the IDs of the downtimes of each monitor, in the order of MonitorIDs.
+	ID string `json:"id,omitempty"`
+
+	// Created is the time the downtime was created.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// MonitorIDs are the sorted IDs of the monitors referenced by MonitorRefs and selected by MonitorSelector.
+	// +listType=atomic
+	MonitorIDs []int64 `json:"monitorIDs,omitempty"`
+
+	// DowntimeState is the state of the downtime in Datadog.
+	DowntimeState DatadogDowntimeState `json:"downtimeState,omitempty"`
+
+	// CurrentStart is the start of the current (or next) downtime.
+	CurrentStart *metav1.Time `json:"currentStart,omitempty"`
+
+	// CurrentEnd is the end of the current (or next) downtime.
+	CurrentEnd *metav1.Time `json:"currentEnd,omitempty"`
+
+	// SyncStatus shows the health of syncing the downtime state to Datadog.
+	SyncStatus DatadogDowntimeSyncStatus `json:"syncStatus,omitempty"`
+
+	// LastForceSyncTime is the last time the API downtime was last force synced with the DatadogDowntime resource.
+	LastForceSyncTime *metav1.Time `json:"lastForceSyncTime,omitempty"`
+
+	// CurrentHash tracks the hash of the current DatadogDowntimeSpec to know
+	// if the Spec has changed and needs an update.
+	CurrentHash string `json:"currentHash,omitempty"`
+}
+
+// DatadogDowntimeState is the state of a downtime in Datadog.
+type DatadogDowntimeState string
+
+const (
+	// DatadogDowntimeStateScheduled means the downtime hasn't started yet.
+	DatadogDowntimeStateScheduled DatadogDowntimeState = "Scheduled"
+	// DatadogDowntimeStateActive means the downtime is muting monitors.
+	DatadogDowntimeStateActive DatadogDowntimeState = "Active"
+	// DatadogDowntimeStateExpired means the downtime has ended.
+	DatadogDowntimeStateExpired DatadogDowntimeState = "Expired"
+	// DatadogDowntimeStateCanceled means the downtime was canceled.
+	DatadogDowntimeStateCanceled DatadogDowntimeState = "Canceled"
+)
+
+// DatadogDowntimeSyncStatus is the message reflecting the health of downtime state syncs to Datadog.
+type DatadogDowntimeSyncStatus string
+
+const (
+	// DatadogDowntimeSyncStatusOK means syncing is OK.
+	DatadogDowntimeSyncStatusOK DatadogDowntimeSyncStatus = "OK"
+	// DatadogDowntimeSyncStatusValidateError means there is a downtime validation error.
+	DatadogDowntimeSyncStatusValidateError DatadogDowntimeSyncStatus = "error validating downtime"
+	// DatadogDowntimeSyncStatusMonitorRefError means the referenced DatadogMonitors can't be resolved.
+	DatadogDowntimeSyncStatusMonitorRefError DatadogDowntimeSyncStatus = "error resolving monitor references"
+	// DatadogDowntimeSyncStatusUpdateError means there is a downtime update error.
+	DatadogDowntimeSyncStatusUpdateError DatadogDowntimeSyncStatus = "error updating downtime"
+	// DatadogDowntimeSyncStatusCreateError means there is an error creating the downtime.
+	DatadogDowntimeSyncStatusCreateError DatadogDowntimeSyncStatus = "error creating downtime"
+	// DatadogDowntimeSyncStatusGetError means there is an error getting the downtime.
+	DatadogDowntimeSyncStatusGetError DatadogDowntimeSyncStatus = "error getting downtime"
+)
+
+// DatadogDowntime allows to define and manage Datadog downtimes from your Kubernetes Cluster.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=datadogdowntimes,scope=Namespaced,shortName=dddt
+// +kubebuilder:printcolumn:name="id",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".status.downtimeState"
+// +kubebuilder:printcolumn:name="start",type="date",JSONPath=".status.currentStart"
+// +kubebuilder:printcolumn:name="end",type="date",JSONPath=".status.currentEnd"
+// +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogDowntime struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogDowntimeSpec   `json:"spec,omitempty"`
+	Status DatadogDowntimeStatus `json:"status,omitempty"`
+}
+
+// DatadogDowntimeList contains a list of DatadogDowntimes.
+// +kubebuilder:object:root=true
+type DatadogDowntimeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogDowntime `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogDowntime{}, &DatadogDowntimeList{})
+}
@@ -0,0 +1,71 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// IsValidDatadogDowntime use to check if a DatadogDowntimeSpec is valid by checking
+// that the required fields are defined and that exclusive fields aren't combined
+func IsValidDatadogDowntime(spec *DatadogDowntimeSpec) error {
+	var errs []error
+
+	if len(spec.MonitorTags) > 0 && (len(spec.MonitorRefs) > 0 || spec.MonitorSelector != nil) {
+		errs = append(errs, fmt.Errorf("spec.MonitorTags can't be used with spec.MonitorRefs or spec.MonitorSelector"))
+	}
+
+	for i, ref := range spec.MonitorRefs {
+		if ref.Name == "" {
+			errs = append(errs, fmt.Errorf("spec.MonitorRefs[%d].Name must be defined", i))
+		}
+	}
+
+	if spec.MonitorSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.MonitorSelector); err != nil {
+			errs = append(errs, fmt.Errorf("spec.MonitorSelector is invalid: %w", err))
+		}
+	}
+
+	if spec.Schedule != nil {
+		schedule := spec.Schedule
+		if len(schedule.Recurrences) > 0 && (schedule.Start != nil || schedule.End != nil) {
+			errs = append(errs, fmt.Errorf("spec.Schedule.Recurrences can't be used with spec.Schedule.Start or spec.Schedule.End"))
+		}
+		if schedule.Start != nil && schedule.End != nil && !schedule.End.After(schedule.Start.Time) {
+			errs = append(errs, fmt.Errorf("spec.Schedule.End must be after spec.Schedule.Start"))
+		}
+		for i, recurrence := range schedule.Recurrences {
+			if recurrence.Duration == "" {
+				errs = append(errs, fmt.Errorf("spec.Schedule.Recurrences[%d].Duration must be defined", i))
+			}
+			if recurrence.Rrule == "" {
+				errs = append(errs, fmt.Errorf("spec.Schedule.Recurrences[%d].Rrule must be defined", i))
+			}
+		}
+	}
+
+	for _, state := range spec.NotifyEndStates {
+		switch state {
+		case DatadogDowntimeNotifyEndStateAlert, DatadogDowntimeNotifyEndStateNoData, DatadogDowntimeNotifyEndStateWarn:
+		default:
+			errs = append(errs, fmt.Errorf("spec.NotifyEndStates must be one of the values: %s, %s or %s", DatadogDowntimeNotifyEndStateAlert, DatadogDowntimeNotifyEndStateNoData, DatadogDowntimeNotifyEndStateWarn))
+		}
+	}
+
+	for _, endType := range spec.NotifyEndTypes {
+		switch endType {
+		case DatadogDowntimeNotifyEndTypeCanceled, DatadogDowntimeNotifyEndTypeExpired:
+		default:
+			errs = append(errs, fmt.Errorf("spec.NotifyEndTypes must be one of the values: %s or %s", DatadogDowntimeNotifyEndTypeCanceled, DatadogDowntimeNotifyEndTypeExpired))
+		}
+	}
+
+	return utilserrors.NewAggregate(errs)
+}
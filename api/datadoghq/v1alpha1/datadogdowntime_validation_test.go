@@ -0,0 +1,145 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+func TestIsValidDatadogDowntime(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		spec     *DatadogDowntimeSpec
+		expected error
+	}{
+		{
+			name:     "Valid empty spec",
+			spec:     &DatadogDowntimeSpec{},
+			expected: nil,
+		},
+		{
+			name: "Valid one-time downtime",
+			spec: &DatadogDowntimeSpec{
+				Scope:       "env:prod",
+				MonitorTags: []string{"team:foo"},
+				Schedule: &DatadogDowntimeSchedule{
+					Start: &start,
+					End:   &end,
+				},
+				NotifyEndStates: []DatadogDowntimeNotifyEndState{DatadogDowntimeNotifyEndStateAlert, DatadogDowntimeNotifyEndStateNoData},
+				NotifyEndTypes:  []DatadogDowntimeNotifyEndType{DatadogDowntimeNotifyEndTypeExpired},
+			},
+			expected: nil,
+		},
+		{
+			name: "Valid recurring downtime",
+			spec: &DatadogDowntimeSpec{
+				MonitorRefs: []DatadogDowntimeMonitorReference{{Name: "my-monitor"}},
+				MonitorSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "foo"},
+				},
+				Schedule: &DatadogDowntimeSchedule{
+					Recurrences: []DatadogDowntimeRecurrence{
+						{Duration: "2h", Rrule: "FREQ=WEEKLY;BYDAY=SA,SU"},
+					},
+					Timezone: "Europe/Paris",
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "MonitorTags and MonitorRefs",
+			spec: &DatadogDowntimeSpec{
+				MonitorTags: []string{"team:foo"},
+				MonitorRefs: []DatadogDowntimeMonitorReference{{Name: "my-monitor"}},
+			},
+			expected: errors.New("spec.MonitorTags can't be used with spec.MonitorRefs or spec.MonitorSelector"),
+		},
+		{
+			name: "MonitorTags and MonitorSelector",
+			spec: &DatadogDowntimeSpec{
+				MonitorTags:     []string{"team:foo"},
+				MonitorSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}},
+			},
+			expected: errors.New("spec.MonitorTags can't be used with spec.MonitorRefs or spec.MonitorSelector"),
+		},
+		{
+			name: "Missing MonitorRefs name",
+			spec: &DatadogDowntimeSpec{
+				MonitorRefs: []DatadogDowntimeMonitorReference{{Name: "my-monitor"}, {}},
+			},
+			expected: errors.New("spec.MonitorRefs[1].Name must be defined"),
+		},
+		{
+			name: "Invalid MonitorSelector",
+			spec: &DatadogDowntimeSpec{
+				MonitorSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Foo"}},
+				},
+			},
+			expected: errors.New(`spec.MonitorSelector is invalid: "Foo" is not a valid label selector operator`),
+		},
+		{
+			name: "End before start",
+			spec: &DatadogDowntimeSpec{
+				Schedule: &DatadogDowntimeSchedule{
+					Start: &end,
+					End:   &start,
+				},
+			},
+			expected: errors.New("spec.Schedule.End must be after spec.Schedule.Start"),
+		},
+		{
+			name: "Recurrences with one-time schedule and missing fields",
+			spec: &DatadogDowntimeSpec{
+				Schedule: &DatadogDowntimeSchedule{
+					Start:       &start,
+					Recurrences: []DatadogDowntimeRecurrence{{}},
+				},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Schedule.Recurrences can't be used with spec.Schedule.Start or spec.Schedule.End"),
+					errors.New("spec.Schedule.Recurrences[0].Duration must be defined"),
+					errors.New("spec.Schedule.Recurrences[0].Rrule must be defined"),
+				},
+			),
+		},
+		{
+			name: "Invalid notify end states and types",
+			spec: &DatadogDowntimeSpec{
+				NotifyEndStates: []DatadogDowntimeNotifyEndState{"ok"},
+				NotifyEndTypes:  []DatadogDowntimeNotifyEndType{"deleted"},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.NotifyEndStates must be one of the values: alert, no data or warn"),
+					errors.New("spec.NotifyEndTypes must be one of the values: canceled or expired"),
+				},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidDatadogDowntime(tt.spec)
+			if tt.expected != nil {
+				assert.EqualError(t, result, tt.expected.Error())
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
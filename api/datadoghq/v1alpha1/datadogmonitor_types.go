@@ -11,6 +11,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DatadogMonitorAdoptIDAnnotationKey is the annotation holding the ID of an existing Datadog monitor for the
+// DatadogMonitor to adopt. The monitor is then updated from the DatadogMonitor spec instead of being created.
+const DatadogMonitorAdoptIDAnnotationKey = "datadoghq.com/adopt-monitor-id"
+
 // DatadogMonitorSpec defines the desired state of DatadogMonitor
 // +k8s:openapi-gen=true
 type DatadogMonitorSpec struct {
@@ -20,7 +24,9 @@ type DatadogMonitorSpec struct {
 	Message string `json:"message,omitempty"`
 	// Priority is an integer from 1 (high) to 5 (low) indicating alert severity
 	Priority int64 `json:"priority,omitempty"`
-	// Query is the Datadog monitor query
+	// Query is the Datadog monitor query. For composite monitors, the query can reference other
+	// DatadogMonitors in the same namespace by name, for example `monitor-a && !monitor-b`,
+	// in addition to Datadog monitor IDs.
 	Query string `json:"query,omitempty"`
 	// RestrictedRoles is a list of unique role identifiers to define which roles are allowed to edit the monitor.
 	// `restricted_roles` is the successor of `locked`. For more information about `locked` and `restricted_roles`,
@@ -37,6 +43,45 @@ type DatadogMonitorSpec struct {
 
 	// ControllerOptions are the optional parameters in the DatadogMonitor controller
 	ControllerOptions DatadogMonitorControllerOptions `json:"controllerOptions,omitempty"`
+
+	// CredentialsRef references a Secret with the Datadog credentials used to manage the monitor, instead of those
+	// of the namespace or of the operator.
+	// +optional
+	CredentialsRef *DatadogCredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy defines what happens to the monitor in Datadog when the custom resource is deleted: it's deleted
+	// (Delete) or kept, and tagged as no longer managed by the operator (Orphan). Defaults to the deletion policy of
+	// the operator, Delete unless configured otherwise.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// RolloutMute mutes the monitor with a downtime while workloads of its namespace roll out.
+	// +optional
+	RolloutMute *DatadogMonitorRolloutMute `json:"rolloutMute,omitempty"`
+}
+
+// DatadogMonitorRolloutMute mutes a monitor while workloads, in the namespace of the DatadogMonitor, roll out. A
+// downtime scoped to the workloads in rollout is created when a rollout starts, and canceled once all the rollouts
+// complete.
+// +k8s:openapi-gen=true
+type DatadogMonitorRolloutMute struct {
+	// Deployments are the names of the Deployments whose rollouts mute the monitor.
+	// +listType=set
+	// +optional
+	Deployments []string `json:"deployments,omitempty"`
+	// StatefulSets are the names of the StatefulSets whose rollouts mute the monitor.
+	// +listType=set
+	// +optional
+	StatefulSets []string `json:"statefulSets,omitempty"`
+	// LabelSelector selects the Deployments and StatefulSets whose rollouts mute the monitor, in addition to the named
+	// ones.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Scope is the scope of the downtime, as a Datadog tag query. Defaults to the namespace and the workloads in
+	// rollout, for example `kube_namespace:default AND kube_deployment:web`, which requires the monitor to be grouped
+	// by these tags. Set it to `*` to mute all the groups of the monitor.
+	// +optional
+	Scope string `json:"scope,omitempty"`
 }
 
 // DatadogMonitorType defines the type of monitor
@@ -67,6 +112,18 @@ const (
 	DatadogMonitorTypeAudit DatadogMonitorType = "audit alert"
 	// DatadogMonitorTypeComposite is the composite alert monitor type
 	DatadogMonitorTypeComposite DatadogMonitorType = "composite"
+	// DatadogMonitorTypeSynthetics is the synthetics alert monitor type
+	DatadogMonitorTypeSynthetics DatadogMonitorType = "synthetics alert"
+	// DatadogMonitorTypeCIPipelines is the ci-pipelines alert monitor type
+	DatadogMonitorTypeCIPipelines DatadogMonitorType = "ci-pipelines alert"
+	// DatadogMonitorTypeCITests is the ci-tests alert monitor type
+	DatadogMonitorTypeCITests DatadogMonitorType = "ci-tests alert"
+	// DatadogMonitorTypeDatabaseMonitoring is the database-monitoring alert monitor type
+	DatadogMonitorTypeDatabaseMonitoring DatadogMonitorType = "database-monitoring alert"
+	// DatadogMonitorTypeErrorTracking is the error-tracking alert monitor type
+	DatadogMonitorTypeErrorTracking DatadogMonitorType = "error-tracking alert"
+	// DatadogMonitorTypeCost is the cost alert monitor type
+	DatadogMonitorTypeCost DatadogMonitorType = "cost alert"
 )
 
 // DatadogMonitorOptionsNotificationPreset toggles the display of additional content sent in the monitor notification.
@@ -173,6 +230,57 @@ type DatadogMonitorOptionsThresholdWindows struct {
 type DatadogMonitorControllerOptions struct {
 	// DisableRequiredTags disables the automatic addition of required tags to monitors.
 	DisableRequiredTags *bool `json:"disableRequiredTags,omitempty"`
+	// DriftPolicy defines how changes made to the monitor outside of Kubernetes, in the Datadog UI for instance,
+	// are handled: reverted as soon as they are detected (enforce, the default) or only reported (observe).
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+}
+
+// DriftPolicy defines how the controllers handle the Datadog objects that differ from their spec.
+// +kubebuilder:validation:Enum=enforce;observe
+type DriftPolicy string
+
+const (
+	// DriftPolicyEnforce reverts the changes made outside of Kubernetes as soon as they are detected.
+	DriftPolicyEnforce DriftPolicy = "enforce"
+	// DriftPolicyObserve only reports the changes made outside of Kubernetes, in a Drifted condition and an event.
+	DriftPolicyObserve DriftPolicy = "observe"
+)
+
+// DeletionPolicy defines what happens to a Datadog object when its custom resource is deleted.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the Datadog object.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan keeps the Datadog object, and tags it as no longer managed by the operator when it has
+	// tags.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+// DatadogCredentialsSecretAnnotationKey is the annotation of a namespace holding the name of a Secret, in the same
+// namespace, with the Datadog credentials used to manage the Datadog objects of the namespace. The Secret has the
+// same keys as the one referenced by a DatadogCredentialsReference.
+const DatadogCredentialsSecretAnnotationKey = "datadoghq.com/credentials-secret"
+
+// ForceSyncAnnotationKey is the annotation requesting an immediate force sync of a DatadogMonitor, DatadogSLO,
+// DatadogDashboard, DatadogSyntheticTest, DatadogDowntime or DatadogSLOCorrection with its Datadog object. Its value
+// is the time of the request in the RFC 3339 format: the sync is forced when it's more recent than the last force sync,
+// even with the observe drift policy.
+const ForceSyncAnnotationKey = "datadoghq.com/force-sync"
+
+// DatadogCredentialsReference references a Secret, in the same namespace, with the Datadog credentials used to
+// manage a Datadog object. The Secret has the `api_key` and `app_key` keys, and optionally the `site` key with the
+// Datadog site of the organization, for example `datadoghq.eu`. The site of the operator is used by default.
+//
+// When the credentials change, the Datadog object is created again with the new credentials; the one created with the
+// previous credentials isn't deleted.
+// +k8s:openapi-gen=true
+type DatadogCredentialsReference struct {
+	// SecretName is the name of the Secret.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
 }
 
 // DatadogMonitorStatus defines the observed state of DatadogMonitor
@@ -199,7 +307,8 @@ type DatadogMonitorStatus struct {
 	MonitorStateLastTransitionTime *metav1.Time `json:"monitorStateLastTransitionTime,omitempty"`
 	// MonitorStateSyncStatus shows the health of syncing the monitor state to Datadog
 	MonitorStateSyncStatus MonitorStateSyncStatusMessage `json:"monitorStateSyncStatus,omitempty"`
-	// TriggeredState only includes details for monitor groups that are triggering
+	// TriggeredState only includes details for monitor groups that are triggering, up to 10 groups. The state of all
+	// the groups is in the DatadogMonitorGroups object with the same name.
 	// +listType=map
 	// +listMapKey=monitorGroup
 	TriggeredState []DatadogMonitorTriggeredState `json:"triggeredState,omitempty"`
@@ -248,6 +357,8 @@ const (
 	DatadogMonitorConditionTypeUpdated DatadogMonitorConditionType = "Updated"
 	// DatadogMonitorConditionTypeError means the DatadogMonitor has an error
 	DatadogMonitorConditionTypeError DatadogMonitorConditionType = "Error"
+	// DatadogMonitorConditionTypeDrifted means the monitor in Datadog differs from the DatadogMonitor spec
+	DatadogMonitorConditionTypeDrifted DatadogMonitorConditionType = "Drifted"
 )
 
 // DatadogMonitorState represents the overall DatadogMonitor state
@@ -270,6 +381,26 @@ const (
 	DatadogMonitorStateUnknown DatadogMonitorState = "Unknown"
 )
 
+// Severity returns the severity of the state, from 0 for Alert to 6 for OK, to sort the monitor groups.
+func (s DatadogMonitorState) Severity() int {
+	switch s {
+	case DatadogMonitorStateAlert:
+		return 0
+	case DatadogMonitorStateWarn:
+		return 1
+	case DatadogMonitorStateNoData:
+		return 2
+	case DatadogMonitorStateSkipped:
+		return 4
+	case DatadogMonitorStateIgnored:
+		return 5
+	case DatadogMonitorStateOK:
+		return 6
+	default:
+		return 3
+	}
+}
+
 // DatadogMonitorOptionsOnMissingData controls how groups or monitors are treated if an evaluation does not return any data points
 type DatadogMonitorOptionsOnMissingData string
 
@@ -292,6 +423,10 @@ const (
 	MonitorStateSyncStatusUpdateError MonitorStateSyncStatusMessage = "error updating monitor"
 	// SyncStatusGetError means there is an error getting the monitor
 	MonitorStateSyncStatusGetError MonitorStateSyncStatusMessage = "error getting monitor"
+	// MonitorStateSyncStatusCompositeRefError means a monitor referenced by a composite monitor can't be resolved
+	MonitorStateSyncStatusCompositeRefError MonitorStateSyncStatusMessage = "error resolving composite monitor references"
+	// MonitorStateSyncStatusCredentialsError means the Datadog credentials of the monitor couldn't be loaded
+	MonitorStateSyncStatusCredentialsError MonitorStateSyncStatusMessage = "error loading Datadog credentials"
 )
 
 // DatadogMonitorTriggeredState represents the details of a triggering DatadogMonitor
@@ -311,6 +446,14 @@ type DatadogMonitorDowntimeStatus struct {
 	IsDowntimed bool `json:"isDowntimed,omitempty"`
 	// DowntimeID is the downtime ID.
 	DowntimeID int `json:"downtimeID,omitempty"`
+	// RolloutDowntimeID is the ID of the downtime muting the monitor while the workloads of RolloutWorkloads roll
+	// out, see DatadogMonitorRolloutMute.
+	RolloutDowntimeID string `json:"rolloutDowntimeID,omitempty"`
+	// RolloutWorkloads are the workloads in rollout muting the monitor, as `<kind>/<name>`.
+	// +listType=set
+	RolloutWorkloads []string `json:"rolloutWorkloads,omitempty"`
+	// RolloutDowntimeEnd is the end of the downtime muting the monitor during rollouts, extended while they continue.
+	RolloutDowntimeEnd *metav1.Time `json:"rolloutDowntimeEnd,omitempty"`
 }
 
 // DatadogMonitor allows to define and manage Monitors from your Kubernetes Cluster
@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogMonitorGroup represents the state of a group of a DatadogMonitor.
+// +k8s:openapi-gen=true
+type DatadogMonitorGroup struct {
+	// Name is the name of the group, for example `host:foo,env:prod`.
+	Name string `json:"name"`
+	// State is the state of the group.
+	State DatadogMonitorState `json:"state,omitempty"`
+	// LastTransitionTime is the last time the group triggered, resolved or stopped reporting data.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// SortDatadogMonitorGroupsBySeverity sorts monitor groups from the most to the least severe state, then from the most
+// recent to the oldest transition, then by name.
+func SortDatadogMonitorGroupsBySeverity(groups []DatadogMonitorGroup) {
+	sort.SliceStable(groups, func(i, j int) bool {
+		if si, sj := groups[i].State.Severity(), groups[j].State.Severity(); si != sj {
+			return si < sj
+		}
+		if !groups[i].LastTransitionTime.Equal(&groups[j].LastTransitionTime) {
+			return groups[j].LastTransitionTime.Before(&groups[i].LastTransitionTime)
+		}
+		return groups[i].Name < groups[j].Name
+	})
+}
+
+// DatadogMonitorGroups holds the state of the groups that aren't OK of the monitor of the DatadogMonitor with the
+// same name, while the TriggeredState of the DatadogMonitor status is capped. It's created and updated by the
+// DatadogMonitor controller, and deleted with the DatadogMonitor.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=datadogmonitorgroups,scope=Namespaced,shortName=ddmg
+// +kubebuilder:printcolumn:name="id",type="integer",JSONPath=".monitorID"
+// +kubebuilder:printcolumn:name="groups",type="integer",JSONPath=".groupCount"
+// +kubebuilder:printcolumn:name="triggered",type="integer",JSONPath=".triggeredCount"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogMonitorGroups struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// MonitorID is the ID of the monitor in Datadog.
+	MonitorID int `json:"monitorID,omitempty"`
+	// GroupCount is the number of groups of the monitor, including the OK groups that aren't stored in Groups.
+	GroupCount int `json:"groupCount,omitempty"`
+	// TriggeredCount is the number of groups in Alert, Warn or No Data.
+	TriggeredCount int `json:"triggeredCount,omitempty"`
+	// Groups are the groups of the monitor that aren't OK, sorted by name. When the monitor has too many of them, only
+	// the most severe ones are kept. The transition times aren't updated when only they change.
+	// +listType=map
+	// +listMapKey=name
+	Groups []DatadogMonitorGroup `json:"groups,omitempty"`
+}
+
+// DatadogMonitorGroupsList contains a list of DatadogMonitorGroups.
+// +kubebuilder:object:root=true
+type DatadogMonitorGroupsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogMonitorGroups `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogMonitorGroups{}, &DatadogMonitorGroupsList{})
+}
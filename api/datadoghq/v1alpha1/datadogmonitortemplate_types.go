@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogMonitorTemplateSpec defines the desired state of a DatadogMonitorTemplate
+// +k8s:openapi-gen=true
+type DatadogMonitorTemplateSpec struct {
+	// Selector selects the workloads, in the namespace of the DatadogMonitorTemplate, for which a DatadogMonitor is created.
+	Selector DatadogMonitorTemplateSelector `json:"selector,omitempty"`
+
+	// MonitorTemplate is the template of the DatadogMonitors created for the selected workloads.
+	MonitorTemplate DatadogMonitorTemplateMonitor `json:"monitorTemplate"`
+}
+
+// DatadogMonitorTemplateSelector selects the workloads for which a DatadogMonitor is created.
+// +k8s:openapi-gen=true
+type DatadogMonitorTemplateSelector struct {
+	// Kinds is the list of workload kinds to select. Defaults to Deployment.
+	// +listType=set
+	Kinds []DatadogMonitorTemplateWorkloadKind `json:"kinds,omitempty"`
+
+	// LabelSelector selects the workloads by label. All the workloads of the selected kinds are selected when it isn't set.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// DatadogMonitorTemplateWorkloadKind is a kind of workload selected by a DatadogMonitorTemplate.
+// +kubebuilder:validation:Enum=Deployment;StatefulSet;Service
+type DatadogMonitorTemplateWorkloadKind string
+
+const (
+	// DatadogMonitorTemplateWorkloadKindDeployment selects Deployments.
+	DatadogMonitorTemplateWorkloadKindDeployment DatadogMonitorTemplateWorkloadKind = "Deployment"
+	// DatadogMonitorTemplateWorkloadKindStatefulSet selects StatefulSets.
+	DatadogMonitorTemplateWorkloadKindStatefulSet DatadogMonitorTemplateWorkloadKind = "StatefulSet"
+	// DatadogMonitorTemplateWorkloadKindService selects Services.
+	DatadogMonitorTemplateWorkloadKindService DatadogMonitorTemplateWorkloadKind = "Service"
+)
+
+// DatadogMonitorTemplateMonitor is the template of a DatadogMonitor.
+// +k8s:openapi-gen=true
+type DatadogMonitorTemplateMonitor struct {
+	// Labels are added to the DatadogMonitors created from the template.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Spec is the spec of the DatadogMonitors. Every string field is a Go template using `[[` and `]]` as delimiters,
+	// so that it doesn't conflict with Datadog template variables. The workload is available as `.Kind`, `.Name`,
+	// `.Namespace`, `.Labels` and `.Annotations`, for example `avg:trace.http.request.errors{kube_deployment:[[ .Name ]]}`.
+	Spec DatadogMonitorSpec `json:"spec"`
+}
+
+// DatadogMonitorTemplateStatus defines the observed state of a DatadogMonitorTemplate
+// +k8s:openapi-gen=true
+type DatadogMonitorTemplateStatus struct {
+	// Conditions represents the latest available observations of the state of a DatadogMonitorTemplate.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Monitors is the list of DatadogMonitors created from the template.
+	// +listType=set
+	Monitors []string `json:"monitors,omitempty"`
+
+	// MonitorCount is the number of DatadogMonitors created from the template.
+	MonitorCount int32 `json:"monitorCount,omitempty"`
+}
+
+// DatadogMonitorTemplate creates a DatadogMonitor for each Kubernetes workload matching a selector.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=datadogmonitortemplates,scope=Namespaced,shortName=ddmt
+// +kubebuilder:printcolumn:name="monitors",type="integer",JSONPath=".status.monitorCount"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogMonitorTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogMonitorTemplateSpec   `json:"spec,omitempty"`
+	Status DatadogMonitorTemplateStatus `json:"status,omitempty"`
+}
+
+// DatadogMonitorTemplateList contains a list of DatadogMonitorTemplates.
+// +kubebuilder:object:root=true
+type DatadogMonitorTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogMonitorTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogMonitorTemplate{}, &DatadogMonitorTemplateList{})
+}
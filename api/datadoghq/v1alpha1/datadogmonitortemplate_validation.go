@@ -0,0 +1,39 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// IsValidDatadogMonitorTemplate use to check if a DatadogMonitorTemplateSpec is valid by checking
+// that the selector is valid and that the required fields of the monitor template are defined
+func IsValidDatadogMonitorTemplate(spec *DatadogMonitorTemplateSpec) error {
+	var errs []error
+
+	for _, kind := range spec.Selector.Kinds {
+		switch kind {
+		case DatadogMonitorTemplateWorkloadKindDeployment, DatadogMonitorTemplateWorkloadKindStatefulSet, DatadogMonitorTemplateWorkloadKindService:
+		default:
+			errs = append(errs, fmt.Errorf("spec.Selector.Kinds contains an unsupported kind: %s", kind))
+		}
+	}
+
+	if spec.Selector.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.Selector.LabelSelector); err != nil {
+			errs = append(errs, fmt.Errorf("spec.Selector.LabelSelector is invalid: %w", err))
+		}
+	}
+
+	if err := IsValidDatadogMonitor(&spec.MonitorTemplate.Spec); err != nil {
+		errs = append(errs, fmt.Errorf("spec.MonitorTemplate is invalid: %w", err))
+	}
+
+	return utilserrors.NewAggregate(errs)
+}
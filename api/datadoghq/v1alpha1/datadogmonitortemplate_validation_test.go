@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+func TestIsValidDatadogMonitorTemplate(t *testing.T) {
+	monitorSpec := DatadogMonitorSpec{
+		Name:    "[[ .Name ]] error rate",
+		Message: "Errors are increasing",
+		Query:   "sum(last_5m):sum:trace.http.request.errors{kube_deployment:[[ .Name ]]}.as_count() > 10",
+		Type:    DatadogMonitorTypeQuery,
+	}
+
+	tests := []struct {
+		name     string
+		spec     *DatadogMonitorTemplateSpec
+		expected error
+	}{
+		{
+			name: "Valid spec",
+			spec: &DatadogMonitorTemplateSpec{
+				Selector: DatadogMonitorTemplateSelector{
+					Kinds: []DatadogMonitorTemplateWorkloadKind{DatadogMonitorTemplateWorkloadKindDeployment, DatadogMonitorTemplateWorkloadKindService},
+					LabelSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpExists}},
+					},
+				},
+				MonitorTemplate: DatadogMonitorTemplateMonitor{Spec: monitorSpec},
+			},
+			expected: nil,
+		},
+		{
+			name: "Unsupported kind and invalid label selector",
+			spec: &DatadogMonitorTemplateSpec{
+				Selector: DatadogMonitorTemplateSelector{
+					Kinds: []DatadogMonitorTemplateWorkloadKind{"DaemonSet"},
+					LabelSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Equals"}},
+					},
+				},
+				MonitorTemplate: DatadogMonitorTemplateMonitor{Spec: monitorSpec},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Selector.Kinds contains an unsupported kind: DaemonSet"),
+					errors.New("spec.Selector.LabelSelector is invalid: \"Equals\" is not a valid label selector operator"),
+				},
+			),
+		},
+		{
+			name: "Missing monitor fields",
+			spec: &DatadogMonitorTemplateSpec{
+				MonitorTemplate: DatadogMonitorTemplateMonitor{
+					Spec: DatadogMonitorSpec{
+						Name:    "[[ .Name ]] error rate",
+						Message: "Errors are increasing",
+						Type:    DatadogMonitorTypeQuery,
+					},
+				},
+			},
+			expected: errors.New("spec.MonitorTemplate is invalid: spec.Query must be defined"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidDatadogMonitorTemplate(tt.spec)
+			if tt.expected != nil {
+				assert.EqualError(t, result, tt.expected.Error())
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
@@ -10,6 +10,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DatadogSLOAdoptIDAnnotationKey is the annotation holding the ID of an existing Datadog SLO for the
+// DatadogSLO to adopt. The SLO is then updated from the DatadogSLO spec instead of being created.
+const DatadogSLOAdoptIDAnnotationKey = "datadoghq.com/adopt-slo-id"
+
 // +k8s:openapi-gen=true
 type DatadogSLOSpec struct {
 	// Name is the name of the service level objective.
@@ -29,6 +33,11 @@ type DatadogSLOSpec struct {
 	// +listType=set
 	MonitorIDs []int64 `json:"monitorIDs,omitempty"`
 
+	// MonitorRefs is a list of DatadogMonitor objects that defines the scope of a monitor service level objective,
+	// in addition to MonitorIDs. The IDs of the monitors are resolved once they are created in Datadog.
+	// +listType=atomic
+	MonitorRefs []DatadogSLOMonitorReference `json:"monitorRefs,omitempty"`
+
 	// Tags is a list of tags to associate with your service level objective.
 	// This can help you categorize and filter service level objectives in the service level objectives page of the UI.
 	// Note: it's not currently possible to filter by these tags when querying via the API.
@@ -39,6 +48,9 @@ type DatadogSLOSpec struct {
 	// Note that only the `sum by` aggregator is allowed, which sums all request counts. `Average`, `max`, nor `min` request aggregators are not supported.
 	Query *DatadogSLOQuery `json:"query,omitempty"`
 
+	// TimeSlice is the SLI specification of a time-slice SLO. Required if type is time_slice.
+	TimeSlice *DatadogSLOTimeSlice `json:"timeSlice,omitempty"`
+
 	// Type is the type of the service level objective.
 	Type DatadogSLOType `json:"type"`
 
@@ -53,6 +65,17 @@ type DatadogSLOSpec struct {
 
 	// ControllerOptions are the optional parameters in the DatadogSLO controller
 	ControllerOptions *DatadogSLOControllerOptions `json:"controllerOptions,omitempty"`
+
+	// CredentialsRef references a Secret with the Datadog credentials used to manage the SLO, instead of those of the
+	// namespace or of the operator.
+	// +optional
+	CredentialsRef *DatadogCredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy defines what happens to the SLO in Datadog when the custom resource is deleted: it's deleted
+	// (Delete) or kept, and tagged as no longer managed by the operator (Orphan). Defaults to the deletion policy of
+	// the operator, Delete unless configured otherwise.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -63,16 +86,68 @@ type DatadogSLOQuery struct {
 	Denominator string `json:"denominator"`
 }
 
+// DatadogSLOMonitorReference references a DatadogMonitor.
+// +k8s:openapi-gen=true
+type DatadogSLOMonitorReference struct {
+	// Name is the name of the DatadogMonitor.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the DatadogMonitor. Defaults to the namespace of the DatadogSLO.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// DatadogSLOTimeSlice defines the SLI of a time-slice SLO: the proportion of time slices in which the formula result
+// meets the condition.
+// +k8s:openapi-gen=true
+type DatadogSLOTimeSlice struct {
+	// Queries are the metric queries used by the formula, referenced by their name.
+	// +listType=map
+	// +listMapKey=name
+	Queries []DatadogSLOTimeSliceQuery `json:"queries"`
+
+	// Formula combines the queries, for example `query1 / query2 * 100`. Defaults to the name of the query when there is only one.
+	Formula string `json:"formula,omitempty"`
+
+	// Comparator is the comparator used to compare the formula result to the threshold: `>`, `>=`, `<` or `<=`.
+	Comparator DatadogSLOTimeSliceComparator `json:"comparator"`
+
+	// Threshold is the value the formula result is compared to.
+	Threshold resource.Quantity `json:"threshold"`
+
+	// QueryIntervalSeconds is the duration of a time slice, in seconds: 60 (the default) or 300.
+	QueryIntervalSeconds *int32 `json:"queryIntervalSeconds,omitempty"`
+}
+
+// DatadogSLOTimeSliceQuery is a named metric query of a time-slice SLO.
+// +k8s:openapi-gen=true
+type DatadogSLOTimeSliceQuery struct {
+	// Name is the name of the query, used in the formula.
+	Name string `json:"name"`
+
+	// Query is the Datadog metric query.
+	Query string `json:"query"`
+}
+
+type DatadogSLOTimeSliceComparator string
+
+const (
+	DatadogSLOTimeSliceComparatorGreater      DatadogSLOTimeSliceComparator = ">"
+	DatadogSLOTimeSliceComparatorGreaterEqual DatadogSLOTimeSliceComparator = ">="
+	DatadogSLOTimeSliceComparatorLess         DatadogSLOTimeSliceComparator = "<"
+	DatadogSLOTimeSliceComparatorLessEqual    DatadogSLOTimeSliceComparator = "<="
+)
+
 type DatadogSLOType string
 
 const (
-	DatadogSLOTypeMetric  DatadogSLOType = "metric"
-	DatadogSLOTypeMonitor DatadogSLOType = "monitor"
+	DatadogSLOTypeMetric    DatadogSLOType = "metric"
+	DatadogSLOTypeMonitor   DatadogSLOType = "monitor"
+	DatadogSLOTypeTimeSlice DatadogSLOType = "time_slice"
 )
 
 func (t DatadogSLOType) IsValid() bool {
 	switch t {
-	case DatadogSLOTypeMetric, DatadogSLOTypeMonitor:
+	case DatadogSLOTypeMetric, DatadogSLOTypeMonitor, DatadogSLOTypeTimeSlice:
 		return true
 	default:
 		return false
@@ -92,6 +167,10 @@ const (
 type DatadogSLOControllerOptions struct {
 	// DisableRequiredTags disables the automatic addition of required tags to SLOs.
 	DisableRequiredTags *bool `json:"disableRequiredTags,omitempty"`
+	// DriftPolicy defines how changes made to the SLO outside of Kubernetes are handled:
+	// reverted as soon as they are detected (enforce, the default) or only reported (observe).
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // DatadogSLOStatus defines the observed state of a DatadogSLO.
@@ -120,8 +199,38 @@ type DatadogSLOStatus struct {
 	// CurrentHash tracks the hash of the current DatadogSLOSpec to know
 	// if the Spec has changed and needs an update.
 	CurrentHash string `json:"currentHash,omitempty"`
+
+	// MonitorIDs are the IDs of the monitors referenced by MonitorRefs.
+	// +listType=atomic
+	MonitorIDs []int64 `json:"monitorIDs,omitempty"`
+
+	// SLIValue is the current SLI value of the SLO over its timeframe, in percent.
+	SLIValue *string `json:"sliValue,omitempty"`
+
+	// ErrorBudgetRemaining is the remaining error budget of the SLO over its timeframe, in percent.
+	ErrorBudgetRemaining *string `json:"errorBudgetRemaining,omitempty"`
+
+	// State is the state of the SLO over its timeframe, based on its SLI value and thresholds.
+	State DatadogSLOState `json:"state,omitempty"`
+
+	// StateLastUpdateTime is the last time the SLI value, error budget and state were updated.
+	StateLastUpdateTime *metav1.Time `json:"stateLastUpdateTime,omitempty"`
 }
 
+// DatadogSLOState is the state of a SLO, based on its SLI value and thresholds.
+type DatadogSLOState string
+
+const (
+	// DatadogSLOStateOK means the SLI value is above the warning threshold, or above the target threshold if there is no warning threshold.
+	DatadogSLOStateOK DatadogSLOState = "OK"
+	// DatadogSLOStateWarning means the SLI value is between the target and warning thresholds.
+	DatadogSLOStateWarning DatadogSLOState = "Warning"
+	// DatadogSLOStateBreached means the SLI value is below the target threshold, the error budget is exhausted.
+	DatadogSLOStateBreached DatadogSLOState = "Breached"
+	// DatadogSLOStateNoData means there is no SLI value yet, for a new SLO for instance.
+	DatadogSLOStateNoData DatadogSLOState = "No Data"
+)
+
 // DatadogSLOSyncStatus is the message reflecting the health of SLO state syncs to Datadog.
 type DatadogSLOSyncStatus string
 
@@ -130,10 +239,16 @@ const (
 	DatadogSLOSyncStatusOK DatadogSLOSyncStatus = "OK"
 	// DatadogSLOSyncStatusValidateError means there is a SLO validation error.
 	DatadogSLOSyncStatusValidateError DatadogSLOSyncStatus = "error validating SLO"
+	// DatadogSLOSyncStatusMonitorRefError means the referenced DatadogMonitors can't be resolved.
+	DatadogSLOSyncStatusMonitorRefError DatadogSLOSyncStatus = "error resolving monitor references"
 	// DatadogSLOSyncStatusUpdateError means there is a SLO update error.
 	DatadogSLOSyncStatusUpdateError DatadogSLOSyncStatus = "error updating SLO"
 	// DatadogSLOSyncStatusCreateError means there is an error getting the SLO.
 	DatadogSLOSyncStatusCreateError DatadogSLOSyncStatus = "error creating SLO"
+	// DatadogSLOSyncStatusGetError means there is an error getting the SLO.
+	DatadogSLOSyncStatusGetError DatadogSLOSyncStatus = "error getting SLO"
+	// DatadogSLOSyncStatusCredentialsError means the Datadog credentials of the SLO couldn't be loaded.
+	DatadogSLOSyncStatusCredentialsError DatadogSLOSyncStatus = "error loading Datadog credentials"
 )
 
 // DatadogSLO allows a user to define and manage datadog SLOs from Kubernetes cluster.
@@ -142,6 +257,9 @@ const (
 // +kubebuilder:resource:path=datadogslos,scope=Namespaced,shortName=ddslo
 // +kubebuilder:printcolumn:name="id",type="string",JSONPath=".status.id"
 // +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="state",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="sli",type="string",JSONPath=".status.sliValue"
+// +kubebuilder:printcolumn:name="error budget",type="string",JSONPath=".status.errorBudgetRemaining"
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 // +k8s:openapi-gen=true
 // +genclient
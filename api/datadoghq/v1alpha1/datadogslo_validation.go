@@ -24,15 +24,29 @@ func IsValidDatadogSLO(spec *DatadogSLOSpec) error {
 	}
 
 	if spec.Type != "" && !spec.Type.IsValid() {
-		errs = append(errs, fmt.Errorf("spec.Type must be one of the values: %s or %s", DatadogSLOTypeMonitor, DatadogSLOTypeMetric))
+		errs = append(errs, fmt.Errorf("spec.Type must be one of the values: %s, %s or %s", DatadogSLOTypeMonitor, DatadogSLOTypeMetric, DatadogSLOTypeTimeSlice))
 	}
 
 	if spec.Type == DatadogSLOTypeMetric && spec.Query == nil {
 		errs = append(errs, fmt.Errorf("spec.Query must be defined when spec.Type is metric"))
 	}
 
-	if spec.Type == DatadogSLOTypeMonitor && len(spec.MonitorIDs) == 0 {
-		errs = append(errs, fmt.Errorf("spec.MonitorIDs must be defined when spec.Type is monitor"))
+	if spec.Type == DatadogSLOTypeMonitor && len(spec.MonitorIDs) == 0 && len(spec.MonitorRefs) == 0 {
+		errs = append(errs, fmt.Errorf("spec.MonitorIDs or spec.MonitorRefs must be defined when spec.Type is monitor"))
+	}
+
+	for i, ref := range spec.MonitorRefs {
+		if ref.Name == "" {
+			errs = append(errs, fmt.Errorf("spec.MonitorRefs[%d].Name must be defined", i))
+		}
+	}
+
+	if spec.Type == DatadogSLOTypeTimeSlice {
+		if spec.TimeSlice == nil {
+			errs = append(errs, fmt.Errorf("spec.TimeSlice must be defined when spec.Type is time_slice"))
+		} else {
+			errs = append(errs, isValidDatadogSLOTimeSlice(spec.TimeSlice)...)
+		}
 	}
 
 	if spec.TargetThreshold.AsApproximateFloat64() <= 0 || spec.TargetThreshold.AsApproximateFloat64() >= 100 {
@@ -52,3 +66,31 @@ func IsValidDatadogSLO(spec *DatadogSLOSpec) error {
 
 	return utilserrors.NewAggregate(errs)
 }
+
+func isValidDatadogSLOTimeSlice(timeSlice *DatadogSLOTimeSlice) []error {
+	var errs []error
+	if len(timeSlice.Queries) == 0 {
+		errs = append(errs, fmt.Errorf("spec.TimeSlice.Queries must be defined"))
+	}
+	for i, query := range timeSlice.Queries {
+		if query.Name == "" || query.Query == "" {
+			errs = append(errs, fmt.Errorf("spec.TimeSlice.Queries[%d] must have a name and a query", i))
+		}
+	}
+
+	if timeSlice.Formula == "" && len(timeSlice.Queries) > 1 {
+		errs = append(errs, fmt.Errorf("spec.TimeSlice.Formula must be defined when there are several queries"))
+	}
+
+	switch timeSlice.Comparator {
+	case DatadogSLOTimeSliceComparatorGreater, DatadogSLOTimeSliceComparatorGreaterEqual, DatadogSLOTimeSliceComparatorLess, DatadogSLOTimeSliceComparatorLessEqual:
+	default:
+		errs = append(errs, fmt.Errorf("spec.TimeSlice.Comparator must be one of the values: >, >=, <, or <="))
+	}
+
+	if timeSlice.QueryIntervalSeconds != nil && *timeSlice.QueryIntervalSeconds != 60 && *timeSlice.QueryIntervalSeconds != 300 {
+		errs = append(errs, fmt.Errorf("spec.TimeSlice.QueryIntervalSeconds must be 60 or 300"))
+	}
+
+	return errs
+}
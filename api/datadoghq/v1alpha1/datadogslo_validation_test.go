@@ -83,7 +83,7 @@ func TestIsValidDatadogSLO(t *testing.T) {
 				TargetThreshold: resource.MustParse("99.99"),
 				Timeframe:       DatadogSLOTimeFrame30d,
 			},
-			expected: errors.New("spec.Type must be one of the values: monitor or metric"),
+			expected: errors.New("spec.Type must be one of the values: monitor, metric or time_slice"),
 		},
 		{
 			name: "Missing Threshold and Timeframe",
@@ -112,7 +112,67 @@ func TestIsValidDatadogSLO(t *testing.T) {
 				Timeframe:       DatadogSLOTimeFrame30d,
 				MonitorIDs:      []int64{},
 			},
-			expected: errors.New("spec.MonitorIDs must be defined when spec.Type is monitor"),
+			expected: errors.New("spec.MonitorIDs or spec.MonitorRefs must be defined when spec.Type is monitor"),
+		},
+		{
+			name: "Valid spec with MonitorRefs",
+			spec: &DatadogSLOSpec{
+				Name:            "MySLO",
+				Type:            DatadogSLOTypeMonitor,
+				TargetThreshold: resource.MustParse("99.99"),
+				Timeframe:       DatadogSLOTimeFrame30d,
+				MonitorRefs:     []DatadogSLOMonitorReference{{Name: "my-monitor"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "Valid time-slice spec",
+			spec: &DatadogSLOSpec{
+				Name:            "MySLO",
+				Type:            DatadogSLOTypeTimeSlice,
+				TargetThreshold: resource.MustParse("99.9"),
+				Timeframe:       DatadogSLOTimeFrame7d,
+				TimeSlice: &DatadogSLOTimeSlice{
+					Queries:    []DatadogSLOTimeSliceQuery{{Name: "query1", Query: "p95:trace.http.request{service:checkout}"}},
+					Comparator: DatadogSLOTimeSliceComparatorLess,
+					Threshold:  resource.MustParse("0.5"),
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing TimeSlice",
+			spec: &DatadogSLOSpec{
+				Name:            "MySLO",
+				Type:            DatadogSLOTypeTimeSlice,
+				TargetThreshold: resource.MustParse("99.9"),
+				Timeframe:       DatadogSLOTimeFrame7d,
+			},
+			expected: errors.New("spec.TimeSlice must be defined when spec.Type is time_slice"),
+		},
+		{
+			name: "Invalid TimeSlice",
+			spec: &DatadogSLOSpec{
+				Name:            "MySLO",
+				Type:            DatadogSLOTypeTimeSlice,
+				TargetThreshold: resource.MustParse("99.9"),
+				Timeframe:       DatadogSLOTimeFrame7d,
+				TimeSlice: &DatadogSLOTimeSlice{
+					Queries: []DatadogSLOTimeSliceQuery{
+						{Name: "query1", Query: "sum:requests.error{*}"},
+						{Name: "query2", Query: "sum:requests{*}"},
+					},
+					Comparator:           "=",
+					QueryIntervalSeconds: ptrInt32(120),
+				},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.TimeSlice.Formula must be defined when there are several queries"),
+					errors.New("spec.TimeSlice.Comparator must be one of the values: >, >=, <, or <="),
+					errors.New("spec.TimeSlice.QueryIntervalSeconds must be 60 or 300"),
+				},
+			),
 		},
 		{
 			name: "Invalid Thresholds",
@@ -174,3 +234,7 @@ func TestIsValidDatadogSLO(t *testing.T) {
 func ptrResourceQuantity(n resource.Quantity) *resource.Quantity {
 	return &n
 }
+
+func ptrInt32(n int32) *int32 {
+	return &n
+}
@@ -0,0 +1,157 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogSLOCorrectionSpec defines the desired state of a DatadogSLOCorrection
+// +k8s:openapi-gen=true
+type DatadogSLOCorrectionSpec struct {
+	// SLORef references the DatadogSLO to correct, in the same namespace.
+	SLORef DatadogSLOCorrectionSLOReference `json:"sloRef"`
+
+	// Category is the category of the correction: `Scheduled Maintenance`, `Outside Business Hours`, `Deployment` or `Other`.
+	Category DatadogSLOCorrectionCategory `json:"category"`
+
+	// Description is a description of the correction.
+	Description *string `json:"description,omitempty"`
+
+	// Start is the start of a one-off correction, or of the first occurrence of a recurring correction.
+	Start metav1.Time `json:"start"`
+
+	// End is the end of a one-off correction. It can't be used with Rrule.
+	End *metav1.Time `json:"end,omitempty"`
+
+	// Duration is the length of each occurrence of a recurring correction, for example `2h`. Required with Rrule.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Rrule is the recurrence rule of a recurring correction, as defined by RFC 5545 (only `FREQ`, `INTERVAL`, `COUNT` and `UNTIL` are supported),
+	// for example `FREQ=WEEKLY;BYDAY=SA,SU`.
+	Rrule string `json:"rrule,omitempty"`
+
+	// Timezone is the timezone in which the recurrences are evaluated, for example `Europe/Paris`. Defaults to `UTC`.
+	Timezone string `json:"timezone,omitempty"`
+
+	// DeletionPolicy defines what happens to the SLO correction in Datadog when the custom resource is deleted: it's
+	// deleted (Delete) or kept (Orphan). Defaults to the deletion policy of the operator, Delete unless configured
+	// otherwise.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// DatadogSLOCorrectionSLOReference references a DatadogSLO.
+// +k8s:openapi-gen=true
+type DatadogSLOCorrectionSLOReference struct {
+	// Name is the name of the DatadogSLO.
+	Name string `json:"name"`
+}
+
+// DatadogSLOCorrectionCategory is the category of an SLO correction.
+type DatadogSLOCorrectionCategory string
+
+const (
+	// DatadogSLOCorrectionCategoryScheduledMaintenance is a correction for a scheduled maintenance.
+	DatadogSLOCorrectionCategoryScheduledMaintenance DatadogSLOCorrectionCategory = "Scheduled Maintenance"
+	// DatadogSLOCorrectionCategoryOutsideBusinessHours is a correction for the time outside business hours.
+	DatadogSLOCorrectionCategoryOutsideBusinessHours DatadogSLOCorrectionCategory = "Outside Business Hours"
+	// DatadogSLOCorrectionCategoryDeployment is a correction for a deployment.
+	DatadogSLOCorrectionCategoryDeployment DatadogSLOCorrectionCategory = "Deployment"
+	// DatadogSLOCorrectionCategoryOther is a correction for another reason.
+	DatadogSLOCorrectionCategoryOther DatadogSLOCorrectionCategory = "Other"
+)
+
+// IsValid returns true if the category is supported by Datadog.
+func (c DatadogSLOCorrectionCategory) IsValid() bool {
+	switch c {
+	case DatadogSLOCorrectionCategoryScheduledMaintenance, DatadogSLOCorrectionCategoryOutsideBusinessHours, DatadogSLOCorrectionCategoryDeployment, DatadogSLOCorrectionCategoryOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// DatadogSLOCorrectionStatus defines the observed state of a DatadogSLOCorrection
+// +k8s:openapi-gen=true
+type DatadogSLOCorrectionStatus struct {
+	// Conditions represents the latest available observations of the state of a DatadogSLOCorrection.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the SLO correction ID generated in Datadog.
+	ID string `json:"id,omitempty"`
+
+	// SLOID is the ID of the SLO referenced by SLORef.
+	SLOID string `json:"sloID,omitempty"`
+
+	// Creator is the identity of the SLO correction creator.
+	Creator string `json:"creator,omitempty"`
+
+	// Created is the time the SLO correction was created.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// SyncStatus shows the health of syncing the SLO correction state to Datadog.
+	SyncStatus DatadogSLOCorrectionSyncStatus `json:"syncStatus,omitempty"`
+
+	// LastForceSyncTime is the last time the API SLO correction was last force synced with the DatadogSLOCorrection resource.
+	LastForceSyncTime *metav1.Time `json:"lastForceSyncTime,omitempty"`
+
+	// CurrentHash tracks the hash of the current DatadogSLOCorrectionSpec to know
+	// if the Spec has changed and needs an update.
+	CurrentHash string `json:"currentHash,omitempty"`
+}
+
+// DatadogSLOCorrectionSyncStatus is the message reflecting the health of SLO correction state syncs to Datadog.
+type DatadogSLOCorrectionSyncStatus string
+
+const (
+	// DatadogSLOCorrectionSyncStatusOK means syncing is OK.
+	DatadogSLOCorrectionSyncStatusOK DatadogSLOCorrectionSyncStatus = "OK"
+	// DatadogSLOCorrectionSyncStatusValidateError means there is an SLO correction validation error.
+	DatadogSLOCorrectionSyncStatusValidateError DatadogSLOCorrectionSyncStatus = "error validating SLO correction"
+	// DatadogSLOCorrectionSyncStatusSLORefError means the referenced DatadogSLO can't be resolved.
+	DatadogSLOCorrectionSyncStatusSLORefError DatadogSLOCorrectionSyncStatus = "error resolving SLO reference"
+	// DatadogSLOCorrectionSyncStatusUpdateError means there is an SLO correction update error.
+	DatadogSLOCorrectionSyncStatusUpdateError DatadogSLOCorrectionSyncStatus = "error updating SLO correction"
+	// DatadogSLOCorrectionSyncStatusCreateError means there is an error creating the SLO correction.
+	DatadogSLOCorrectionSyncStatusCreateError DatadogSLOCorrectionSyncStatus = "error creating SLO correction"
+	// DatadogSLOCorrectionSyncStatusGetError means there is an error getting the SLO correction.
+	DatadogSLOCorrectionSyncStatusGetError DatadogSLOCorrectionSyncStatus = "error getting SLO correction"
+)
+
+// DatadogSLOCorrection allows to define and manage Datadog SLO corrections from your Kubernetes Cluster.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=datadogslocorrections,scope=Namespaced,shortName=ddslocorr
+// +kubebuilder:printcolumn:name="id",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="slo",type="string",JSONPath=".spec.sloRef.name"
+// +kubebuilder:printcolumn:name="category",type="string",JSONPath=".spec.category"
+// +kubebuilder:printcolumn:name="start",type="date",JSONPath=".spec.start"
+// +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogSLOCorrection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogSLOCorrectionSpec   `json:"spec,omitempty"`
+	Status DatadogSLOCorrectionStatus `json:"status,omitempty"`
+}
+
+// DatadogSLOCorrectionList contains a list of DatadogSLOCorrections.
+// +kubebuilder:object:root=true
+type DatadogSLOCorrectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogSLOCorrection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogSLOCorrection{}, &DatadogSLOCorrectionList{})
+}
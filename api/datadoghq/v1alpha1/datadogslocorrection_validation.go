@@ -0,0 +1,51 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// IsValidDatadogSLOCorrection use to check if a DatadogSLOCorrectionSpec is valid by checking
+// that the required fields are defined and that exclusive fields aren't combined
+func IsValidDatadogSLOCorrection(spec *DatadogSLOCorrectionSpec) error {
+	var errs []error
+
+	if spec.SLORef.Name == "" {
+		errs = append(errs, fmt.Errorf("spec.SLORef.Name must be defined"))
+	}
+
+	if !spec.Category.IsValid() {
+		errs = append(errs, fmt.Errorf("spec.Category must be one of the values: %s, %s, %s or %s", DatadogSLOCorrectionCategoryScheduledMaintenance, DatadogSLOCorrectionCategoryOutsideBusinessHours, DatadogSLOCorrectionCategoryDeployment, DatadogSLOCorrectionCategoryOther))
+	}
+
+	if spec.Start.IsZero() {
+		errs = append(errs, fmt.Errorf("spec.Start must be defined"))
+	}
+
+	if spec.Rrule != "" {
+		if spec.End != nil {
+			errs = append(errs, fmt.Errorf("spec.Rrule can't be used with spec.End"))
+		}
+		if spec.Duration == nil {
+			errs = append(errs, fmt.Errorf("spec.Duration must be defined when spec.Rrule is defined"))
+		}
+	} else if spec.End == nil && spec.Duration == nil {
+		errs = append(errs, fmt.Errorf("spec.End or spec.Duration must be defined"))
+	}
+
+	if spec.End != nil && !spec.End.After(spec.Start.Time) {
+		errs = append(errs, fmt.Errorf("spec.End must be after spec.Start"))
+	}
+
+	if spec.Duration != nil && spec.Duration.Duration <= 0 {
+		errs = append(errs, fmt.Errorf("spec.Duration must be positive"))
+	}
+
+	return utilserrors.NewAggregate(errs)
+}
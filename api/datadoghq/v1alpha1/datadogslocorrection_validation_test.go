@@ -0,0 +1,96 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+func TestIsValidDatadogSLOCorrection(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	duration := metav1.Duration{Duration: 2 * time.Hour}
+
+	tests := []struct {
+		name     string
+		spec     *DatadogSLOCorrectionSpec
+		expected error
+	}{
+		{
+			name: "Valid one-off correction",
+			spec: &DatadogSLOCorrectionSpec{
+				SLORef:   DatadogSLOCorrectionSLOReference{Name: "my-slo"},
+				Category: DatadogSLOCorrectionCategoryScheduledMaintenance,
+				Start:    start,
+				End:      &end,
+			},
+			expected: nil,
+		},
+		{
+			name: "Valid recurring correction",
+			spec: &DatadogSLOCorrectionSpec{
+				SLORef:   DatadogSLOCorrectionSLOReference{Name: "my-slo"},
+				Category: DatadogSLOCorrectionCategoryOutsideBusinessHours,
+				Start:    start,
+				Duration: &duration,
+				Rrule:    "FREQ=WEEKLY;BYDAY=SA,SU",
+				Timezone: "Europe/Paris",
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing required fields",
+			spec: &DatadogSLOCorrectionSpec{},
+			expected: utilserrors.NewAggregate([]error{
+				errors.New("spec.SLORef.Name must be defined"),
+				errors.New("spec.Category must be one of the values: Scheduled Maintenance, Outside Business Hours, Deployment or Other"),
+				errors.New("spec.Start must be defined"),
+				errors.New("spec.End or spec.Duration must be defined"),
+			}),
+		},
+		{
+			name: "Rrule with End and without Duration",
+			spec: &DatadogSLOCorrectionSpec{
+				SLORef:   DatadogSLOCorrectionSLOReference{Name: "my-slo"},
+				Category: DatadogSLOCorrectionCategoryDeployment,
+				Start:    start,
+				End:      &end,
+				Rrule:    "FREQ=DAILY",
+			},
+			expected: utilserrors.NewAggregate([]error{
+				errors.New("spec.Rrule can't be used with spec.End"),
+				errors.New("spec.Duration must be defined when spec.Rrule is defined"),
+			}),
+		},
+		{
+			name: "End before Start",
+			spec: &DatadogSLOCorrectionSpec{
+				SLORef:   DatadogSLOCorrectionSLOReference{Name: "my-slo"},
+				Category: DatadogSLOCorrectionCategoryOther,
+				Start:    end,
+				End:      &start,
+			},
+			expected: errors.New("spec.End must be after spec.Start"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidDatadogSLOCorrection(tt.spec)
+			if tt.expected != nil {
+				assert.EqualError(t, result, tt.expected.Error())
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
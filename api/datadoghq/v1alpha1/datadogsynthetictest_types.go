@@ -0,0 +1,300 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogSyntheticTestSpec defines the desired state of a DatadogSyntheticTest
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestSpec struct {
+	// Name is the name of the test.
+	Name string `json:"name"`
+
+	// Message is the notification message of the test.
+	Message string `json:"message,omitempty"`
+
+	// Subtype is the subtype of the API test: `http`, `tcp`, `dns`, `ssl`, or `multi` for a multistep test.
+	Subtype DatadogSyntheticTestSubtype `json:"subtype"`
+
+	// Locations is the list of locations the test runs from, for example `aws:eu-west-1`.
+	// +listType=set
+	Locations []string `json:"locations"`
+
+	// Tags is a list of tags to associate with the test.
+	// +listType=set
+	Tags []string `json:"tags,omitempty"`
+
+	// Paused pauses the test. Tests are live by default.
+	Paused *bool `json:"paused,omitempty"`
+
+	// Request is the request of the test. Required for all subtypes except `multi`.
+	Request *DatadogSyntheticTestRequest `json:"request,omitempty"`
+
+	// Assertions is the list of assertions on the response of the request. Required for all subtypes except `multi`.
+	// +listType=atomic
+	Assertions []DatadogSyntheticTestAssertion `json:"assertions,omitempty"`
+
+	// Steps is the list of HTTP steps of a `multi` test, run in order.
+	// +listType=atomic
+	Steps []DatadogSyntheticTestStep `json:"steps,omitempty"`
+
+	// Options are the run and alerting options of the test.
+	Options *DatadogSyntheticTestOptions `json:"options,omitempty"`
+
+	// ControllerOptions are the optional parameters in the DatadogSyntheticTest controller.
+	ControllerOptions *DatadogSyntheticTestControllerOptions `json:"controllerOptions,omitempty"`
+
+	// DeletionPolicy defines what happens to the test in Datadog when the custom resource is deleted: it's deleted
+	// (Delete) or kept, and tagged as no longer managed by the operator (Orphan). Defaults to the deletion policy of
+	// the operator, Delete unless configured otherwise.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// DatadogSyntheticTestSubtype is the subtype of an API test.
+type DatadogSyntheticTestSubtype string
+
+const (
+	// DatadogSyntheticTestSubtypeHTTP is an HTTP test.
+	DatadogSyntheticTestSubtypeHTTP DatadogSyntheticTestSubtype = "http"
+	// DatadogSyntheticTestSubtypeTCP is a TCP test.
+	DatadogSyntheticTestSubtypeTCP DatadogSyntheticTestSubtype = "tcp"
+	// DatadogSyntheticTestSubtypeDNS is a DNS test.
+	DatadogSyntheticTestSubtypeDNS DatadogSyntheticTestSubtype = "dns"
+	// DatadogSyntheticTestSubtypeSSL is an SSL test.
+	DatadogSyntheticTestSubtypeSSL DatadogSyntheticTestSubtype = "ssl"
+	// DatadogSyntheticTestSubtypeMulti is a multistep test.
+	DatadogSyntheticTestSubtypeMulti DatadogSyntheticTestSubtype = "multi"
+)
+
+// DatadogSyntheticTestRequest is the request of an API test, or of a step of a multistep test.
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestRequest struct {
+	// Method is the HTTP method of an `http` request, for example `GET`.
+	Method string `json:"method,omitempty"`
+
+	// URL is the URL of an `http` request.
+	URL string `json:"url,omitempty"`
+
+	// Headers are the headers of an `http` request.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is the body of an `http` request.
+	Body string `json:"body,omitempty"`
+
+	// FollowRedirects defines whether the redirections of an `http` request are followed.
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+
+	// Host is the host of a `tcp`, `dns` or `ssl` request.
+	Host string `json:"host,omitempty"`
+
+	// Port is the port of a `tcp` or `ssl` request.
+	Port *int64 `json:"port,omitempty"`
+
+	// DNSServer is the DNS server of a `dns` request.
+	DNSServer string `json:"dnsServer,omitempty"`
+
+	// DNSServerPort is the port of the DNS server of a `dns` request.
+	DNSServerPort *int32 `json:"dnsServerPort,omitempty"`
+
+	// Timeout is the timeout of the request, in seconds.
+	Timeout *int64 `json:"timeout,omitempty"`
+}
+
+// DatadogSyntheticTestAssertion is an assertion on the response of a request.
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestAssertion struct {
+	// Type is the type of the assertion, for example `statusCode`, `responseTime`, `header`, `body`, `certificate`,
+	// `latency`, `recordEvery` or `recordSome`.
+	Type string `json:"type"`
+
+	// Operator is the operator of the assertion, for example `is`, `lessThan`, `contains` or `isInMoreThan`.
+	Operator string `json:"operator"`
+
+	// Property is the property the assertion applies to, like the name of a header or the type of a DNS record.
+	Property string `json:"property,omitempty"`
+
+	// Target is the expected value.
+	Target string `json:"target,omitempty"`
+
+	// JSONPath is the JSON path of the value of a `body` assertion. Operator and Target then apply to this value.
+	JSONPath string `json:"jsonPath,omitempty"`
+}
+
+// DatadogSyntheticTestStep is an HTTP step of a multistep test.
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestStep struct {
+	// Name is the name of the step.
+	Name string `json:"name"`
+
+	// Request is the HTTP request of the step.
+	Request DatadogSyntheticTestRequest `json:"request"`
+
+	// Assertions is the list of assertions on the response of the request.
+	// +listType=atomic
+	Assertions []DatadogSyntheticTestAssertion `json:"assertions"`
+
+	// AllowFailure defines whether the test continues when the step fails.
+	AllowFailure *bool `json:"allowFailure,omitempty"`
+
+	// IsCritical defines whether the test fails when the step fails. Can only be used with AllowFailure.
+	IsCritical *bool `json:"isCritical,omitempty"`
+}
+
+// DatadogSyntheticTestOptions are the run and alerting options of a test.
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestOptions struct {
+	// TickEvery is the frequency of the test, in seconds, between 30 and 604800.
+	TickEvery *int64 `json:"tickEvery,omitempty"`
+
+	// MinFailureDuration is the minimum duration of a failure before the test alerts, in seconds.
+	MinFailureDuration *int64 `json:"minFailureDuration,omitempty"`
+
+	// MinLocationFailed is the minimum number of locations that must fail for the test to alert.
+	MinLocationFailed *int64 `json:"minLocationFailed,omitempty"`
+
+	// RetryCount is the number of times a failed test is retried before being marked as failed.
+	RetryCount *int64 `json:"retryCount,omitempty"`
+
+	// RetryInterval is the interval between the retries of a failed test, in milliseconds.
+	RetryInterval *int64 `json:"retryInterval,omitempty"`
+
+	// AcceptSelfSigned defines whether self-signed certificates are accepted by an `ssl` test.
+	AcceptSelfSigned *bool `json:"acceptSelfSigned,omitempty"`
+
+	// MonitorPriority is the priority of the monitor of the test, from 1 (highest) to 5 (lowest).
+	MonitorPriority *int32 `json:"monitorPriority,omitempty"`
+}
+
+// DatadogSyntheticTestControllerOptions defines options in the DatadogSyntheticTest controller.
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestControllerOptions struct {
+	// DisableRequiredTags disables the automatic addition of required tags to tests.
+	DisableRequiredTags *bool `json:"disableRequiredTags,omitempty"`
+}
+
+// DatadogSyntheticTestStatus defines the observed state of a DatadogSyntheticTest
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestStatus struct {
+	// Conditions represents the latest available observations of the state of a DatadogSyntheticTest.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the public ID of the test generated in Datadog.
+	ID string `json:"id,omitempty"`
+
+	// MonitorID is the ID of the monitor of the test.
+	MonitorID int64 `json:"monitorID,omitempty"`
+
+	// Created is the time the test was created.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// TestStatus is the status of the test in Datadog, `live` or `paused`.
+	TestStatus DatadogSyntheticTestPauseStatus `json:"testStatus,omitempty"`
+
+	// LastResult is the latest result of the test.
+	LastResult *DatadogSyntheticTestResult `json:"lastResult,omitempty"`
+
+	// ResultLastUpdateTime is the last time the latest result of the test was fetched.
+	ResultLastUpdateTime *metav1.Time `json:"resultLastUpdateTime,omitempty"`
+
+	// SyncStatus shows the health of syncing the test state to Datadog.
+	SyncStatus DatadogSyntheticTestSyncStatus `json:"syncStatus,omitempty"`
+
+	// LastForceSyncTime is the last time the API test was last force synced with the DatadogSyntheticTest resource.
+	LastForceSyncTime *metav1.Time `json:"lastForceSyncTime,omitempty"`
+
+	// CurrentHash tracks the hash of the current DatadogSyntheticTestSpec to know
+	// if the Spec has changed and needs an update.
+	CurrentHash string `json:"currentHash,omitempty"`
+}
+
+// DatadogSyntheticTestResult is a result of a test run.
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestResult struct {
+	// ID is the ID of the result.
+	ID string `json:"id,omitempty"`
+
+	// Status is the status of the result, `Passed` or `Failed`.
+	Status DatadogSyntheticTestResultStatus `json:"status,omitempty"`
+
+	// Location is the location the test ran from.
+	Location string `json:"location,omitempty"`
+
+	// CheckTime is the time the test ran.
+	CheckTime *metav1.Time `json:"checkTime,omitempty"`
+}
+
+// DatadogSyntheticTestPauseStatus is the status of a test in Datadog.
+type DatadogSyntheticTestPauseStatus string
+
+const (
+	// DatadogSyntheticTestPauseStatusLive means the test is running.
+	DatadogSyntheticTestPauseStatusLive DatadogSyntheticTestPauseStatus = "live"
+	// DatadogSyntheticTestPauseStatusPaused means the test is paused.
+	DatadogSyntheticTestPauseStatusPaused DatadogSyntheticTestPauseStatus = "paused"
+)
+
+// DatadogSyntheticTestResultStatus is the status of a test result.
+type DatadogSyntheticTestResultStatus string
+
+const (
+	// DatadogSyntheticTestResultStatusPassed means the test passed.
+	DatadogSyntheticTestResultStatusPassed DatadogSyntheticTestResultStatus = "Passed"
+	// DatadogSyntheticTestResultStatusFailed means the test failed.
+	DatadogSyntheticTestResultStatusFailed DatadogSyntheticTestResultStatus = "Failed"
+)
+
+// DatadogSyntheticTestSyncStatus is the message reflecting the health of test state syncs to Datadog.
+type DatadogSyntheticTestSyncStatus string
+
+const (
+	// DatadogSyntheticTestSyncStatusOK means syncing is OK.
+	DatadogSyntheticTestSyncStatusOK DatadogSyntheticTestSyncStatus = "OK"
+	// DatadogSyntheticTestSyncStatusValidateError means there is a test validation error.
+	DatadogSyntheticTestSyncStatusValidateError DatadogSyntheticTestSyncStatus = "error validating test"
+	// DatadogSyntheticTestSyncStatusUpdateError means there is a test update error.
+	DatadogSyntheticTestSyncStatusUpdateError DatadogSyntheticTestSyncStatus = "error updating test"
+	// DatadogSyntheticTestSyncStatusCreateError means there is an error creating the test.
+	DatadogSyntheticTestSyncStatusCreateError DatadogSyntheticTestSyncStatus = "error creating test"
+	// DatadogSyntheticTestSyncStatusGetError means there is an error getting the test.
+	DatadogSyntheticTestSyncStatusGetError DatadogSyntheticTestSyncStatus = "error getting test"
+)
+
+// DatadogSyntheticTest allows to define and manage Datadog Synthetic API tests from your Kubernetes Cluster.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=datadogsynthetictests,scope=Namespaced,shortName=ddst
+// +kubebuilder:printcolumn:name="id",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="subtype",type="string",JSONPath=".spec.subtype"
+// +kubebuilder:printcolumn:name="test status",type="string",JSONPath=".status.testStatus"
+// +kubebuilder:printcolumn:name="last result",type="string",JSONPath=".status.lastResult.status"
+// +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogSyntheticTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogSyntheticTestSpec   `json:"spec,omitempty"`
+	Status DatadogSyntheticTestStatus `json:"status,omitempty"`
+}
+
+// DatadogSyntheticTestList contains a list of DatadogSyntheticTests.
+// +kubebuilder:object:root=true
+type DatadogSyntheticTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogSyntheticTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogSyntheticTest{}, &DatadogSyntheticTestList{})
+}
@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// IsValidDatadogSyntheticTest use to check if a DatadogSyntheticTestSpec is valid by checking
+// that the required fields of its subtype are defined
+func IsValidDatadogSyntheticTest(spec *DatadogSyntheticTestSpec) error {
+	var errs []error
+
+	if spec.Name == "" {
+		errs = append(errs, fmt.Errorf("spec.Name must be defined"))
+	}
+
+	if len(spec.Locations) == 0 {
+		errs = append(errs, fmt.Errorf("spec.Locations must be defined"))
+	}
+
+	switch spec.Subtype {
+	case DatadogSyntheticTestSubtypeMulti:
+		if spec.Request != nil || len(spec.Assertions) > 0 {
+			errs = append(errs, fmt.Errorf("spec.Request and spec.Assertions can't be used with the multi subtype, use spec.Steps"))
+		}
+		if len(spec.Steps) == 0 {
+			errs = append(errs, fmt.Errorf("spec.Steps must be defined for the multi subtype"))
+		}
+		for i, step := range spec.Steps {
+			field := fmt.Sprintf("spec.Steps[%d]", i)
+			if step.Name == "" {
+				errs = append(errs, fmt.Errorf("%s.Name must be defined", field))
+			}
+			errs = append(errs, validateSyntheticTestRequest(field+".Request", DatadogSyntheticTestSubtypeHTTP, &step.Request)...)
+			errs = append(errs, validateSyntheticTestAssertions(field+".Assertions", step.Assertions)...)
+			if step.IsCritical != nil && (step.AllowFailure == nil || !*step.AllowFailure) {
+				errs = append(errs, fmt.Errorf("%s.IsCritical can only be used with %s.AllowFailure", field, field))
+			}
+		}
+	case DatadogSyntheticTestSubtypeHTTP, DatadogSyntheticTestSubtypeTCP, DatadogSyntheticTestSubtypeDNS, DatadogSyntheticTestSubtypeSSL:
+		if len(spec.Steps) > 0 {
+			errs = append(errs, fmt.Errorf("spec.Steps can only be used with the multi subtype"))
+		}
+		if spec.Request == nil {
+			errs = append(errs, fmt.Errorf("spec.Request must be defined"))
+		} else {
+			errs = append(errs, validateSyntheticTestRequest("spec.Request", spec.Subtype, spec.Request)...)
+		}
+		errs = append(errs, validateSyntheticTestAssertions("spec.Assertions", spec.Assertions)...)
+	default:
+		errs = append(errs, fmt.Errorf("spec.Subtype must be one of the values: %s, %s, %s, %s or %s", DatadogSyntheticTestSubtypeHTTP, DatadogSyntheticTestSubtypeTCP, DatadogSyntheticTestSubtypeDNS, DatadogSyntheticTestSubtypeSSL, DatadogSyntheticTestSubtypeMulti))
+	}
+
+	return utilserrors.NewAggregate(errs)
+}
+
+func validateSyntheticTestRequest(field string, subtype DatadogSyntheticTestSubtype, request *DatadogSyntheticTestRequest) []error {
+	var errs []error
+
+	switch subtype {
+	case DatadogSyntheticTestSubtypeHTTP:
+		if request.URL == "" {
+			errs = append(errs, fmt.Errorf("%s.URL must be defined", field))
+		}
+	case DatadogSyntheticTestSubtypeTCP, DatadogSyntheticTestSubtypeSSL:
+		if request.Host == "" || request.Port == nil {
+			errs = append(errs, fmt.Errorf("%s.Host and %s.Port must be defined", field, field))
+		}
+	case DatadogSyntheticTestSubtypeDNS:
+		if request.Host == "" {
+			errs = append(errs, fmt.Errorf("%s.Host must be defined", field))
+		}
+	}
+
+	return errs
+}
+
+func validateSyntheticTestAssertions(field string, assertions []DatadogSyntheticTestAssertion) []error {
+	var errs []error
+
+	if len(assertions) == 0 {
+		errs = append(errs, fmt.Errorf("%s must be defined", field))
+	}
+	for i, assertion := range assertions {
+		if _, err := datadogV1.NewSyntheticsAssertionTypeFromValue(assertion.Type); err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d].Type %q is not supported", field, i, assertion.Type))
+		}
+		if _, err := datadogV1.NewSyntheticsAssertionOperatorFromValue(assertion.Operator); err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d].Operator %q is not supported", field, i, assertion.Operator))
+		}
+		if assertion.Target == "" && assertion.Operator != string(datadogV1.SYNTHETICSASSERTIONOPERATOR_DOES_NOT_EXIST) && assertion.Operator != string(datadogV1.SYNTHETICSASSERTIONOPERATOR_IS_UNDEFINED) {
+			errs = append(errs, fmt.Errorf("%s[%d].Target must be defined", field, i))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,127 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+func TestIsValidDatadogSyntheticTest(t *testing.T) {
+	port := int64(443)
+	isCritical := true
+	statusCode := DatadogSyntheticTestAssertion{Type: "statusCode", Operator: "is", Target: "200"}
+
+	tests := []struct {
+		name     string
+		spec     *DatadogSyntheticTestSpec
+		expected error
+	}{
+		{
+			name: "Valid http test",
+			spec: &DatadogSyntheticTestSpec{
+				Name:       "Health",
+				Subtype:    DatadogSyntheticTestSubtypeHTTP,
+				Locations:  []string{"aws:eu-west-1"},
+				Request:    &DatadogSyntheticTestRequest{Method: "GET", URL: "https://example.com"},
+				Assertions: []DatadogSyntheticTestAssertion{statusCode},
+			},
+			expected: nil,
+		},
+		{
+			name: "Valid ssl test",
+			spec: &DatadogSyntheticTestSpec{
+				Name:       "Certificate",
+				Subtype:    DatadogSyntheticTestSubtypeSSL,
+				Locations:  []string{"aws:eu-west-1"},
+				Request:    &DatadogSyntheticTestRequest{Host: "example.com", Port: &port},
+				Assertions: []DatadogSyntheticTestAssertion{{Type: "certificate", Operator: "isInMoreThan", Target: "30"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "Valid multistep test",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Checkout",
+				Subtype:   DatadogSyntheticTestSubtypeMulti,
+				Locations: []string{"aws:eu-west-1"},
+				Steps: []DatadogSyntheticTestStep{
+					{Name: "Login", Request: DatadogSyntheticTestRequest{URL: "https://example.com/login"}, Assertions: []DatadogSyntheticTestAssertion{statusCode}},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing required fields",
+			spec: &DatadogSyntheticTestSpec{},
+			expected: utilserrors.NewAggregate([]error{
+				errors.New("spec.Name must be defined"),
+				errors.New("spec.Locations must be defined"),
+				errors.New("spec.Subtype must be one of the values: http, tcp, dns, ssl or multi"),
+			}),
+		},
+		{
+			name: "tcp test without port nor assertions",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Database",
+				Subtype:   DatadogSyntheticTestSubtypeTCP,
+				Locations: []string{"aws:eu-west-1"},
+				Request:   &DatadogSyntheticTestRequest{Host: "db.example.com"},
+			},
+			expected: utilserrors.NewAggregate([]error{
+				errors.New("spec.Request.Host and spec.Request.Port must be defined"),
+				errors.New("spec.Assertions must be defined"),
+			}),
+		},
+		{
+			name: "Unsupported assertion",
+			spec: &DatadogSyntheticTestSpec{
+				Name:       "Health",
+				Subtype:    DatadogSyntheticTestSubtypeHTTP,
+				Locations:  []string{"aws:eu-west-1"},
+				Request:    &DatadogSyntheticTestRequest{URL: "https://example.com"},
+				Assertions: []DatadogSyntheticTestAssertion{{Type: "status", Operator: "equals"}},
+			},
+			expected: utilserrors.NewAggregate([]error{
+				errors.New(`spec.Assertions[0].Type "status" is not supported`),
+				errors.New(`spec.Assertions[0].Operator "equals" is not supported`),
+				errors.New("spec.Assertions[0].Target must be defined"),
+			}),
+		},
+		{
+			name: "Multistep test with a request and an invalid step",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Checkout",
+				Subtype:   DatadogSyntheticTestSubtypeMulti,
+				Locations: []string{"aws:eu-west-1"},
+				Request:   &DatadogSyntheticTestRequest{URL: "https://example.com"},
+				Steps: []DatadogSyntheticTestStep{
+					{Assertions: []DatadogSyntheticTestAssertion{statusCode}, IsCritical: &isCritical},
+				},
+			},
+			expected: utilserrors.NewAggregate([]error{
+				errors.New("spec.Request and spec.Assertions can't be used with the multi subtype, use spec.Steps"),
+				errors.New("spec.Steps[0].Name must be defined"),
+				errors.New("spec.Steps[0].Request.URL must be defined"),
+				errors.New("spec.Steps[0].IsCritical can only be used with spec.Steps[0].AllowFailure"),
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidDatadogSyntheticTest(tt.spec)
+			if tt.expected != nil {
+				assert.EqualError(t, result, tt.expected.Error())
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
@@ -75,6 +75,179 @@ func (in *Container) DeepCopy() *Container {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardConfigMapReference) DeepCopyInto(out *DashboardConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardConfigMapReference.
+func (in *DashboardConfigMapReference) DeepCopy() *DashboardConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardGroupWidget) DeepCopyInto(out *DashboardGroupWidget) {
+	*out = *in
+	in.DashboardWidgetDefinition.DeepCopyInto(&out.DashboardWidgetDefinition)
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(DashboardWidgetLayout)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardGroupWidget.
+func (in *DashboardGroupWidget) DeepCopy() *DashboardGroupWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardGroupWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardGroupWidgetDefinition) DeepCopyInto(out *DashboardGroupWidgetDefinition) {
+	*out = *in
+	if in.ShowTitle != nil {
+		in, out := &in.ShowTitle, &out.ShowTitle
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Widgets != nil {
+		in, out := &in.Widgets, &out.Widgets
+		*out = make([]DashboardGroupWidget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardGroupWidgetDefinition.
+func (in *DashboardGroupWidgetDefinition) DeepCopy() *DashboardGroupWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardGroupWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardMonitorSummaryWidgetDefinition) DeepCopyInto(out *DashboardMonitorSummaryWidgetDefinition) {
+	*out = *in
+	if in.HideZeroCounts != nil {
+		in, out := &in.HideZeroCounts, &out.HideZeroCounts
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardMonitorSummaryWidgetDefinition.
+func (in *DashboardMonitorSummaryWidgetDefinition) DeepCopy() *DashboardMonitorSummaryWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardMonitorSummaryWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardNoteWidgetDefinition) DeepCopyInto(out *DashboardNoteWidgetDefinition) {
+	*out = *in
+	if in.ShowTick != nil {
+		in, out := &in.ShowTick, &out.ShowTick
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardNoteWidgetDefinition.
+func (in *DashboardNoteWidgetDefinition) DeepCopy() *DashboardNoteWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardNoteWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardQueryValueWidgetDefinition) DeepCopyInto(out *DashboardQueryValueWidgetDefinition) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make([]DashboardQueryValueWidgetRequest, len(*in))
+		copy(*out, *in)
+	}
+	if in.Precision != nil {
+		in, out := &in.Precision, &out.Precision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Autoscale != nil {
+		in, out := &in.Autoscale, &out.Autoscale
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardQueryValueWidgetDefinition.
+func (in *DashboardQueryValueWidgetDefinition) DeepCopy() *DashboardQueryValueWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardQueryValueWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardQueryValueWidgetRequest) DeepCopyInto(out *DashboardQueryValueWidgetRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardQueryValueWidgetRequest.
+func (in *DashboardQueryValueWidgetRequest) DeepCopy() *DashboardQueryValueWidgetRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardQueryValueWidgetRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardSLOWidgetDefinition) DeepCopyInto(out *DashboardSLOWidgetDefinition) {
+	*out = *in
+	if in.TimeWindows != nil {
+		in, out := &in.TimeWindows, &out.TimeWindows
+		*out = make([]DashboardWidgetTimeWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.ShowErrorBudget != nil {
+		in, out := &in.ShowErrorBudget, &out.ShowErrorBudget
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardSLOWidgetDefinition.
+func (in *DashboardSLOWidgetDefinition) DeepCopy() *DashboardSLOWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardSLOWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardTemplateVariable) DeepCopyInto(out *DashboardTemplateVariable) {
 	*out = *in
@@ -161,6 +334,167 @@ func (in *DashboardTemplateVariablePresetValue) DeepCopy() *DashboardTemplateVar
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTimeseriesWidgetDefinition) DeepCopyInto(out *DashboardTimeseriesWidgetDefinition) {
+	*out = *in
+	if in.ShowLegend != nil {
+		in, out := &in.ShowLegend, &out.ShowLegend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make([]DashboardTimeseriesWidgetRequest, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTimeseriesWidgetDefinition.
+func (in *DashboardTimeseriesWidgetDefinition) DeepCopy() *DashboardTimeseriesWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTimeseriesWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTimeseriesWidgetRequest) DeepCopyInto(out *DashboardTimeseriesWidgetRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTimeseriesWidgetRequest.
+func (in *DashboardTimeseriesWidgetRequest) DeepCopy() *DashboardTimeseriesWidgetRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTimeseriesWidgetRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardToplistWidgetDefinition) DeepCopyInto(out *DashboardToplistWidgetDefinition) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make([]DashboardToplistWidgetRequest, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardToplistWidgetDefinition.
+func (in *DashboardToplistWidgetDefinition) DeepCopy() *DashboardToplistWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardToplistWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardToplistWidgetRequest) DeepCopyInto(out *DashboardToplistWidgetRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardToplistWidgetRequest.
+func (in *DashboardToplistWidgetRequest) DeepCopy() *DashboardToplistWidgetRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardToplistWidgetRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidget) DeepCopyInto(out *DashboardWidget) {
+	*out = *in
+	in.DashboardWidgetDefinition.DeepCopyInto(&out.DashboardWidgetDefinition)
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(DashboardGroupWidgetDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(DashboardWidgetLayout)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidget.
+func (in *DashboardWidget) DeepCopy() *DashboardWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidgetDefinition) DeepCopyInto(out *DashboardWidgetDefinition) {
+	*out = *in
+	if in.Timeseries != nil {
+		in, out := &in.Timeseries, &out.Timeseries
+		*out = new(DashboardTimeseriesWidgetDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryValue != nil {
+		in, out := &in.QueryValue, &out.QueryValue
+		*out = new(DashboardQueryValueWidgetDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Toplist != nil {
+		in, out := &in.Toplist, &out.Toplist
+		*out = new(DashboardToplistWidgetDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Note != nil {
+		in, out := &in.Note, &out.Note
+		*out = new(DashboardNoteWidgetDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(DashboardSLOWidgetDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MonitorSummary != nil {
+		in, out := &in.MonitorSummary, &out.MonitorSummary
+		*out = new(DashboardMonitorSummaryWidgetDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidgetDefinition.
+func (in *DashboardWidgetDefinition) DeepCopy() *DashboardWidgetDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidgetDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidgetLayout) DeepCopyInto(out *DashboardWidgetLayout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidgetLayout.
+func (in *DashboardWidgetLayout) DeepCopy() *DashboardWidgetLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidgetLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogAgentProfile) DeepCopyInto(out *DatadogAgentProfile) {
 	*out = *in
@@ -276,6 +610,21 @@ func (in *DatadogAgentProfileStatus) DeepCopy() *DatadogAgentProfileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogCredentialsReference) DeepCopyInto(out *DatadogCredentialsReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogCredentialsReference.
+func (in *DatadogCredentialsReference) DeepCopy() *DatadogCredentialsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogCredentialsReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogDashboard) DeepCopyInto(out *DatadogDashboard) {
 	*out = *in
@@ -303,6 +652,21 @@ func (in *DatadogDashboard) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDashboardControllerOptions) DeepCopyInto(out *DatadogDashboardControllerOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardControllerOptions.
+func (in *DatadogDashboardControllerOptions) DeepCopy() *DatadogDashboardControllerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDashboardControllerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogDashboardList) DeepCopyInto(out *DatadogDashboardList) {
 	*out = *in
@@ -367,16 +731,45 @@ func (in *DatadogDashboardSpec) DeepCopyInto(out *DatadogDashboardSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardSpec.
-func (in *DatadogDashboardSpec) DeepCopy() *DatadogDashboardSpec {
-	if in == nil {
-		return nil
+	if in.TypedWidgets != nil {
+		in, out := &in.TypedWidgets, &out.TypedWidgets
+		*out = make([]DashboardWidget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(DatadogDashboardSpec)
-	in.DeepCopyInto(out)
-	return out
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(DashboardConfigMapReference)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ControllerOptions != nil {
+		in, out := &in.ControllerOptions, &out.ControllerOptions
+		*out = new(DatadogDashboardControllerOptions)
+		**out = **in
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(DatadogCredentialsReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardSpec.
+func (in *DatadogDashboardSpec) DeepCopy() *DatadogDashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDashboardSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -409,6 +802,261 @@ func (in *DatadogDashboardStatus) DeepCopy() *DatadogDashboardStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntime) DeepCopyInto(out *DatadogDowntime) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntime.
+func (in *DatadogDowntime) DeepCopy() *DatadogDowntime {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogDowntime) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeKubernetesScope) DeepCopyInto(out *DatadogDowntimeKubernetesScope) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatefulSets != nil {
+		in, out := &in.StatefulSets, &out.StatefulSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DaemonSets != nil {
+		in, out := &in.DaemonSets, &out.DaemonSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeKubernetesScope.
+func (in *DatadogDowntimeKubernetesScope) DeepCopy() *DatadogDowntimeKubernetesScope {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeKubernetesScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeList) DeepCopyInto(out *DatadogDowntimeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogDowntime, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeList.
+func (in *DatadogDowntimeList) DeepCopy() *DatadogDowntimeList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogDowntimeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeMonitorReference) DeepCopyInto(out *DatadogDowntimeMonitorReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeMonitorReference.
+func (in *DatadogDowntimeMonitorReference) DeepCopy() *DatadogDowntimeMonitorReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeMonitorReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeRecurrence) DeepCopyInto(out *DatadogDowntimeRecurrence) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeRecurrence.
+func (in *DatadogDowntimeRecurrence) DeepCopy() *DatadogDowntimeRecurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeRecurrence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeSchedule) DeepCopyInto(out *DatadogDowntimeSchedule) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Recurrences != nil {
+		in, out := &in.Recurrences, &out.Recurrences
+		*out = make([]DatadogDowntimeRecurrence, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeSchedule.
+func (in *DatadogDowntimeSchedule) DeepCopy() *DatadogDowntimeSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeSpec) DeepCopyInto(out *DatadogDowntimeSpec) {
+	*out = *in
+	if in.KubernetesScope != nil {
+		in, out := &in.KubernetesScope, &out.KubernetesScope
+		*out = new(DatadogDowntimeKubernetesScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MonitorTags != nil {
+		in, out := &in.MonitorTags, &out.MonitorTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MonitorRefs != nil {
+		in, out := &in.MonitorRefs, &out.MonitorRefs
+		*out = make([]DatadogDowntimeMonitorReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.MonitorSelector != nil {
+		in, out := &in.MonitorSelector, &out.MonitorSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(DatadogDowntimeSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MuteFirstRecoveryNotification != nil {
+		in, out := &in.MuteFirstRecoveryNotification, &out.MuteFirstRecoveryNotification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NotifyEndStates != nil {
+		in, out := &in.NotifyEndStates, &out.NotifyEndStates
+		*out = make([]DatadogDowntimeNotifyEndState, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotifyEndTypes != nil {
+		in, out := &in.NotifyEndTypes, &out.NotifyEndTypes
+		*out = make([]DatadogDowntimeNotifyEndType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeSpec.
+func (in *DatadogDowntimeSpec) DeepCopy() *DatadogDowntimeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeStatus) DeepCopyInto(out *DatadogDowntimeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.MonitorIDs != nil {
+		in, out := &in.MonitorIDs, &out.MonitorIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.CurrentStart != nil {
+		in, out := &in.CurrentStart, &out.CurrentStart
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentEnd != nil {
+		in, out := &in.CurrentEnd, &out.CurrentEnd
+		*out = (*in).DeepCopy()
+	}
+	if in.LastForceSyncTime != nil {
+		in, out := &in.LastForceSyncTime, &out.LastForceSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeStatus.
+func (in *DatadogDowntimeStatus) DeepCopy() *DatadogDowntimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
 	*out = *in
@@ -591,6 +1239,15 @@ func (in *DatadogMonitorControllerOptions) DeepCopy() *DatadogMonitorControllerO
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMonitorDowntimeStatus) DeepCopyInto(out *DatadogMonitorDowntimeStatus) {
 	*out = *in
+	if in.RolloutWorkloads != nil {
+		in, out := &in.RolloutWorkloads, &out.RolloutWorkloads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RolloutDowntimeEnd != nil {
+		in, out := &in.RolloutDowntimeEnd, &out.RolloutDowntimeEnd
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorDowntimeStatus.
@@ -604,31 +1261,79 @@ func (in *DatadogMonitorDowntimeStatus) DeepCopy() *DatadogMonitorDowntimeStatus
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorList) DeepCopyInto(out *DatadogMonitorList) {
+func (in *DatadogMonitorGroup) DeepCopyInto(out *DatadogMonitorGroup) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorGroup.
+func (in *DatadogMonitorGroup) DeepCopy() *DatadogMonitorGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorGroups) DeepCopyInto(out *DatadogMonitorGroups) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]DatadogMonitorGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorGroups.
+func (in *DatadogMonitorGroups) DeepCopy() *DatadogMonitorGroups {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorGroups)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMonitorGroups) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorGroupsList) DeepCopyInto(out *DatadogMonitorGroupsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DatadogMonitor, len(*in))
+		*out = make([]DatadogMonitorGroups, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorList.
-func (in *DatadogMonitorList) DeepCopy() *DatadogMonitorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorGroupsList.
+func (in *DatadogMonitorGroupsList) DeepCopy() *DatadogMonitorGroupsList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorList)
+	out := new(DatadogMonitorGroupsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogMonitorList) DeepCopyObject() runtime.Object {
+func (in *DatadogMonitorGroupsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -636,13 +1341,45 @@ func (in *DatadogMonitorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorOptions) DeepCopyInto(out *DatadogMonitorOptions) {
+func (in *DatadogMonitorList) DeepCopyInto(out *DatadogMonitorList) {
 	*out = *in
-	if in.EnableLogsSample != nil {
-		in, out := &in.EnableLogsSample, &out.EnableLogsSample
-		*out = new(bool)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogMonitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorList.
+func (in *DatadogMonitorList) DeepCopy() *DatadogMonitorList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorOptions) DeepCopyInto(out *DatadogMonitorOptions) {
+	*out = *in
+	if in.EnableLogsSample != nil {
+		in, out := &in.EnableLogsSample, &out.EnableLogsSample
+		*out = new(bool)
+		**out = **in
+	}
 	if in.EscalationMessage != nil {
 		in, out := &in.EscalationMessage, &out.EscalationMessage
 		*out = new(string)
@@ -810,6 +1547,36 @@ func (in *DatadogMonitorOptionsThresholds) DeepCopy() *DatadogMonitorOptionsThre
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorRolloutMute) DeepCopyInto(out *DatadogMonitorRolloutMute) {
+	*out = *in
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatefulSets != nil {
+		in, out := &in.StatefulSets, &out.StatefulSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorRolloutMute.
+func (in *DatadogMonitorRolloutMute) DeepCopy() *DatadogMonitorRolloutMute {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorRolloutMute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMonitorSpec) DeepCopyInto(out *DatadogMonitorSpec) {
 	*out = *in
@@ -825,6 +1592,16 @@ func (in *DatadogMonitorSpec) DeepCopyInto(out *DatadogMonitorSpec) {
 	}
 	in.Options.DeepCopyInto(&out.Options)
 	in.ControllerOptions.DeepCopyInto(&out.ControllerOptions)
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(DatadogCredentialsReference)
+		**out = **in
+	}
+	if in.RolloutMute != nil {
+		in, out := &in.RolloutMute, &out.RolloutMute
+		*out = new(DatadogMonitorRolloutMute)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorSpec.
@@ -870,7 +1647,7 @@ func (in *DatadogMonitorStatus) DeepCopyInto(out *DatadogMonitorStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.DowntimeStatus = in.DowntimeStatus
+	in.DowntimeStatus.DeepCopyInto(&out.DowntimeStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorStatus.
@@ -883,6 +1660,157 @@ func (in *DatadogMonitorStatus) DeepCopy() *DatadogMonitorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorTemplate) DeepCopyInto(out *DatadogMonitorTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTemplate.
+func (in *DatadogMonitorTemplate) DeepCopy() *DatadogMonitorTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMonitorTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorTemplateList) DeepCopyInto(out *DatadogMonitorTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogMonitorTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTemplateList.
+func (in *DatadogMonitorTemplateList) DeepCopy() *DatadogMonitorTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMonitorTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorTemplateMonitor) DeepCopyInto(out *DatadogMonitorTemplateMonitor) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTemplateMonitor.
+func (in *DatadogMonitorTemplateMonitor) DeepCopy() *DatadogMonitorTemplateMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorTemplateMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorTemplateSelector) DeepCopyInto(out *DatadogMonitorTemplateSelector) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]DatadogMonitorTemplateWorkloadKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTemplateSelector.
+func (in *DatadogMonitorTemplateSelector) DeepCopy() *DatadogMonitorTemplateSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorTemplateSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorTemplateSpec) DeepCopyInto(out *DatadogMonitorTemplateSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.MonitorTemplate.DeepCopyInto(&out.MonitorTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTemplateSpec.
+func (in *DatadogMonitorTemplateSpec) DeepCopy() *DatadogMonitorTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorTemplateStatus) DeepCopyInto(out *DatadogMonitorTemplateStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTemplateStatus.
+func (in *DatadogMonitorTemplateStatus) DeepCopy() *DatadogMonitorTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMonitorTriggeredState) DeepCopyInto(out *DatadogMonitorTriggeredState) {
 	*out = *in
@@ -1526,31 +2454,58 @@ func (in *DatadogSLOControllerOptions) DeepCopy() *DatadogSLOControllerOptions {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOList) DeepCopyInto(out *DatadogSLOList) {
+func (in *DatadogSLOCorrection) DeepCopyInto(out *DatadogSLOCorrection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOCorrection.
+func (in *DatadogSLOCorrection) DeepCopy() *DatadogSLOCorrection {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOCorrection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogSLOCorrection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOCorrectionList) DeepCopyInto(out *DatadogSLOCorrectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DatadogSLO, len(*in))
+		*out = make([]DatadogSLOCorrection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOList.
-func (in *DatadogSLOList) DeepCopy() *DatadogSLOList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOCorrectionList.
+func (in *DatadogSLOCorrectionList) DeepCopy() *DatadogSLOCorrectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOList)
+	out := new(DatadogSLOCorrectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogSLOList) DeepCopyObject() runtime.Object {
+func (in *DatadogSLOCorrectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1558,73 +2513,53 @@ func (in *DatadogSLOList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOQuery) DeepCopyInto(out *DatadogSLOQuery) {
+func (in *DatadogSLOCorrectionSLOReference) DeepCopyInto(out *DatadogSLOCorrectionSLOReference) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOQuery.
-func (in *DatadogSLOQuery) DeepCopy() *DatadogSLOQuery {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOCorrectionSLOReference.
+func (in *DatadogSLOCorrectionSLOReference) DeepCopy() *DatadogSLOCorrectionSLOReference {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOQuery)
+	out := new(DatadogSLOCorrectionSLOReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOSpec) DeepCopyInto(out *DatadogSLOSpec) {
+func (in *DatadogSLOCorrectionSpec) DeepCopyInto(out *DatadogSLOCorrectionSpec) {
 	*out = *in
+	out.SLORef = in.SLORef
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.MonitorIDs != nil {
-		in, out := &in.MonitorIDs, &out.MonitorIDs
-		*out = make([]int64, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	in.Start.DeepCopyInto(&out.Start)
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
 	}
-	if in.Query != nil {
-		in, out := &in.Query, &out.Query
-		*out = new(DatadogSLOQuery)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	out.TargetThreshold = in.TargetThreshold.DeepCopy()
-	if in.WarningThreshold != nil {
-		in, out := &in.WarningThreshold, &out.WarningThreshold
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.ControllerOptions != nil {
-		in, out := &in.ControllerOptions, &out.ControllerOptions
-		*out = new(DatadogSLOControllerOptions)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOSpec.
-func (in *DatadogSLOSpec) DeepCopy() *DatadogSLOSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOCorrectionSpec.
+func (in *DatadogSLOCorrectionSpec) DeepCopy() *DatadogSLOCorrectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOSpec)
+	out := new(DatadogSLOCorrectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOStatus) DeepCopyInto(out *DatadogSLOStatus) {
+func (in *DatadogSLOCorrectionStatus) DeepCopyInto(out *DatadogSLOCorrectionStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1643,12 +2578,562 @@ func (in *DatadogSLOStatus) DeepCopyInto(out *DatadogSLOStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOStatus.
-func (in *DatadogSLOStatus) DeepCopy() *DatadogSLOStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOCorrectionStatus.
+func (in *DatadogSLOCorrectionStatus) DeepCopy() *DatadogSLOCorrectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOStatus)
+	out := new(DatadogSLOCorrectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOList) DeepCopyInto(out *DatadogSLOList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogSLO, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOList.
+func (in *DatadogSLOList) DeepCopy() *DatadogSLOList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogSLOList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOMonitorReference) DeepCopyInto(out *DatadogSLOMonitorReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOMonitorReference.
+func (in *DatadogSLOMonitorReference) DeepCopy() *DatadogSLOMonitorReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOMonitorReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOQuery) DeepCopyInto(out *DatadogSLOQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOQuery.
+func (in *DatadogSLOQuery) DeepCopy() *DatadogSLOQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOSpec) DeepCopyInto(out *DatadogSLOSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MonitorIDs != nil {
+		in, out := &in.MonitorIDs, &out.MonitorIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.MonitorRefs != nil {
+		in, out := &in.MonitorRefs, &out.MonitorRefs
+		*out = make([]DatadogSLOMonitorReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(DatadogSLOQuery)
+		**out = **in
+	}
+	if in.TimeSlice != nil {
+		in, out := &in.TimeSlice, &out.TimeSlice
+		*out = new(DatadogSLOTimeSlice)
+		(*in).DeepCopyInto(*out)
+	}
+	out.TargetThreshold = in.TargetThreshold.DeepCopy()
+	if in.WarningThreshold != nil {
+		in, out := &in.WarningThreshold, &out.WarningThreshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ControllerOptions != nil {
+		in, out := &in.ControllerOptions, &out.ControllerOptions
+		*out = new(DatadogSLOControllerOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(DatadogCredentialsReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOSpec.
+func (in *DatadogSLOSpec) DeepCopy() *DatadogSLOSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOStatus) DeepCopyInto(out *DatadogSLOStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.LastForceSyncTime != nil {
+		in, out := &in.LastForceSyncTime, &out.LastForceSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MonitorIDs != nil {
+		in, out := &in.MonitorIDs, &out.MonitorIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.SLIValue != nil {
+		in, out := &in.SLIValue, &out.SLIValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorBudgetRemaining != nil {
+		in, out := &in.ErrorBudgetRemaining, &out.ErrorBudgetRemaining
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateLastUpdateTime != nil {
+		in, out := &in.StateLastUpdateTime, &out.StateLastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOStatus.
+func (in *DatadogSLOStatus) DeepCopy() *DatadogSLOStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOTimeSlice) DeepCopyInto(out *DatadogSLOTimeSlice) {
+	*out = *in
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make([]DatadogSLOTimeSliceQuery, len(*in))
+		copy(*out, *in)
+	}
+	out.Threshold = in.Threshold.DeepCopy()
+	if in.QueryIntervalSeconds != nil {
+		in, out := &in.QueryIntervalSeconds, &out.QueryIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOTimeSlice.
+func (in *DatadogSLOTimeSlice) DeepCopy() *DatadogSLOTimeSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOTimeSlice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOTimeSliceQuery) DeepCopyInto(out *DatadogSLOTimeSliceQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOTimeSliceQuery.
+func (in *DatadogSLOTimeSliceQuery) DeepCopy() *DatadogSLOTimeSliceQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOTimeSliceQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTest) DeepCopyInto(out *DatadogSyntheticTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTest.
+func (in *DatadogSyntheticTest) DeepCopy() *DatadogSyntheticTest {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogSyntheticTest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestAssertion) DeepCopyInto(out *DatadogSyntheticTestAssertion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestAssertion.
+func (in *DatadogSyntheticTestAssertion) DeepCopy() *DatadogSyntheticTestAssertion {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestAssertion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestControllerOptions) DeepCopyInto(out *DatadogSyntheticTestControllerOptions) {
+	*out = *in
+	if in.DisableRequiredTags != nil {
+		in, out := &in.DisableRequiredTags, &out.DisableRequiredTags
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestControllerOptions.
+func (in *DatadogSyntheticTestControllerOptions) DeepCopy() *DatadogSyntheticTestControllerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestControllerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestList) DeepCopyInto(out *DatadogSyntheticTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogSyntheticTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestList.
+func (in *DatadogSyntheticTestList) DeepCopy() *DatadogSyntheticTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogSyntheticTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestOptions) DeepCopyInto(out *DatadogSyntheticTestOptions) {
+	*out = *in
+	if in.TickEvery != nil {
+		in, out := &in.TickEvery, &out.TickEvery
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinFailureDuration != nil {
+		in, out := &in.MinFailureDuration, &out.MinFailureDuration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinLocationFailed != nil {
+		in, out := &in.MinLocationFailed, &out.MinLocationFailed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryCount != nil {
+		in, out := &in.RetryCount, &out.RetryCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AcceptSelfSigned != nil {
+		in, out := &in.AcceptSelfSigned, &out.AcceptSelfSigned
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MonitorPriority != nil {
+		in, out := &in.MonitorPriority, &out.MonitorPriority
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestOptions.
+func (in *DatadogSyntheticTestOptions) DeepCopy() *DatadogSyntheticTestOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestRequest) DeepCopyInto(out *DatadogSyntheticTestRequest) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DNSServerPort != nil {
+		in, out := &in.DNSServerPort, &out.DNSServerPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestRequest.
+func (in *DatadogSyntheticTestRequest) DeepCopy() *DatadogSyntheticTestRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestResult) DeepCopyInto(out *DatadogSyntheticTestResult) {
+	*out = *in
+	if in.CheckTime != nil {
+		in, out := &in.CheckTime, &out.CheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestResult.
+func (in *DatadogSyntheticTestResult) DeepCopy() *DatadogSyntheticTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestSpec) DeepCopyInto(out *DatadogSyntheticTestSpec) {
+	*out = *in
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(DatadogSyntheticTestRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Assertions != nil {
+		in, out := &in.Assertions, &out.Assertions
+		*out = make([]DatadogSyntheticTestAssertion, len(*in))
+		copy(*out, *in)
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DatadogSyntheticTestStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(DatadogSyntheticTestOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControllerOptions != nil {
+		in, out := &in.ControllerOptions, &out.ControllerOptions
+		*out = new(DatadogSyntheticTestControllerOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestSpec.
+func (in *DatadogSyntheticTestSpec) DeepCopy() *DatadogSyntheticTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestStatus) DeepCopyInto(out *DatadogSyntheticTestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.LastResult != nil {
+		in, out := &in.LastResult, &out.LastResult
+		*out = new(DatadogSyntheticTestResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResultLastUpdateTime != nil {
+		in, out := &in.ResultLastUpdateTime, &out.ResultLastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastForceSyncTime != nil {
+		in, out := &in.LastForceSyncTime, &out.LastForceSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestStatus.
+func (in *DatadogSyntheticTestStatus) DeepCopy() *DatadogSyntheticTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestStep) DeepCopyInto(out *DatadogSyntheticTestStep) {
+	*out = *in
+	in.Request.DeepCopyInto(&out.Request)
+	if in.Assertions != nil {
+		in, out := &in.Assertions, &out.Assertions
+		*out = make([]DatadogSyntheticTestAssertion, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowFailure != nil {
+		in, out := &in.AllowFailure, &out.AllowFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsCritical != nil {
+		in, out := &in.IsCritical, &out.IsCritical
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestStep.
+func (in *DatadogSyntheticTestStep) DeepCopy() *DatadogSyntheticTestStep {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestStep)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -19,378 +19,455 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"./api/datadoghq/v1alpha1.DashboardTemplateVariable":             schema__api_datadoghq_v1alpha1_DashboardTemplateVariable(ref),
-		"./api/datadoghq/v1alpha1.DashboardTemplateVariablePreset":       schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePreset(ref),
-		"./api/datadoghq/v1alpha1.DashboardTemplateVariablePresetValue":  schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePresetValue(ref),
-		"./api/datadoghq/v1alpha1.DatadogAgentProfile":                   schema__api_datadoghq_v1alpha1_DatadogAgentProfile(ref),
-		"./api/datadoghq/v1alpha1.DatadogAgentProfileStatus":             schema__api_datadoghq_v1alpha1_DatadogAgentProfileStatus(ref),
-		"./api/datadoghq/v1alpha1.DatadogDashboard":                      schema__api_datadoghq_v1alpha1_DatadogDashboard(ref),
-		"./api/datadoghq/v1alpha1.DatadogDashboardSpec":                  schema__api_datadoghq_v1alpha1_DatadogDashboardSpec(ref),
-		"./api/datadoghq/v1alpha1.DatadogDashboardStatus":                schema__api_datadoghq_v1alpha1_DatadogDashboardStatus(ref),
-		"./api/datadoghq/v1alpha1.DatadogMetric":                         schema__api_datadoghq_v1alpha1_DatadogMetric(ref),
-		"./api/datadoghq/v1alpha1.DatadogMetricCondition":                schema__api_datadoghq_v1alpha1_DatadogMetricCondition(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitor":                        schema__api_datadoghq_v1alpha1_DatadogMonitor(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorCondition":               schema__api_datadoghq_v1alpha1_DatadogMonitorCondition(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorControllerOptions":       schema__api_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus":          schema__api_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorOptions":                 schema__api_datadoghq_v1alpha1_DatadogMonitorOptions(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows": schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds":       schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorSpec":                    schema__api_datadoghq_v1alpha1_DatadogMonitorSpec(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorStatus":                  schema__api_datadoghq_v1alpha1_DatadogMonitorStatus(ref),
-		"./api/datadoghq/v1alpha1.DatadogMonitorTriggeredState":          schema__api_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref),
-		"./api/datadoghq/v1alpha1.DatadogSLO":                            schema__api_datadoghq_v1alpha1_DatadogSLO(ref),
-		"./api/datadoghq/v1alpha1.DatadogSLOControllerOptions":           schema__api_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref),
-		"./api/datadoghq/v1alpha1.DatadogSLOQuery":                       schema__api_datadoghq_v1alpha1_DatadogSLOQuery(ref),
-		"./api/datadoghq/v1alpha1.DatadogSLOSpec":                        schema__api_datadoghq_v1alpha1_DatadogSLOSpec(ref),
-		"./api/datadoghq/v1alpha1.DatadogSLOStatus":                      schema__api_datadoghq_v1alpha1_DatadogSLOStatus(ref),
-		"./api/datadoghq/v1alpha1.SlowStart":                             schema__api_datadoghq_v1alpha1_SlowStart(ref),
+		"./api/datadoghq/v1alpha1.DashboardConfigMapReference":             schema__api_datadoghq_v1alpha1_DashboardConfigMapReference(ref),
+		"./api/datadoghq/v1alpha1.DashboardGroupWidget":                    schema__api_datadoghq_v1alpha1_DashboardGroupWidget(ref),
+		"./api/datadoghq/v1alpha1.DashboardGroupWidgetDefinition":          schema__api_datadoghq_v1alpha1_DashboardGroupWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardMonitorSummaryWidgetDefinition": schema__api_datadoghq_v1alpha1_DashboardMonitorSummaryWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardNoteWidgetDefinition":           schema__api_datadoghq_v1alpha1_DashboardNoteWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardQueryValueWidgetDefinition":     schema__api_datadoghq_v1alpha1_DashboardQueryValueWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardQueryValueWidgetRequest":        schema__api_datadoghq_v1alpha1_DashboardQueryValueWidgetRequest(ref),
+		"./api/datadoghq/v1alpha1.DashboardSLOWidgetDefinition":            schema__api_datadoghq_v1alpha1_DashboardSLOWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardTemplateVariable":               schema__api_datadoghq_v1alpha1_DashboardTemplateVariable(ref),
+		"./api/datadoghq/v1alpha1.DashboardTemplateVariablePreset":         schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePreset(ref),
+		"./api/datadoghq/v1alpha1.DashboardTemplateVariablePresetValue":    schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePresetValue(ref),
+		"./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetDefinition":     schema__api_datadoghq_v1alpha1_DashboardTimeseriesWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetRequest":        schema__api_datadoghq_v1alpha1_DashboardTimeseriesWidgetRequest(ref),
+		"./api/datadoghq/v1alpha1.DashboardToplistWidgetDefinition":        schema__api_datadoghq_v1alpha1_DashboardToplistWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardToplistWidgetRequest":           schema__api_datadoghq_v1alpha1_DashboardToplistWidgetRequest(ref),
+		"./api/datadoghq/v1alpha1.DashboardWidget":                         schema__api_datadoghq_v1alpha1_DashboardWidget(ref),
+		"./api/datadoghq/v1alpha1.DashboardWidgetDefinition":               schema__api_datadoghq_v1alpha1_DashboardWidgetDefinition(ref),
+		"./api/datadoghq/v1alpha1.DashboardWidgetLayout":                   schema__api_datadoghq_v1alpha1_DashboardWidgetLayout(ref),
+		"./api/datadoghq/v1alpha1.DatadogAgentProfile":                     schema__api_datadoghq_v1alpha1_DatadogAgentProfile(ref),
+		"./api/datadoghq/v1alpha1.DatadogAgentProfileStatus":               schema__api_datadoghq_v1alpha1_DatadogAgentProfileStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogCredentialsReference":             schema__api_datadoghq_v1alpha1_DatadogCredentialsReference(ref),
+		"./api/datadoghq/v1alpha1.DatadogDashboard":                        schema__api_datadoghq_v1alpha1_DatadogDashboard(ref),
+		"./api/datadoghq/v1alpha1.DatadogDashboardControllerOptions":       schema__api_datadoghq_v1alpha1_DatadogDashboardControllerOptions(ref),
+		"./api/datadoghq/v1alpha1.DatadogDashboardSpec":                    schema__api_datadoghq_v1alpha1_DatadogDashboardSpec(ref),
+		"./api/datadoghq/v1alpha1.DatadogDashboardStatus":                  schema__api_datadoghq_v1alpha1_DatadogDashboardStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogDowntime":                         schema__api_datadoghq_v1alpha1_DatadogDowntime(ref),
+		"./api/datadoghq/v1alpha1.DatadogDowntimeKubernetesScope":          schema__api_datadoghq_v1alpha1_DatadogDowntimeKubernetesScope(ref),
+		"./api/datadoghq/v1alpha1.DatadogDowntimeMonitorReference":         schema__api_datadoghq_v1alpha1_DatadogDowntimeMonitorReference(ref),
+		"./api/datadoghq/v1alpha1.DatadogDowntimeRecurrence":               schema__api_datadoghq_v1alpha1_DatadogDowntimeRecurrence(ref),
+		"./api/datadoghq/v1alpha1.DatadogDowntimeSchedule":                 schema__api_datadoghq_v1alpha1_DatadogDowntimeSchedule(ref),
+		"./api/datadoghq/v1alpha1.DatadogDowntimeSpec":                     schema__api_datadoghq_v1alpha1_DatadogDowntimeSpec(ref),
+		"./api/datadoghq/v1alpha1.DatadogDowntimeStatus":                   schema__api_datadoghq_v1alpha1_DatadogDowntimeStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogMetric":                           schema__api_datadoghq_v1alpha1_DatadogMetric(ref),
+		"./api/datadoghq/v1alpha1.DatadogMetricCondition":                  schema__api_datadoghq_v1alpha1_DatadogMetricCondition(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitor":                          schema__api_datadoghq_v1alpha1_DatadogMonitor(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorCondition":                 schema__api_datadoghq_v1alpha1_DatadogMonitorCondition(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorControllerOptions":         schema__api_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus":            schema__api_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorGroup":                     schema__api_datadoghq_v1alpha1_DatadogMonitorGroup(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorGroups":                    schema__api_datadoghq_v1alpha1_DatadogMonitorGroups(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorOptions":                   schema__api_datadoghq_v1alpha1_DatadogMonitorOptions(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows":   schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds":         schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorRolloutMute":               schema__api_datadoghq_v1alpha1_DatadogMonitorRolloutMute(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorSpec":                      schema__api_datadoghq_v1alpha1_DatadogMonitorSpec(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorStatus":                    schema__api_datadoghq_v1alpha1_DatadogMonitorStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorTemplate":                  schema__api_datadoghq_v1alpha1_DatadogMonitorTemplate(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorTemplateMonitor":           schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateMonitor(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorTemplateSelector":          schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateSelector(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorTemplateSpec":              schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateSpec(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorTemplateStatus":            schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogMonitorTriggeredState":            schema__api_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLO":                              schema__api_datadoghq_v1alpha1_DatadogSLO(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOControllerOptions":             schema__api_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOCorrection":                    schema__api_datadoghq_v1alpha1_DatadogSLOCorrection(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOCorrectionSLOReference":        schema__api_datadoghq_v1alpha1_DatadogSLOCorrectionSLOReference(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOCorrectionSpec":                schema__api_datadoghq_v1alpha1_DatadogSLOCorrectionSpec(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOCorrectionStatus":              schema__api_datadoghq_v1alpha1_DatadogSLOCorrectionStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOMonitorReference":              schema__api_datadoghq_v1alpha1_DatadogSLOMonitorReference(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOQuery":                         schema__api_datadoghq_v1alpha1_DatadogSLOQuery(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOSpec":                          schema__api_datadoghq_v1alpha1_DatadogSLOSpec(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOStatus":                        schema__api_datadoghq_v1alpha1_DatadogSLOStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOTimeSlice":                     schema__api_datadoghq_v1alpha1_DatadogSLOTimeSlice(ref),
+		"./api/datadoghq/v1alpha1.DatadogSLOTimeSliceQuery":                schema__api_datadoghq_v1alpha1_DatadogSLOTimeSliceQuery(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTest":                    schema__api_datadoghq_v1alpha1_DatadogSyntheticTest(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestAssertion":           schema__api_datadoghq_v1alpha1_DatadogSyntheticTestAssertion(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestControllerOptions":   schema__api_datadoghq_v1alpha1_DatadogSyntheticTestControllerOptions(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestOptions":             schema__api_datadoghq_v1alpha1_DatadogSyntheticTestOptions(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestRequest":             schema__api_datadoghq_v1alpha1_DatadogSyntheticTestRequest(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestResult":              schema__api_datadoghq_v1alpha1_DatadogSyntheticTestResult(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestSpec":                schema__api_datadoghq_v1alpha1_DatadogSyntheticTestSpec(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestStatus":              schema__api_datadoghq_v1alpha1_DatadogSyntheticTestStatus(ref),
+		"./api/datadoghq/v1alpha1.DatadogSyntheticTestStep":                schema__api_datadoghq_v1alpha1_DatadogSyntheticTestStep(ref),
+		"./api/datadoghq/v1alpha1.SlowStart":                               schema__api_datadoghq_v1alpha1_SlowStart(ref),
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DashboardTemplateVariable(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DashboardTemplateVariable Template variable.",
+				Description: "DashboardConfigMapReference references a key of a ConfigMap containing an exported Datadog dashboard. Every string of the dashboard JSON is a Go template using `[[` and `]]` as delimiters, so that it doesn't conflict with Datadog template variables. The cluster name, the namespace of the DatadogDashboard and its parameters are available as `.ClusterName`, `.Namespace` and `.Parameters`, for example `kube_namespace:[[ .Namespace ]]`.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"availableValues": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The list of values that the template variable drop-down is limited to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Name is the name of the ConfigMap.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"defaults": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key of the ConfigMap containing the dashboard JSON.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "key"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DashboardGroupWidget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DashboardGroupWidget is a widget of a group widget. Exactly one widget definition must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeseries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeseries is a timeseries widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetDefinition"),
 						},
+					},
+					"queryValue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One or many default values for template variables on load. If more than one default is specified, they will be unioned together with `OR`. Cannot be used in conjunction with `default`.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "QueryValue is a query value widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardQueryValueWidgetDefinition"),
 						},
 					},
-					"name": {
+					"toplist": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the variable.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Toplist is a top list widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardToplistWidgetDefinition"),
 						},
 					},
-					"prefix": {
+					"note": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The tag prefix associated with the variable. Only tags with this prefix appear in the variable drop-down.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Note is a note widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardNoteWidgetDefinition"),
+						},
+					},
+					"slo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SLO is an SLO widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardSLOWidgetDefinition"),
+						},
+					},
+					"monitorSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorSummary is a monitor summary widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardMonitorSummaryWidgetDefinition"),
+						},
+					},
+					"layout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Layout is the position and size of the widget in the group.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardWidgetLayout"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DashboardMonitorSummaryWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardNoteWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardQueryValueWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardSLOWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardToplistWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardWidgetLayout"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardGroupWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DashboardTemplateVariablePreset Template variables saved views.",
+				Description: "DashboardGroupWidgetDefinition is the definition of a group widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"title": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the variable.",
+							Description: "Title is the title of the group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"templateVariables": {
+					"backgroundColor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackgroundColor is the background color of the group title, for example `vivid_blue`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"showTitle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShowTitle defines whether the title of the group is displayed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"widgets": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "List of variables.",
+							Description: "Widgets is the list of widgets of the group. Groups can't be nested.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("./api/datadoghq/v1alpha1.DashboardTemplateVariablePresetValue"),
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardGroupWidget"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"widgets"},
 			},
 		},
 		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DashboardTemplateVariablePresetValue"},
+			"./api/datadoghq/v1alpha1.DashboardGroupWidget"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePresetValue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardMonitorSummaryWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DashboardTemplateVariablePresetValue Template variables saved views.",
+				Description: "DashboardMonitorSummaryWidgetDefinition is the definition of a monitor summary widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"title": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the variable.",
+							Description: "Title is the title of the widget.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"values": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the monitor search query, for example `tag:team:example`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"summaryType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One or many template variable values within the saved view, which will be unioned together using `OR` if more than one is specified. Cannot be used in conjunction with `value`.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "SummaryType is the type of summary: `monitors`, `groups` or `combined`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"displayFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisplayFormat is the format of the summary: `counts`, `countsAndList` or `list`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hideZeroCounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HideZeroCounts defines whether the states without any monitor are hidden.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"query"},
 			},
 		},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogAgentProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardNoteWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogAgentProfile is the Schema for the datadogagentprofiles API",
+				Description: "DashboardNoteWidgetDefinition is the definition of a note widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"content": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Content is the content of the note, in Markdown.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"backgroundColor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "BackgroundColor is the background color of the note, for example `yellow`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"fontSize": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "FontSize is the size of the text, for example `14`.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"textAlign": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogAgentProfileSpec"),
+							Description: "TextAlign is the alignment of the text: `center`, `left` or `right`.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"showTick": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogAgentProfileStatus"),
+							Description: "ShowTick defines whether the note is displayed with a tick.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"content"},
 			},
 		},
-		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogAgentProfileSpec", "./api/datadoghq/v1alpha1.DatadogAgentProfileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogAgentProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardQueryValueWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogAgentProfileStatus defines the observed state of DatadogAgentProfile",
+				Description: "DashboardQueryValueWidgetDefinition is the definition of a query value widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"lastUpdate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdate is the last time the status was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"currentHash": {
+					"title": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentHash is the stored hash of the DatadogAgentProfile.",
+							Description: "Title is the title of the widget.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"conditions": {
+					"requests": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type": "map",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a DatadogAgentProfile's current state.",
+							Description: "Requests is the list of queries displayed by the widget.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardQueryValueWidgetRequest"),
 									},
 								},
 							},
 						},
 					},
-					"valid": {
+					"precision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Valid shows if the DatadogAgentProfile has a valid config spec.",
-							Type:        []string{"string"},
+							Description: "Precision is the number of decimals of the value.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale defines whether the unit of the value is scaled automatically.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"applied": {
+					"customUnit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Applied shows whether the DatadogAgentProfile conflicts with an existing DatadogAgentProfile.",
+							Description: "CustomUnit is the unit displayed with the value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"slowStart": {
+					"textAlign": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SlowStart is the state of the slow start feature.",
-							Ref:         ref("./api/datadoghq/v1alpha1.SlowStart"),
+							Description: "TextAlign is the alignment of the value: `center`, `left` or `right`.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"requests"},
 			},
 		},
 		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.SlowStart", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"./api/datadoghq/v1alpha1.DashboardQueryValueWidgetRequest"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogDashboard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardQueryValueWidgetRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogDashboard is the Schema for the datadogdashboards API",
+				Description: "DashboardQueryValueWidgetRequest is a query of a query value widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Query is the metric query, for example `avg:system.cpu.user{*}`.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"aggregator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Aggregator is the aggregation of the query over the time frame: `avg`, `last`, `max`, `min` or `sum`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogDashboardSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogDashboardStatus"),
-						},
-					},
 				},
+				Required: []string{"query"},
 			},
 		},
-		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogDashboardSpec", "./api/datadoghq/v1alpha1.DatadogDashboardStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogDashboardSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardSLOWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogDashboardSpec defines the desired state of DatadogDashboard",
+				Description: "DashboardSLOWidgetDefinition is the definition of an SLO widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"description": {
+					"title": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is the description of the dashboard.",
+							Description: "Title is the title of the widget.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"layoutType": {
+					"sloID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LayoutType is the layout type of the dashboard.",
+							Description: "SLOID is the ID of the SLO displayed by the widget.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"notifyList": {
+					"timeWindows": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "NotifyList is the list of handles of users to notify when changes are made to this dashboard.",
+							Description: "TimeWindows is the list of time windows displayed by the widget, for example `7d` or `month_to_date`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -403,522 +480,714 @@ func schema__api_datadoghq_v1alpha1_DatadogDashboardSpec(ref common.ReferenceCal
 							},
 						},
 					},
-					"reflowType": {
+					"viewMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reflowtype is the reflow type for a 'new dashboard layout' dashboard. Set this only when layout type is 'ordered'. If set to 'fixed', the dashboard expects all widgets to have a layout, and if it's set to 'auto', widgets should not have layouts.",
+							Description: "ViewMode defines whether the overall status, the status of the groups, or both are displayed: `overall`, `component` or `both`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tags": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
-						},
+					"showErrorBudget": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tags is a list of team names representing ownership of a dashboard.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ShowErrorBudget defines whether the error budget is displayed.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"templateVariablePresets": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+				},
+				Required: []string{"sloID"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DashboardTemplateVariable(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DashboardTemplateVariable Template variable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"availableValues": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TemplateVariablePresets is an array of template variables saved views.",
+							Description: "The list of values that the template variable drop-down is limited to.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("./api/datadoghq/v1alpha1.DashboardTemplateVariablePreset"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"templateVariables": {
+					"defaults": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
+								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "TemplateVariables is a list of template variables for this dashboard.",
+							Description: "One or many default values for template variables on load. If more than one default is specified, they will be unioned together with `OR`. Cannot be used in conjunction with `default`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("./api/datadoghq/v1alpha1.DashboardTemplateVariable"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"title": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Title is the title of the dashboard.",
+							Description: "The name of the variable.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"widgets": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Widgets is a JSON string representation of a list of Datadog API Widgets",
+							Description: "The tag prefix associated with the variable. Only tags with this prefix appear in the variable drop-down.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DashboardTemplateVariable", "./api/datadoghq/v1alpha1.DashboardTemplateVariablePreset"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogDashboardStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogDashboardStatus defines the observed state of DatadogDashboard",
+				Description: "DashboardTemplateVariablePreset Template variables saved views.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the variable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"templateVariables": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
+									"name",
 								},
 								"x-kubernetes-list-type": "map",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of the state of a DatadogDashboard.",
+							Description: "List of variables.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardTemplateVariablePresetValue"),
 									},
 								},
 							},
 						},
 					},
-					"id": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DashboardTemplateVariablePresetValue"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DashboardTemplateVariablePresetValue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DashboardTemplateVariablePresetValue Template variables saved views.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the dashboard ID generated in Datadog.",
+							Description: "The name of the variable.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"creator": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Creator is the identity of the dashboard creator.",
-							Type:        []string{"string"},
-							Format:      "",
+					"values": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
 						},
-					},
-					"created": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Created is the time the dashboard was created.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "One or many template variable values within the saved view, which will be unioned together using `OR` if more than one is specified. Cannot be used in conjunction with `value`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"syncStatus": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DashboardTimeseriesWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DashboardTimeseriesWidgetDefinition is the definition of a timeseries widget.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"title": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SyncStatus shows the health of syncing the dashboard state to Datadog.",
+							Description: "Title is the title of the widget.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"currentHash": {
+					"showLegend": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentHash tracks the hash of the current DatadogDashboardSpec to know if the Spec has changed and needs an update.",
-							Type:        []string{"string"},
+							Description: "ShowLegend defines whether the legend is displayed.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"dashboardLastForceSyncTime": {
+					"requests": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "DashboardLastForceSyncTime is the last time the API dashboard was last force synced with the Datadogdashboard resource",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Requests is the list of queries displayed by the widget.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetRequest"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"requests"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetRequest"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardTimeseriesWidgetRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMetric allows autoscaling on arbitrary Datadog query",
+				Description: "DashboardTimeseriesWidgetRequest is a query of a timeseries widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Query is the metric query, for example `avg:system.cpu.user{*} by {host}`.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"displayType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "DisplayType is the type of display of the query: `line` (the default), `area` or `bars`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+				},
+				Required: []string{"query"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DashboardToplistWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DashboardToplistWidgetDefinition is the definition of a top list widget.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"title": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Title is the title of the widget.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMetricSpec"),
+					"requests": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"status": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMetricStatus"),
+							Description: "Requests is the list of queries displayed by the widget.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardToplistWidgetRequest"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"requests"},
 			},
 		},
 		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogMetricSpec", "./api/datadoghq/v1alpha1.DatadogMetricStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"./api/datadoghq/v1alpha1.DashboardToplistWidgetRequest"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMetricCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardToplistWidgetRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMetricCondition describes the state of a DatadogMetric at a certain point.",
+				Description: "DashboardToplistWidgetRequest is a query of a top list widget.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of DatadogMetric condition.",
+							Description: "Query is the metric query, for example `top(avg:system.cpu.user{*} by {host}, 10, 'mean', 'desc')`.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+				},
+				Required: []string{"query"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DashboardWidget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DashboardWidget is a typed dashboard widget. Exactly one widget definition must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeseries": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Timeseries is a timeseries widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetDefinition"),
 						},
 					},
-					"lastTransitionTime": {
+					"queryValue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "QueryValue is a query value widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardQueryValueWidgetDefinition"),
 						},
 					},
-					"lastUpdateTime": {
+					"toplist": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Toplist is a top list widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardToplistWidgetDefinition"),
 						},
 					},
-					"reason": {
+					"note": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Note is a note widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardNoteWidgetDefinition"),
 						},
 					},
-					"message": {
+					"slo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SLO is an SLO widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardSLOWidgetDefinition"),
+						},
+					},
+					"monitorSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorSummary is a monitor summary widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardMonitorSummaryWidgetDefinition"),
+						},
+					},
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is a group widget, containing other widgets.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardGroupWidgetDefinition"),
+						},
+					},
+					"layout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Layout is the position and size of the widget. It's required for dashboards with the `free` layout type, or the `fixed` reflow type.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardWidgetLayout"),
 						},
 					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"./api/datadoghq/v1alpha1.DashboardGroupWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardMonitorSummaryWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardNoteWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardQueryValueWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardSLOWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardToplistWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardWidgetLayout"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardWidgetDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitor allows to define and manage Monitors from your Kubernetes Cluster",
+				Description: "DashboardWidgetDefinition is the definition of a widget. Exactly one of its fields must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"timeseries": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Timeseries is a timeseries widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetDefinition"),
 						},
 					},
-					"apiVersion": {
+					"queryValue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "QueryValue is a query value widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardQueryValueWidgetDefinition"),
 						},
 					},
-					"metadata": {
+					"toplist": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Toplist is a top list widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardToplistWidgetDefinition"),
 						},
 					},
-					"spec": {
+					"note": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorSpec"),
+							Description: "Note is a note widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardNoteWidgetDefinition"),
 						},
 					},
-					"status": {
+					"slo": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorStatus"),
+							Description: "SLO is an SLO widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardSLOWidgetDefinition"),
+						},
+					},
+					"monitorSummary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorSummary is a monitor summary widget.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardMonitorSummaryWidgetDefinition"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogMonitorSpec", "./api/datadoghq/v1alpha1.DatadogMonitorStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"./api/datadoghq/v1alpha1.DashboardMonitorSummaryWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardNoteWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardQueryValueWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardSLOWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardTimeseriesWidgetDefinition", "./api/datadoghq/v1alpha1.DashboardToplistWidgetDefinition"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DashboardWidgetLayout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorCondition describes the current state of a DatadogMonitor",
+				Description: "DashboardWidgetLayout is the position and size of a widget, in grid units.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"x": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of DatadogMonitor condition",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "X is the position of the widget on the x (horizontal) axis.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"status": {
+					"y": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Y is the position of the widget on the y (vertical) axis.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"lastTransitionTime": {
+					"width": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Width is the width of the widget.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"lastUpdateTime": {
+					"height": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Height is the height of the widget.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"reason": {
+				},
+				Required: []string{"x", "y", "width", "height"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogAgentProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogAgentProfile is the Schema for the datadogagentprofiles API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogAgentProfileSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogAgentProfileStatus"),
+						},
+					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"./api/datadoghq/v1alpha1.DatadogAgentProfileSpec", "./api/datadoghq/v1alpha1.DatadogAgentProfileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogAgentProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorControllerOptions defines options in the DatadogMonitor controller",
+				Description: "DatadogAgentProfileStatus defines the observed state of DatadogAgentProfile",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"disableRequiredTags": {
+					"lastUpdate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisableRequiredTags disables the automatic addition of required tags to monitors.",
-							Type:        []string{"boolean"},
+							Description: "LastUpdate is the last time the status was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash is the stored hash of the DatadogAgentProfile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a DatadogAgentProfile's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"valid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Valid shows if the DatadogAgentProfile has a valid config spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"applied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Applied shows whether the DatadogAgentProfile conflicts with an existing DatadogAgentProfile.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"slowStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SlowStart is the state of the slow start feature.",
+							Ref:         ref("./api/datadoghq/v1alpha1.SlowStart"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.SlowStart", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogCredentialsReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorDowntimeStatus represents the downtime status of a DatadogMonitor",
+				Description: "DatadogCredentialsReference references a Secret, in the same namespace, with the Datadog credentials used to manage a Datadog object. The Secret has the `api_key` and `app_key` keys, and optionally the `site` key with the Datadog site of the organization, for example `datadoghq.eu`. The site of the operator is used by default.\n\nWhen the credentials change, the Datadog object is created again with the new credentials; the one created with the previous credentials isn't deleted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"isDowntimed": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IsDowntimed shows the downtime status of the monitor.",
-							Type:        []string{"boolean"},
+							Description: "SecretName is the name of the Secret.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"downtimeID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DowntimeID is the downtime ID.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
 				},
+				Required: []string{"secretName"},
 			},
 		},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogDashboard(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorOptions define the optional parameters of a monitor",
+				Description: "DatadogDashboard is the Schema for the datadogdashboards API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enableLogsSample": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether to send a log sample when the log monitor triggers.",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"escalationMessage": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A message to include with a re-notification.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"evaluationDelay": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Time (in seconds) to delay evaluation, as a non-negative integer. For example, if the value is set to 300 (5min), the timeframe is set to last_5m and the time is 7:00, the monitor evaluates data from 6:50 to 6:55. This is useful for AWS CloudWatch and other backfilled metrics to ensure the monitor always has data during evaluation.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"includeTags": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether notifications from this monitor automatically inserts its triggering tags into the title.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogDashboardSpec"),
 						},
 					},
-					"groupbySimpleMonitor": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether the log alert monitor triggers a single alert or multiple alerts when any group breaches a threshold.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogDashboardStatus"),
 						},
 					},
-					"locked": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogDashboardSpec", "./api/datadoghq/v1alpha1.DatadogDashboardStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogDashboardControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDashboardControllerOptions defines options in the DatadogDashboard controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"driftPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DEPRECATED: Whether or not the monitor is locked (only editable by creator and admins). Use `restricted_roles` instead.",
-							Type:        []string{"boolean"},
+							Description: "DriftPolicy defines how changes made to the dashboard outside of Kubernetes are handled: reverted as soon as they are detected (enforce, the default) or only reported (observe).",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"newGroupDelay": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Time (in seconds) to allow a host to boot and applications to fully start before starting the evaluation of monitor results. Should be a non negative integer.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"noDataTimeframe": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The number of minutes before a monitor notifies after data stops reporting. Datadog recommends at least 2x the monitor timeframe for metric alerts or 2 minutes for service checks. If omitted, 2x the evaluation timeframe is used for metric alerts, and 24 hours is used for service checks.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"notificationPresetName": {
+				},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogDashboardSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDashboardSpec defines the desired state of DatadogDashboard",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "An enum that toggles the display of additional content sent in the monitor notification.",
+							Description: "Description is the description of the dashboard.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"notifyAudit": {
+					"layoutType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether tagged users are notified on changes to this monitor.",
-							Type:        []string{"boolean"},
+							Description: "LayoutType is the layout type of the dashboard.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"notifyBy": {
+					"notifyList": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "A string indicating the granularity a monitor alerts on. Only available for monitors with groupings. For instance, a monitor grouped by cluster, namespace, and pod can be configured to only notify on each new cluster violating the alert conditions by setting notify_by to [\"cluster\"]. Tags mentioned in notify_by must be a subset of the grouping tags in the query. For example, a query grouped by cluster and namespace cannot notify on region. Setting notify_by to [*] configures the monitor to notify as a simple-alert.",
+							Description: "NotifyList is the list of handles of users to notify when changes are made to this dashboard.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -931,567 +1200,3119 @@ func schema__api_datadoghq_v1alpha1_DatadogMonitorOptions(ref common.ReferenceCa
 							},
 						},
 					},
-					"notifyNoData": {
+					"reflowType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether this monitor notifies when data stops reporting.",
-							Type:        []string{"boolean"},
+							Description: "Reflowtype is the reflow type for a 'new dashboard layout' dashboard. Set this only when layout type is 'ordered'. If set to 'fixed', the dashboard expects all widgets to have a layout, and if it's set to 'auto', widgets should not have layouts.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"onMissingData": {
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "An enum that controls how groups or monitors are treated if an evaluation does not return data points. The default option results in different behavior depending on the monitor query type. For monitors using Count queries, an empty monitor evaluation is treated as 0 and is compared to the threshold conditions. For monitors using any query type other than Count, for example Gauge, Measure, or Rate, the monitor shows the last known status. This option is only available for APM Trace Analytics, Audit Trail, CI, Error Tracking, Event, Logs, and RUM monitors",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Tags is a list of team names representing ownership of a dashboard.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"renotifyInterval": {
+					"templateVariablePresets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of minutes after the last notification before a monitor re-notifies on the current status. It only re-notifies if it’s not resolved.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "TemplateVariablePresets is an array of template variables saved views.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardTemplateVariablePreset"),
+									},
+								},
+							},
 						},
 					},
-					"renotifyOccurrences": {
+					"templateVariables": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of times re-notification messages should be sent on the current status at the provided re-notification interval.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "TemplateVariables is a list of template variables for this dashboard.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardTemplateVariable"),
+									},
+								},
+							},
 						},
 					},
-					"renotifyStatuses": {
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Title is the title of the dashboard.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"typedWidgets": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "The types of statuses for which re-notification messages should be sent. Valid values are alert, warn, no data.",
+							Description: "TypedWidgets is the list of widgets of the dashboard, validated when the DatadogDashboard is applied.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DashboardWidget"),
 									},
 								},
 							},
 						},
 					},
-					"requireFullWindow": {
+					"widgets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether this monitor needs a full window of data before it’s evaluated. We highly recommend you set this to false for sparse metrics, otherwise some evaluations are skipped. Default is false.",
-							Type:        []string{"boolean"},
+							Description: "Widgets is a JSON string representation of a list of Datadog API Widgets, for the widgets that aren't supported by TypedWidgets. They are added to the dashboard after the typed widgets.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"timeoutH": {
+					"configMapRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of hours of the monitor not reporting data before it automatically resolves from a triggered state.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "ConfigMapRef references a ConfigMap key, in the same namespace, containing the JSON of an exported Datadog dashboard. The dashboard is used as a template: the other fields of the spec, when set, override those of the exported dashboard.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DashboardConfigMapReference"),
 						},
 					},
-					"thresholds": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A struct of the different monitor threshold values.",
-							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"),
+							Description: "Parameters are the custom parameters of the dashboard loaded from ConfigMapRef.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"thresholdWindows": {
+					"controllerOptions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A struct of the alerting time window options.",
-							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows"),
+							Description: "ControllerOptions are the optional parameters in the DatadogDashboard controller.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogDashboardControllerOptions"),
+						},
+					},
+					"credentialsRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsRef references a Secret with the Datadog credentials used to manage the dashboard, instead of those of the namespace or of the operator.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogCredentialsReference"),
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy defines what happens to the dashboard in Datadog when the custom resource is deleted: it's deleted (Delete) or kept, and marked as no longer managed by the operator at the end of its description (Orphan). Defaults to the deletion policy of the operator, Delete unless configured otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows", "./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"},
+			"./api/datadoghq/v1alpha1.DashboardConfigMapReference", "./api/datadoghq/v1alpha1.DashboardTemplateVariable", "./api/datadoghq/v1alpha1.DashboardTemplateVariablePreset", "./api/datadoghq/v1alpha1.DashboardWidget", "./api/datadoghq/v1alpha1.DatadogCredentialsReference", "./api/datadoghq/v1alpha1.DatadogDashboardControllerOptions"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogDashboardStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorOptionsThresholdWindows is a struct of the alerting time window options",
+				Description: "DatadogDashboardStatus defines the observed state of DatadogDashboard",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"recoveryWindow": {
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Describes how long an anomalous metric must be normal before the alert recovers.",
+							Description: "Conditions represents the latest available observations of the state of a DatadogDashboard.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the dashboard ID generated in Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"triggerWindow": {
+					"creator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Describes how long a metric must be anomalous before an alert triggers.",
+							Description: "Creator is the identity of the dashboard creator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is the time the dashboard was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"syncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStatus shows the health of syncing the dashboard state to Datadog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogDashboardSpec to know if the Spec has changed and needs an update.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"dashboardLastForceSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DashboardLastForceSyncTime is the last time the API dashboard was last force synced with the Datadogdashboard resource",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogDowntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorOptionsThresholds is a struct of the different monitor threshold values",
+				Description: "DatadogDowntime allows to define and manage Datadog downtimes from your Kubernetes Cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"critical": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The monitor CRITICAL threshold.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"criticalRecovery": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The monitor CRITICAL recovery threshold.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ok": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The monitor OK threshold.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"unknown": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The monitor UNKNOWN threshold.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"warning": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The monitor WARNING threshold.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogDowntimeSpec"),
 						},
 					},
-					"warningRecovery": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The monitor WARNING recovery threshold.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogDowntimeStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogDowntimeSpec", "./api/datadoghq/v1alpha1.DatadogDowntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogDowntimeKubernetesScope(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorSpec defines the desired state of DatadogMonitor",
+				Description: "DatadogDowntimeKubernetesScope scopes a downtime to Kubernetes objects. Values of a list are combined with OR, and lists are combined with AND.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterName is the name of the cluster (`kube_cluster_name` tag).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces is the list of namespaces (`kube_namespace` tag).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deployments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployments is the list of deployments (`kube_deployment` tag).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"statefulSets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "StatefulSets is the list of statefulsets (`kube_stateful_set` tag).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"daemonSets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DaemonSets is the list of daemonsets (`kube_daemon_set` tag).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogDowntimeMonitorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDowntimeMonitorReference references a DatadogMonitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the DatadogMonitor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogDowntimeRecurrence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDowntimeRecurrence defines a recurrence of a downtime.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the downtime, for example `1h` or `2d`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rrule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rrule is the recurrence rule of the downtime, as defined by RFC 5545 (only `FREQ`, `INTERVAL`, `COUNT`, `UNTIL` and `BY*` are supported), for example `FREQ=WEEKLY;BYDAY=SA,SU`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the first start of the recurrence in the `YYYY-MM-DDThh:mm` format, in the schedule timezone. The recurrence starts immediately when it isn't set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"duration", "rrule"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogDowntimeSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDowntimeSchedule defines a one-time or a recurring downtime schedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the start time of a one-time downtime. The downtime starts immediately when it isn't set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end time of a one-time downtime. The downtime never ends when it isn't set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"recurrences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Recurrences is the list of recurrences of a recurring downtime. It can't be used with Start and End.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogDowntimeRecurrence"),
+									},
+								},
+							},
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the timezone in which the recurrences are evaluated, for example `Europe/Paris`. Defaults to `UTC`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogDowntimeRecurrence", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogDowntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDowntimeSpec defines the desired state of a DatadogDowntime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope is the Datadog scope query the downtime applies to, for example `env:(staging OR prod) AND datacenter:us-east-1`. It's combined with KubernetesScope when both are set. The downtime applies to all scopes when both are empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubernetesScope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesScope scopes the downtime to Kubernetes objects, using the tags set by the Datadog Agent.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogDowntimeKubernetesScope"),
+						},
+					},
+					"monitorTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorTags is the list of monitor tags the downtime applies to. Monitors must have all the tags to be muted. It can't be used with MonitorRefs or MonitorSelector. The downtime applies to all monitors when they're all empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"monitorRefs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorRefs references the DatadogMonitors in the same namespace to mute. A downtime is created in Datadog for each monitor. It can't be used with MonitorTags.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogDowntimeMonitorReference"),
+									},
+								},
+							},
+						},
+					},
+					"monitorSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorSelector selects the DatadogMonitors in the same namespace to mute, in addition to MonitorRefs. It must select at least one DatadogMonitor. It can't be used with MonitorTags.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the message to include with notifications for this downtime. Email notifications can be sent to specific users by using the same `@username` notation as events.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the schedule of the downtime. The downtime starts immediately and never ends when it isn't set.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogDowntimeSchedule"),
+						},
+					},
+					"displayTimezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisplayTimezone is the timezone in which to display the downtime's start and end times in Datadog applications. This is only used for display purposes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"muteFirstRecoveryNotification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MuteFirstRecoveryNotification mutes the first recovery notification of the monitors when the downtime ends.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"notifyEndStates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifyEndStates are the states that will trigger a monitor notification when the downtime ends, if the monitor is in one of them. Defaults to `alert`, `no data` and `warn`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notifyEndTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifyEndTypes are the actions that will trigger a monitor notification if the downtime is in the `notifyEndStates` states. Defaults to `expired`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy defines what happens to the downtime in Datadog when the custom resource is deleted: it's deleted (Delete) or kept (Orphan). Defaults to the deletion policy of the operator, Delete unless configured otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogDowntimeKubernetesScope", "./api/datadoghq/v1alpha1.DatadogDowntimeMonitorReference", "./api/datadoghq/v1alpha1.DatadogDowntimeSchedule", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogDowntimeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDowntimeStatus defines the observed state of a DatadogDowntime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the state of a DatadogDowntime.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the downtime ID generated in Datadog. When the downtime mutes DatadogMonitors, it's the comma-separated list of the IDs of the downtimes of each monitor, in the order of MonitorIDs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is the time the downtime was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorIDs are the sorted IDs of the monitors referenced by MonitorRefs and selected by MonitorSelector.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+					"downtimeState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DowntimeState is the state of the downtime in Datadog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentStart is the start of the current (or next) downtime.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentEnd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentEnd is the end of the current (or next) downtime.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"syncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStatus shows the health of syncing the downtime state to Datadog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastForceSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastForceSyncTime is the last time the API downtime was last force synced with the DatadogDowntime resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogDowntimeSpec to know if the Spec has changed and needs an update.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMetric allows autoscaling on arbitrary Datadog query",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMetricSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMetricStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMetricSpec", "./api/datadoghq/v1alpha1.DatadogMetricStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMetricCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMetricCondition describes the state of a DatadogMetric at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of DatadogMetric condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitor allows to define and manage Monitors from your Kubernetes Cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMonitorSpec", "./api/datadoghq/v1alpha1.DatadogMonitorStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorCondition describes the current state of a DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of DatadogMonitor condition",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorControllerOptions defines options in the DatadogMonitor controller",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disableRequiredTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableRequiredTags disables the automatic addition of required tags to monitors.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines how changes made to the monitor outside of Kubernetes, in the Datadog UI for instance, are handled: reverted as soon as they are detected (enforce, the default) or only reported (observe).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorDowntimeStatus represents the downtime status of a DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"isDowntimed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsDowntimed shows the downtime status of the monitor.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"downtimeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DowntimeID is the downtime ID.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"rolloutDowntimeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutDowntimeID is the ID of the downtime muting the monitor while the workloads of RolloutWorkloads roll out, see DatadogMonitorRolloutMute.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rolloutWorkloads": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutWorkloads are the workloads in rollout muting the monitor, as `<kind>/<name>`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rolloutDowntimeEnd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutDowntimeEnd is the end of the downtime muting the monitor during rollouts, extended while they continue.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorGroup represents the state of a group of a DatadogMonitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the group, for example `host:foo,env:prod`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the group triggered, resolved or stopped reporting data.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorGroups(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorGroups holds the state of the groups that aren't OK of the monitor of the DatadogMonitor with the same name, while the TriggeredState of the DatadogMonitor status is capped. It's created and updated by the DatadogMonitor controller, and deleted with the DatadogMonitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"monitorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorID is the ID of the monitor in Datadog.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"groupCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupCount is the number of groups of the monitor, including the OK groups that aren't stored in Groups.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"triggeredCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredCount is the number of groups in Alert, Warn or No Data.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"groups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups are the groups of the monitor that aren't OK, sorted by name. When the monitor has too many of them, only the most severe ones are kept. The transition times aren't updated when only they change.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMonitorGroup", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptions define the optional parameters of a monitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enableLogsSample": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether to send a log sample when the log monitor triggers.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"escalationMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A message to include with a re-notification.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"evaluationDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time (in seconds) to delay evaluation, as a non-negative integer. For example, if the value is set to 300 (5min), the timeframe is set to last_5m and the time is 7:00, the monitor evaluates data from 6:50 to 6:55. This is useful for AWS CloudWatch and other backfilled metrics to ensure the monitor always has data during evaluation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"includeTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether notifications from this monitor automatically inserts its triggering tags into the title.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"groupbySimpleMonitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether the log alert monitor triggers a single alert or multiple alerts when any group breaches a threshold.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"locked": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DEPRECATED: Whether or not the monitor is locked (only editable by creator and admins). Use `restricted_roles` instead.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"newGroupDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time (in seconds) to allow a host to boot and applications to fully start before starting the evaluation of monitor results. Should be a non negative integer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"noDataTimeframe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of minutes before a monitor notifies after data stops reporting. Datadog recommends at least 2x the monitor timeframe for metric alerts or 2 minutes for service checks. If omitted, 2x the evaluation timeframe is used for metric alerts, and 24 hours is used for service checks.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"notificationPresetName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "An enum that toggles the display of additional content sent in the monitor notification.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notifyAudit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether tagged users are notified on changes to this monitor.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"notifyBy": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "A string indicating the granularity a monitor alerts on. Only available for monitors with groupings. For instance, a monitor grouped by cluster, namespace, and pod can be configured to only notify on each new cluster violating the alert conditions by setting notify_by to [\"cluster\"]. Tags mentioned in notify_by must be a subset of the grouping tags in the query. For example, a query grouped by cluster and namespace cannot notify on region. Setting notify_by to [*] configures the monitor to notify as a simple-alert.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notifyNoData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether this monitor notifies when data stops reporting.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"onMissingData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "An enum that controls how groups or monitors are treated if an evaluation does not return data points. The default option results in different behavior depending on the monitor query type. For monitors using Count queries, an empty monitor evaluation is treated as 0 and is compared to the threshold conditions. For monitors using any query type other than Count, for example Gauge, Measure, or Rate, the monitor shows the last known status. This option is only available for APM Trace Analytics, Audit Trail, CI, Error Tracking, Event, Logs, and RUM monitors",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"renotifyInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of minutes after the last notification before a monitor re-notifies on the current status. It only re-notifies if it’s not resolved.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"renotifyOccurrences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of times re-notification messages should be sent on the current status at the provided re-notification interval.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"renotifyStatuses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The types of statuses for which re-notification messages should be sent. Valid values are alert, warn, no data.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"requireFullWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether this monitor needs a full window of data before it’s evaluated. We highly recommend you set this to false for sparse metrics, otherwise some evaluations are skipped. Default is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"timeoutH": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of hours of the monitor not reporting data before it automatically resolves from a triggered state.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"thresholds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A struct of the different monitor threshold values.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"),
+						},
+					},
+					"thresholdWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A struct of the alerting time window options.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows", "./api/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsThresholdWindows is a struct of the alerting time window options",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recoveryWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how long an anomalous metric must be normal before the alert recovers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggerWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how long a metric must be anomalous before an alert triggers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsThresholds is a struct of the different monitor threshold values",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"critical": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor CRITICAL threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"criticalRecovery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor CRITICAL recovery threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ok": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor OK threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"unknown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor UNKNOWN threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"warning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor WARNING threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"warningRecovery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor WARNING recovery threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorRolloutMute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorRolloutMute mutes a monitor while workloads, in the namespace of the DatadogMonitor, roll out. A downtime scoped to the workloads in rollout is created when a rollout starts, and canceled once all the rollouts complete.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deployments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployments are the names of the Deployments whose rollouts mute the monitor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"statefulSets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "StatefulSets are the names of the StatefulSets whose rollouts mute the monitor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the Deployments and StatefulSets whose rollouts mute the monitor, in addition to the named ones.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope is the scope of the downtime, as a Datadog tag query. Defaults to the namespace and the workloads in rollout, for example `kube_namespace:default AND kube_deployment:web`, which requires the monitor to be grouped by these tags. Set it to `*` to mute all the groups of the monitor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorSpec defines the desired state of DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the monitor name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a message to include with notifications for this monitor",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority is an integer from 1 (high) to 5 (low) indicating alert severity",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the Datadog monitor query. For composite monitors, the query can reference other DatadogMonitors in the same namespace by name, for example `monitor-a && !monitor-b`, in addition to Datadog monitor IDs.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restrictedRoles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RestrictedRoles is a list of unique role identifiers to define which roles are allowed to edit the monitor. `restricted_roles` is the successor of `locked`. For more information about `locked` and `restricted_roles`, see the [monitor options docs](https://docs.datadoghq.com/monitors/guide/monitor_api_options/#permissions-options).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is the monitor tags associated with your monitor",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the monitor type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options are the optional parameters associated with your monitor",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorOptions"),
+						},
+					},
+					"controllerOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControllerOptions are the optional parameters in the DatadogMonitor controller",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorControllerOptions"),
+						},
+					},
+					"credentialsRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsRef references a Secret with the Datadog credentials used to manage the monitor, instead of those of the namespace or of the operator.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogCredentialsReference"),
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy defines what happens to the monitor in Datadog when the custom resource is deleted: it's deleted (Delete) or kept, and tagged as no longer managed by the operator (Orphan). Defaults to the deletion policy of the operator, Delete unless configured otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rolloutMute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutMute mutes the monitor with a downtime while workloads of its namespace roll out.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorRolloutMute"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogCredentialsReference", "./api/datadoghq/v1alpha1.DatadogMonitorControllerOptions", "./api/datadoghq/v1alpha1.DatadogMonitorOptions", "./api/datadoghq/v1alpha1.DatadogMonitorRolloutMute"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorStatus defines the observed state of DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions Represents the latest available observations of a DatadogMonitor's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorCondition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the monitor ID generated in Datadog",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"creator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Creator is the identify of the monitor creator",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is the time the monitor was created",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorState is the overall state of monitor",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"monitorLastForceSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorLastForceSyncTime is the last time the API monitor was last force synced with the DatadogMonitor resource",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorStateLastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorStateLastUpdateTime is the last time the monitor state updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorStateLastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorStateLastTransitionTime is the last time the monitor state changed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorStateSyncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorStateSyncStatus shows the health of syncing the monitor state to Datadog",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggeredState": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"monitorGroup",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredState only includes details for monitor groups that are triggering, up to 10 groups. The state of all the groups is in the DatadogMonitorGroups object with the same name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorTriggeredState"),
+									},
+								},
+							},
+						},
+					},
+					"downtimeStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DowntimeStatus defines whether the monitor is downtimed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus"),
+						},
+					},
+					"primary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Primary defines whether the monitor is managed by the Kubernetes custom resource (true) or outside Kubernetes (false)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogMonitorSpec to know if the Spec has changed and needs an update",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMonitorCondition", "./api/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus", "./api/datadoghq/v1alpha1.DatadogMonitorTriggeredState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorTemplate creates a DatadogMonitor for each Kubernetes workload matching a selector.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorTemplateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorTemplateStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMonitorTemplateSpec", "./api/datadoghq/v1alpha1.DatadogMonitorTemplateStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorTemplateMonitor is the template of a DatadogMonitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the DatadogMonitors created from the template.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the spec of the DatadogMonitors. Every string field is a Go template using `[[` and `]]` as delimiters, so that it doesn't conflict with Datadog template variables. The workload is available as `.Kind`, `.Name`, `.Namespace`, `.Labels` and `.Annotations`, for example `avg:trace.http.request.errors{kube_deployment:[[ .Name ]]}`.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMonitorSpec"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorTemplateSelector selects the workloads for which a DatadogMonitor is created.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kinds": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Kinds is the list of workload kinds to select. Defaults to Deployment.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the workloads by label. All the workloads of the selected kinds are selected when it isn't set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorTemplateSpec defines the desired state of a DatadogMonitorTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the workloads, in the namespace of the DatadogMonitorTemplate, for which a DatadogMonitor is created.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorTemplateSelector"),
+						},
+					},
+					"monitorTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorTemplate is the template of the DatadogMonitors created for the selected workloads.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorTemplateMonitor"),
+						},
+					},
+				},
+				Required: []string{"monitorTemplate"},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogMonitorTemplateMonitor", "./api/datadoghq/v1alpha1.DatadogMonitorTemplateSelector"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorTemplateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorTemplateStatus defines the observed state of a DatadogMonitorTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the state of a DatadogMonitorTemplate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"monitors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Monitors is the list of DatadogMonitors created from the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"monitorCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorCount is the number of DatadogMonitors created from the template.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorTriggeredState represents the details of a triggering DatadogMonitor The DatadogMonitor is triggering if one of its groups is in Alert, Warn, or No Data",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"monitorGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorGroup is the name of the triggering group",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"monitorGroup"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLO(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLO allows a user to define and manage datadog SLOs from Kubernetes cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogSLOSpec", "./api/datadoghq/v1alpha1.DatadogSLOStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOControllerOptions defines options in the DatadogSLO controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disableRequiredTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableRequiredTags disables the automatic addition of required tags to SLOs.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines how changes made to the SLO outside of Kubernetes are handled: reverted as soon as they are detected (enforce, the default) or only reported (observe).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOCorrection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOCorrection allows to define and manage Datadog SLO corrections from your Kubernetes Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOCorrectionSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOCorrectionStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogSLOCorrectionSpec", "./api/datadoghq/v1alpha1.DatadogSLOCorrectionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOCorrectionSLOReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOCorrectionSLOReference references a DatadogSLO.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the DatadogSLO.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOCorrectionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOCorrectionSpec defines the desired state of a DatadogSLOCorrection",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sloRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SLORef references the DatadogSLO to correct, in the same namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSLOCorrectionSLOReference"),
+						},
+					},
+					"category": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Category is the category of the correction: `Scheduled Maintenance`, `Outside Business Hours`, `Deployment` or `Other`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a description of the correction.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the start of a one-off correction, or of the first occurrence of a recurring correction.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of a one-off correction. It can't be used with Rrule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of each occurrence of a recurring correction, for example `2h`. Required with Rrule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"rrule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rrule is the recurrence rule of a recurring correction, as defined by RFC 5545 (only `FREQ`, `INTERVAL`, `COUNT` and `UNTIL` are supported), for example `FREQ=WEEKLY;BYDAY=SA,SU`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the timezone in which the recurrences are evaluated, for example `Europe/Paris`. Defaults to `UTC`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy defines what happens to the SLO correction in Datadog when the custom resource is deleted: it's deleted (Delete) or kept (Orphan). Defaults to the deletion policy of the operator, Delete unless configured otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"sloRef", "category", "start"},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogSLOCorrectionSLOReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOCorrectionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOCorrectionStatus defines the observed state of a DatadogSLOCorrection",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the state of a DatadogSLOCorrection.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the SLO correction ID generated in Datadog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sloID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SLOID is the ID of the SLO referenced by SLORef.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Creator is the identity of the SLO correction creator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is the time the SLO correction was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"syncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStatus shows the health of syncing the SLO correction state to Datadog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastForceSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastForceSyncTime is the last time the API SLO correction was last force synced with the DatadogSLOCorrection resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogSLOCorrectionSpec to know if the Spec has changed and needs an update.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOMonitorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOMonitorReference references a DatadogMonitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the DatadogMonitor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the DatadogMonitor. Defaults to the namespace of the DatadogSLO.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numerator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Numerator is a Datadog metric query for good events.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"denominator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Denominator is a Datadog metric query for total (valid) events.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"numerator", "denominator"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the service level objective.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a user-defined description of the service level objective. Always included in service level objective responses (but may be null). Optional in create/update requests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups is a list of (up to 100) monitor groups that narrow the scope of a monitor service level objective. Included in service level objective responses if it is not empty. Optional in create/update requests for monitor service level objectives, but may only be used when the length of the monitor_ids field is one.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"monitorIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorIDs is a list of monitor IDs that defines the scope of a monitor service level objective. Required if type is monitor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+					"monitorRefs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorRefs is a list of DatadogMonitor objects that defines the scope of a monitor service level objective, in addition to MonitorIDs. The IDs of the monitors are resolved once they are created in Datadog.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOMonitorReference"),
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is a list of tags to associate with your service level objective. This can help you categorize and filter service level objectives in the service level objectives page of the UI. Note: it's not currently possible to filter by these tags when querying via the API.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the query for a metric-based SLO. Required if type is metric. Note that only the `sum by` aggregator is allowed, which sums all request counts. `Average`, `max`, nor `min` request aggregators are not supported.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSLOQuery"),
+						},
+					},
+					"timeSlice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeSlice is the SLI specification of a time-slice SLO. Required if type is time_slice.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSLOTimeSlice"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the service level objective.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeframe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The SLO time window options.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetThreshold is the target threshold such that when the service level indicator is above this threshold over the given timeframe, the objective is being met.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"warningThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarningThreshold is a optional warning threshold such that when the service level indicator is below this value for the given threshold, but above the target threshold, the objective appears in a \"warning\" state. This value must be greater than the target threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"controllerOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControllerOptions are the optional parameters in the DatadogSLO controller",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSLOControllerOptions"),
+						},
+					},
+					"credentialsRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsRef references a Secret with the Datadog credentials used to manage the SLO, instead of those of the namespace or of the operator.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogCredentialsReference"),
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy defines what happens to the SLO in Datadog when the custom resource is deleted: it's deleted (Delete) or kept, and tagged as no longer managed by the operator (Orphan). Defaults to the deletion policy of the operator, Delete unless configured otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "type", "timeframe", "targetThreshold"},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogCredentialsReference", "./api/datadoghq/v1alpha1.DatadogSLOControllerOptions", "./api/datadoghq/v1alpha1.DatadogSLOMonitorReference", "./api/datadoghq/v1alpha1.DatadogSLOQuery", "./api/datadoghq/v1alpha1.DatadogSLOTimeSlice", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOStatus defines the observed state of a DatadogSLO.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the state of a DatadogSLO.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the monitor name",
+							Description: "ID is the SLO ID generated in Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"creator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message is a message to include with notifications for this monitor",
+							Description: "Creator is the identity of the SLO creator.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"priority": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Priority is an integer from 1 (high) to 5 (low) indicating alert severity",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Created is the time the SLO was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"query": {
+					"syncStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Query is the Datadog monitor query",
+							Description: "SyncStatus shows the health of syncing the SLO state to Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"restrictedRoles": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
+					"lastForceSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastForceSyncTime is the last time the API SLO was last force synced with the DatadogSLO resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
+					},
+					"currentHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RestrictedRoles is a list of unique role identifiers to define which roles are allowed to edit the monitor. `restricted_roles` is the successor of `locked`. For more information about `locked` and `restricted_roles`, see the [monitor options docs](https://docs.datadoghq.com/monitors/guide/monitor_api_options/#permissions-options).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "CurrentHash tracks the hash of the current DatadogSLOSpec to know if the Spec has changed and needs an update.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tags": {
+					"monitorIDs": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Tags is the monitor tags associated with your monitor",
+							Description: "MonitorIDs are the IDs of the monitors referenced by MonitorRefs.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
 									},
 								},
 							},
 						},
 					},
-					"type": {
+					"sliValue": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the monitor type",
+							Description: "SLIValue is the current SLI value of the SLO over its timeframe, in percent.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"options": {
+					"errorBudgetRemaining": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Options are the optional parameters associated with your monitor",
-							Default:     map[string]interface{}{},
-							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorOptions"),
+							Description: "ErrorBudgetRemaining is the remaining error budget of the SLO over its timeframe, in percent.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"controllerOptions": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ControllerOptions are the optional parameters in the DatadogMonitor controller",
-							Default:     map[string]interface{}{},
-							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorControllerOptions"),
+							Description: "State is the state of the SLO over its timeframe, based on its SLI value and thresholds.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stateLastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StateLastUpdateTime is the last time the SLI value, error budget and state were updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogMonitorControllerOptions", "./api/datadoghq/v1alpha1.DatadogMonitorOptions"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogSLOTimeSlice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorStatus defines the observed state of DatadogMonitor",
+				Description: "DatadogSLOTimeSlice defines the SLI of a time-slice SLO: the proportion of time slices in which the formula result meets the condition.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"queries": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
+									"name",
 								},
 								"x-kubernetes-list-type": "map",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions Represents the latest available observations of a DatadogMonitor's current state.",
+							Description: "Queries are the metric queries used by the formula, referenced by their name.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorCondition"),
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOTimeSliceQuery"),
 									},
 								},
 							},
 						},
 					},
-					"id": {
+					"formula": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the monitor ID generated in Datadog",
+							Description: "Formula combines the queries, for example `query1 / query2 * 100`. Defaults to the name of the query when there is only one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comparator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comparator is the comparator used to compare the formula result to the threshold: `>`, `>=`, `<` or `<=`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold is the value the formula result is compared to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"queryIntervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryIntervalSeconds is the duration of a time slice, in seconds: 60 (the default) or 300.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"creator": {
+				},
+				Required: []string{"queries", "comparator", "threshold"},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogSLOTimeSliceQuery", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSLOTimeSliceQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOTimeSliceQuery is a named metric query of a time-slice SLO.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Creator is the identify of the monitor creator",
+							Description: "Name is the name of the query, used in the formula.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"created": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Created is the time the monitor was created",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Query is the Datadog metric query.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"monitorState": {
+				},
+				Required: []string{"name", "query"},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTest allows to define and manage Datadog Synthetic API tests from your Kubernetes Cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorState is the overall state of monitor",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"monitorLastForceSyncTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorLastForceSyncTime is the last time the API monitor was last force synced with the DatadogMonitor resource",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"monitorStateLastUpdateTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorStateLastUpdateTime is the last time the monitor state updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"monitorStateLastTransitionTime": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorStateLastTransitionTime is the last time the monitor state changed",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestSpec"),
 						},
 					},
-					"monitorStateSyncStatus": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorStateSyncStatus shows the health of syncing the monitor state to Datadog",
+							Default: map[string]interface{}{},
+							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogSyntheticTestSpec", "./api/datadoghq/v1alpha1.DatadogSyntheticTestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestAssertion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTestAssertion is an assertion on the response of a request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the assertion, for example `statusCode`, `responseTime`, `header`, `body`, `certificate`, `latency`, `recordEvery` or `recordSome`.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"triggeredState": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"monitorGroup",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+					"operator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TriggeredState only includes details for monitor groups that are triggering",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("./api/datadoghq/v1alpha1.DatadogMonitorTriggeredState"),
-									},
-								},
-							},
+							Description: "Operator is the operator of the assertion, for example `is`, `lessThan`, `contains` or `isInMoreThan`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"downtimeStatus": {
+					"property": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DowntimeStatus defines whether the monitor is downtimed",
-							Default:     map[string]interface{}{},
-							Ref:         ref("./api/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus"),
+							Description: "Property is the property the assertion applies to, like the name of a header or the type of a DNS record.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"primary": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Primary defines whether the monitor is managed by the Kubernetes custom resource (true) or outside Kubernetes (false)",
-							Type:        []string{"boolean"},
+							Description: "Target is the expected value.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"currentHash": {
+					"jsonPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentHash tracks the hash of the current DatadogMonitorSpec to know if the Spec has changed and needs an update",
+							Description: "JSONPath is the JSON path of the value of a `body` assertion. Operator and Target then apply to this value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type", "operator"},
 			},
 		},
-		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogMonitorCondition", "./api/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus", "./api/datadoghq/v1alpha1.DatadogMonitorTriggeredState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorTriggeredState represents the details of a triggering DatadogMonitor The DatadogMonitor is triggering if one of its groups is in Alert, Warn, or No Data",
+				Description: "DatadogSyntheticTestControllerOptions defines options in the DatadogSyntheticTest controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"monitorGroup": {
+					"disableRequiredTags": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorGroup is the name of the triggering group",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "DisableRequiredTags disables the automatic addition of required tags to tests.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"state": {
+				},
+			},
+		},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTestOptions are the run and alerting options of a test.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tickEvery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TickEvery is the frequency of the test, in seconds, between 30 and 604800.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minFailureDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinFailureDuration is the minimum duration of a failure before the test alerts, in seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minLocationFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinLocationFailed is the minimum number of locations that must fail for the test to alert.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is the number of times a failed test is retried before being marked as failed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryInterval is the interval between the retries of a failed test, in milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"acceptSelfSigned": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "AcceptSelfSigned defines whether self-signed certificates are accepted by an `ssl` test.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"monitorPriority": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "MonitorPriority is the priority of the monitor of the test, from 1 (highest) to 5 (lowest).",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"monitorGroup"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogSLO(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogSLO allows a user to define and manage datadog SLOs from Kubernetes cluster.",
+				Description: "DatadogSyntheticTestRequest is the request of an API test, or of a step of a multistep test.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Method is the HTTP method of an `http` request, for example `GET`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "URL is the URL of an `http` request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Headers are the headers of an `http` request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"spec": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOSpec"),
+							Description: "Body is the body of an `http` request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"followRedirects": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./api/datadoghq/v1alpha1.DatadogSLOStatus"),
+							Description: "FollowRedirects defines whether the redirections of an `http` request are followed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the host of a `tcp`, `dns` or `ssl` request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port of a `tcp` or `ssl` request.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"dnsServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSServer is the DNS server of a `dns` request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsServerPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSServerPort is the port of the DNS server of a `dns` request.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout of the request, in seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogSLOSpec", "./api/datadoghq/v1alpha1.DatadogSLOStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogSLOControllerOptions defines options in the DatadogSLO controller.",
+				Description: "DatadogSyntheticTestResult is a result of a test run.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"disableRequiredTags": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisableRequiredTags disables the automatic addition of required tags to SLOs.",
-							Type:        []string{"boolean"},
+							Description: "ID is the ID of the result.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema__api_datadoghq_v1alpha1_DatadogSLOQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"numerator": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Numerator is a Datadog metric query for good events.",
-							Default:     "",
+							Description: "Status is the status of the result, `Passed` or `Failed`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"denominator": {
+					"location": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Denominator is a Datadog metric query for total (valid) events.",
-							Default:     "",
+							Description: "Location is the location the test ran from.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"checkTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckTime is the time the test ran.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
-				Required: []string{"numerator", "denominator"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogSLOSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DatadogSyntheticTestSpec defines the desired state of a DatadogSyntheticTest",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the service level objective.",
+							Description: "Name is the name of the test.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is a user-defined description of the service level objective. Always included in service level objective responses (but may be null). Optional in create/update requests.",
+							Description: "Message is the notification message of the test.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"groups": {
+					"subtype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subtype is the subtype of the API test: `http`, `tcp`, `dns`, `ssl`, or `multi` for a multistep test.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"locations": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Groups is a list of (up to 100) monitor groups that narrow the scope of a monitor service level objective. Included in service level objective responses if it is not empty. Optional in create/update requests for monitor service level objectives, but may only be used when the length of the monitor_ids field is one.",
+							Description: "Locations is the list of locations the test runs from, for example `aws:eu-west-1`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1504,101 +4325,110 @@ func schema__api_datadoghq_v1alpha1_DatadogSLOSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
-					"monitorIDs": {
+					"tags": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorIDs is a list of monitor IDs that defines the scope of a monitor service level objective. Required if type is monitor.",
+							Description: "Tags is a list of tags to associate with the test.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: 0,
-										Type:    []string{"integer"},
-										Format:  "int64",
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"tags": {
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused pauses the test. Tests are live by default.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Request is the request of the test. Required for all subtypes except `multi`.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestRequest"),
+						},
+					},
+					"assertions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Tags is a list of tags to associate with your service level objective. This can help you categorize and filter service level objectives in the service level objectives page of the UI. Note: it's not currently possible to filter by these tags when querying via the API.",
+							Description: "Assertions is the list of assertions on the response of the request. Required for all subtypes except `multi`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestAssertion"),
 									},
 								},
 							},
 						},
 					},
-					"query": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Query is the query for a metric-based SLO. Required if type is metric. Note that only the `sum by` aggregator is allowed, which sums all request counts. `Average`, `max`, nor `min` request aggregators are not supported.",
-							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSLOQuery"),
-						},
-					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the service level objective.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+					"steps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"timeframe": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The SLO time window options.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Steps is the list of HTTP steps of a `multi` test, run in order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestStep"),
+									},
+								},
+							},
 						},
 					},
-					"targetThreshold": {
+					"options": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetThreshold is the target threshold such that when the service level indicator is above this threshold over the given timeframe, the objective is being met.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Options are the run and alerting options of the test.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestOptions"),
 						},
 					},
-					"warningThreshold": {
+					"controllerOptions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WarningThreshold is a optional warning threshold such that when the service level indicator is below this value for the given threshold, but above the target threshold, the objective appears in a \"warning\" state. This value must be greater than the target threshold.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "ControllerOptions are the optional parameters in the DatadogSyntheticTest controller.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestControllerOptions"),
 						},
 					},
-					"controllerOptions": {
+					"deletionPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ControllerOptions are the optional parameters in the DatadogSLO controller",
-							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSLOControllerOptions"),
+							Description: "DeletionPolicy defines what happens to the test in Datadog when the custom resource is deleted: it's deleted (Delete) or kept, and tagged as no longer managed by the operator (Orphan). Defaults to the deletion policy of the operator, Delete unless configured otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "type", "timeframe", "targetThreshold"},
+				Required: []string{"name", "subtype", "locations"},
 			},
 		},
 		Dependencies: []string{
-			"./api/datadoghq/v1alpha1.DatadogSLOControllerOptions", "./api/datadoghq/v1alpha1.DatadogSLOQuery", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"./api/datadoghq/v1alpha1.DatadogSyntheticTestAssertion", "./api/datadoghq/v1alpha1.DatadogSyntheticTestControllerOptions", "./api/datadoghq/v1alpha1.DatadogSyntheticTestOptions", "./api/datadoghq/v1alpha1.DatadogSyntheticTestRequest", "./api/datadoghq/v1alpha1.DatadogSyntheticTestStep"},
 	}
 }
 
-func schema__api_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogSLOStatus defines the observed state of a DatadogSLO.",
+				Description: "DatadogSyntheticTestStatus defines the observed state of a DatadogSyntheticTest",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -1611,7 +4441,7 @@ func schema__api_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallbac
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of the state of a DatadogSLO.",
+							Description: "Conditions represents the latest available observations of the state of a DatadogSyntheticTest.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1625,40 +4455,59 @@ func schema__api_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallbac
 					},
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the SLO ID generated in Datadog.",
+							Description: "ID is the public ID of the test generated in Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"creator": {
+					"monitorID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Creator is the identity of the SLO creator.",
+							Description: "MonitorID is the ID of the monitor of the test.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is the time the test was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"testStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TestStatus is the status of the test in Datadog, `live` or `paused`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"created": {
+					"lastResult": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Created is the time the SLO was created.",
+							Description: "LastResult is the latest result of the test.",
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestResult"),
+						},
+					},
+					"resultLastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResultLastUpdateTime is the last time the latest result of the test was fetched.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"syncStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SyncStatus shows the health of syncing the SLO state to Datadog.",
+							Description: "SyncStatus shows the health of syncing the test state to Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"lastForceSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastForceSyncTime is the last time the API SLO was last force synced with the DatadogSLO resource.",
+							Description: "LastForceSyncTime is the last time the API test was last force synced with the DatadogSyntheticTest resource.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"currentHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentHash tracks the hash of the current DatadogSLOSpec to know if the Spec has changed and needs an update.",
+							Description: "CurrentHash tracks the hash of the current DatadogSyntheticTestSpec to know if the Spec has changed and needs an update.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1667,7 +4516,71 @@ func schema__api_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"./api/datadoghq/v1alpha1.DatadogSyntheticTestResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__api_datadoghq_v1alpha1_DatadogSyntheticTestStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTestStep is an HTTP step of a multistep test.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the step.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Request is the HTTP request of the step.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestRequest"),
+						},
+					},
+					"assertions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Assertions is the list of assertions on the response of the request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./api/datadoghq/v1alpha1.DatadogSyntheticTestAssertion"),
+									},
+								},
+							},
+						},
+					},
+					"allowFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowFailure defines whether the test continues when the step fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"isCritical": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsCritical defines whether the test fails when the step fails. Can only be used with AllowFailure.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "request", "assertions"},
+			},
+		},
+		Dependencies: []string{
+			"./api/datadoghq/v1alpha1.DatadogSyntheticTestAssertion", "./api/datadoghq/v1alpha1.DatadogSyntheticTestRequest"},
 	}
 }
 
@@ -12,6 +12,10 @@ const (
 	OverrideReconcileConflictConditionType = "OverrideReconcileConflict"
 	// DatadogAgentReconcileErrorConditionType ReconcileConditionType for DatadogAgent reconcile error
 	DatadogAgentReconcileErrorConditionType = "DatadogAgentReconcileError"
+	// GKEAutopilotConflictConditionType ReconcileConditionType for an Agent that can't be admitted on GKE Autopilot
+	GKEAutopilotConflictConditionType = "GKEAutopilotConflict"
+	// FeatureDisabledConditionTypePrefix prefix of the ReconcileConditionType reported for each feature disabled by the Operator
+	FeatureDisabledConditionTypePrefix = "FeatureDisabled-"
 
 	// ExtraConfdConfigMapName is the name of the ConfigMap storing Custom Confd data
 	ExtraConfdConfigMapName = "%s-extra-confd"
@@ -1072,6 +1072,13 @@ type GlobalConfig struct {
 
 	// FIPS contains configuration used to customize the FIPS proxy sidecar.
 	FIPS *FIPSConfig `json:"fips,omitempty"`
+
+	// GKEAutopilot constrains the Agent to the configurations admitted on GKE Autopilot.
+	// Features that require the system-probe or the security-agent are disabled, host path volumes are
+	// restricted to the allowlisted ones, and DaemonSets that still can't be admitted fail the reconcile.
+	// Default: true if the Operator detects a GKE Autopilot cluster, false otherwise.
+	// +optional
+	GKEAutopilot *bool `json:"gkeAutopilot,omitempty"`
 }
 
 // DatadogCredentials is a generic structure that holds credentials to access Datadog.
@@ -1448,6 +1455,9 @@ type DatadogAgentStatus struct {
 	// RemoteConfigConfiguration stores the configuration received from RemoteConfig.
 	// +optional
 	RemoteConfigConfiguration *RemoteConfigConfiguration `json:"remoteConfigConfiguration,omitempty"`
+	// Distribution is the Kubernetes distribution detected by the Operator (e.g. openshift, eks, gke, gke-autopilot, aks, k3s, rke2, kind).
+	// +optional
+	Distribution string `json:"distribution,omitempty"`
 }
 
 // DatadogAgent Deployment with the Datadog Operator.
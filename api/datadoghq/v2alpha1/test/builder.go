@@ -751,6 +751,13 @@ func (builder *DatadogAgentBuilder) WithSingleContainerStrategy(enabled bool) *D
 	return builder
 }
 
+// Global GKEAutopilot
+
+func (builder *DatadogAgentBuilder) WithGKEAutopilot(enabled bool) *DatadogAgentBuilder {
+	builder.datadogAgent.Spec.Global.GKEAutopilot = apiutils.NewBoolPointer(enabled)
+	return builder
+}
+
 // Global Credentials
 
 func (builder *DatadogAgentBuilder) WithCredentials(apiKey, appKey string) *DatadogAgentBuilder {
@@ -79,6 +79,11 @@ func IsHostNetworkEnabled(dda *DatadogAgent, component ComponentName) bool {
 	return false
 }
 
+// IsGKEAutopilotEnabled returns whether the Agent must be rendered in GKE Autopilot compatible mode
+func IsGKEAutopilotEnabled(dda *DatadogAgent) bool {
+	return dda.Spec.Global != nil && apiutils.BoolValue(dda.Spec.Global.GKEAutopilot)
+}
+
 // IsClusterChecksEnabled returns whether the DDA should use cluster checks
 func IsClusterChecksEnabled(dda *DatadogAgent) bool {
 	return dda.Spec.Features.ClusterChecks != nil && apiutils.BoolValue(dda.Spec.Features.ClusterChecks.Enabled)
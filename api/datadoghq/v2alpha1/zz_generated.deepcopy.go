@@ -1460,6 +1460,11 @@ func (in *GlobalConfig) DeepCopyInto(out *GlobalConfig) {
 		*out = new(FIPSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GKEAutopilot != nil {
+		in, out := &in.GKEAutopilot, &out.GKEAutopilot
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalConfig.
@@ -371,6 +371,13 @@ func schema__api_datadoghq_v2alpha1_DatadogAgentStatus(ref common.ReferenceCallb
 							Ref:         ref("./api/datadoghq/v2alpha1.RemoteConfigConfiguration"),
 						},
 					},
+					"distribution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Distribution is the Kubernetes distribution detected by the Operator (e.g. openshift, eks, gke, gke-autopilot, aks, k3s, rke2, kind).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -0,0 +1,46 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// fake-datadog-api serves the fake Datadog API of the fakedatadog package, so that the Operator controllers can run
+// against it in a cluster, like in the kind e2e tests, without a Datadog organization.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/DataDog/datadog-operator/pkg/testutils/fakedatadog"
+)
+
+func main() {
+	var (
+		addr       string
+		latency    time.Duration
+		rateLimit  int
+		ratePeriod time.Duration
+		apiKey     string
+		appKey     string
+	)
+	flag.StringVar(&addr, "addr", ":8080", "The address the fake Datadog API listens on.")
+	flag.DurationVar(&latency, "latency", 0, "The latency added to every request.")
+	flag.IntVar(&rateLimit, "rate-limit", 0, "The number of requests allowed per rate limit period and per endpoint, unlimited if not positive.")
+	flag.DurationVar(&ratePeriod, "rate-period", time.Minute, "The rate limit period.")
+	flag.StringVar(&apiKey, "api-key", "", "The only API key accepted, any if empty.")
+	flag.StringVar(&appKey, "app-key", "", "The only application key accepted, with the API key.")
+	flag.Parse()
+
+	opts := []fakedatadog.Option{fakedatadog.WithLatency(latency), fakedatadog.WithRateLimit(rateLimit, ratePeriod)}
+	if apiKey != "" {
+		opts = append(opts, fakedatadog.WithCredentials(apiKey, appKey))
+	}
+
+	log.Printf("Serving the fake Datadog API on %s", addr)
+	server := &http.Server{Addr: addr, Handler: fakedatadog.NewAPI(opts...), ReadHeaderTimeout: 10 * time.Second}
+	if err := server.ListenAndServe(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,131 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var getExample = `
+  # show the details of the DatadogDashboard named foo, with a link to the dashboard in Datadog
+  %[1]s get foo --site datadoghq.eu
+`
+
+// options provides information required by Datadog dashboard get command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args          []string
+	dashboardName string
+	site          string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "get" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "get <DatadogDashboard name> [flags]",
+		Short:        "Show the details of a DatadogDashboard",
+		Example:      fmt.Sprintf(getExample, "kubectl datadog dashboard"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.site, "site", "", "Datadog site of the links to Datadog, defaults to the DD_SITE environment variable, then to datadoghq.com")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.dashboardName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogDashboard is required")
+	}
+
+	return nil
+}
+
+// run runs the get command.
+func (o *options) run() error {
+	dashboard, err := common.GetDatadogDashboard(context.TODO(), o.Client, o.UserNamespace, o.dashboardName)
+	if err != nil {
+		return err
+	}
+
+	printDashboard(o.Out, dashboard, common.DatadogAppURL(o.site), time.Now())
+
+	return nil
+}
+
+// printDashboard prints the details of a DatadogDashboard.
+func printDashboard(out io.Writer, dashboard *v1alpha1.DatadogDashboard, appURL string, now time.Time) {
+	common.PrintField(out, "Name", dashboard.Namespace+"/"+dashboard.Name)
+	if dashboard.Status.ID != "" {
+		common.PrintField(out, "ID", dashboard.Status.ID)
+		common.PrintField(out, "URL", common.DatadogDashboardURL(appURL, dashboard))
+	}
+	common.PrintField(out, "Title", dashboard.Spec.Title)
+	if dashboard.Spec.ConfigMapRef != nil {
+		common.PrintField(out, "ConfigMap", dashboard.Spec.ConfigMapRef.Name)
+	}
+	common.PrintField(out, "Layout", string(dashboard.Spec.LayoutType))
+	if dashboard.Status.Creator != "" {
+		common.PrintField(out, "Creator", dashboard.Status.Creator)
+	}
+	common.PrintField(out, "Sync status", string(dashboard.Status.SyncStatus))
+	if dashboard.Status.LastForceSyncTime != nil {
+		common.PrintField(out, "Last force sync", duration.HumanDuration(now.Sub(dashboard.Status.LastForceSyncTime.Time))+" ago")
+	}
+
+	if len(dashboard.Status.Conditions) > 0 {
+		fmt.Fprintln(out, "\nConditions:")
+		table := common.NewTable(out, "TYPE", "STATUS", "LAST TRANSITION", "MESSAGE")
+		for _, condition := range dashboard.Status.Conditions {
+			table.Append([]string{condition.Type, string(condition.Status), duration.HumanDuration(now.Sub(condition.LastTransitionTime.Time)), condition.Message})
+		}
+		table.Render()
+	}
+}
@@ -0,0 +1,121 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var listExample = `
+  # list the DatadogDashboards of the current namespace with their links
+  %[1]s list
+
+  # list the DatadogDashboards of all the namespaces, with links to the dashboards in Datadog EU
+  %[1]s list -A --site datadoghq.eu
+`
+
+// options provides information required by Datadog dashboard list command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args          []string
+	site          string
+	allNamespaces bool
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "list" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "list [flags]",
+		Short:        "List the DatadogDashboards with their sync status, Datadog ID and link",
+		Example:      fmt.Sprintf(listExample, "kubectl datadog dashboard"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&o.allNamespaces, "all-namespaces", "A", false, "List the DatadogDashboards of all the namespaces")
+	cmd.Flags().StringVar(&o.site, "site", "", "Datadog site of the links to Datadog, defaults to the DD_SITE environment variable, then to datadoghq.com")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) > 0 {
+		return errors.New("no arguments are allowed")
+	}
+
+	return nil
+}
+
+// run runs the list command.
+func (o *options) run() error {
+	namespace := o.UserNamespace
+	if o.allNamespaces {
+		namespace = ""
+	}
+	dashboards := &v1alpha1.DatadogDashboardList{}
+	if err := o.Client.List(context.TODO(), dashboards, &client.ListOptions{Namespace: namespace}); err != nil {
+		return fmt.Errorf("unable to list DatadogDashboard: %w", err)
+	}
+
+	printDashboards(o.Out, dashboards.Items, common.DatadogAppURL(o.site))
+
+	return nil
+}
+
+// printDashboards prints a table of DatadogDashboards.
+func printDashboards(out io.Writer, dashboards []v1alpha1.DatadogDashboard, appURL string) {
+	table := common.NewTable(out, "NAMESPACE", "NAME", "ID", "TITLE", "SYNC STATUS", "URL")
+	for i := range dashboards {
+		dashboard := &dashboards[i]
+		table.Append([]string{
+			dashboard.Namespace,
+			dashboard.Name,
+			dashboard.Status.ID,
+			dashboard.Spec.Title,
+			string(dashboard.Status.SyncStatus),
+			common.DatadogDashboardURL(appURL, dashboard),
+		})
+	}
+	table.Render()
+}
@@ -0,0 +1,48 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package list
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_printDashboards(t *testing.T) {
+	dashboards := []v1alpha1.DatadogDashboard{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo"},
+			Spec:       v1alpha1.DatadogDashboardSpec{Title: "Checkout"},
+			Status: v1alpha1.DatadogDashboardStatus{
+				ID:         "abc-def-ghi",
+				SyncStatus: v1alpha1.DatadogDashboardSyncStatusOK,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "new"},
+			Spec:       v1alpha1.DatadogDashboardSpec{Title: "New"},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	printDashboards(out, dashboards, "https://app.datadoghq.com")
+
+	var got [][]string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		got = append(got, strings.Fields(line))
+	}
+	want := [][]string{
+		{"NAMESPACE", "NAME", "ID", "TITLE", "SYNC", "STATUS", "URL"},
+		{"bar", "foo", "abc-def-ghi", "Checkout", "OK", "https://app.datadoghq.com/dashboard/abc-def-ghi"},
+		{"bar", "new", "New"},
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,110 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package open
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var openExample = `
+  # open the DatadogDashboard named foo in the browser
+  %[1]s open foo --site datadoghq.eu
+
+  # only print the link to the dashboard of the DatadogDashboard named foo
+  %[1]s open foo --print
+`
+
+// options provides information required by Datadog dashboard open command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args          []string
+	dashboardName string
+	site          string
+	printOnly     bool
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "open" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "open <DatadogDashboard name> [flags]",
+		Short:        "Open the dashboard of a DatadogDashboard in the browser",
+		Example:      fmt.Sprintf(openExample, "kubectl datadog dashboard"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.printOnly, "print", false, "Only print the link to the dashboard")
+	cmd.Flags().StringVar(&o.site, "site", "", "Datadog site of the links to Datadog, defaults to the DD_SITE environment variable, then to datadoghq.com")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.dashboardName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogDashboard is required")
+	}
+
+	return nil
+}
+
+// run runs the open command.
+func (o *options) run() error {
+	dashboard, err := common.GetDatadogDashboard(context.TODO(), o.Client, o.UserNamespace, o.dashboardName)
+	if err != nil {
+		return err
+	}
+
+	url := common.DatadogDashboardURL(common.DatadogAppURL(o.site), dashboard)
+	if url == "" {
+		return fmt.Errorf("DatadogDashboard %s/%s isn't created in Datadog yet", dashboard.Namespace, dashboard.Name)
+	}
+
+	fmt.Fprintln(o.Out, url)
+	if o.printOnly {
+		return nil
+	}
+
+	return common.OpenBrowser(url)
+}
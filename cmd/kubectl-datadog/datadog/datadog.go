@@ -8,9 +8,13 @@ package datadog
 import (
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/agent/agent"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/clusteragent/clusteragent"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/dashboard"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/export"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/flare"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/get"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/metrics"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/slo"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/validate/validate"
 
 	"github.com/spf13/cobra"
@@ -51,6 +55,12 @@ func NewCmd(streams genericclioptions.IOStreams) *cobra.Command {
 	// DatadogMetric commands
 	cmd.AddCommand(metrics.New(streams))
 
+	// Datadog monitors, SLOs and dashboards commands
+	cmd.AddCommand(export.New(streams))
+	cmd.AddCommand(monitor.New(streams))
+	cmd.AddCommand(slo.New(streams))
+	cmd.AddCommand(dashboard.New(streams))
+
 	o := newOptions(streams)
 	o.configFlags.AddFlags(cmd.Flags())
 
@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package export
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogdashboard"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const datadogDashboardKind = "DatadogDashboard"
+
+// runDashboards runs the export dashboards command.
+func (o *options) runDashboards() error {
+	ddClient, err := datadogclient.InitDatadogDashboardClient(logr.Discard(), o.creds())
+	if err != nil {
+		return err
+	}
+
+	ids, err := o.listDashboardIDs(ddClient)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		dashboard, _, err := ddClient.Client.GetDashboard(ddClient.Auth, id)
+		if err != nil {
+			return fmt.Errorf("unable to get dashboard %s: %w", id, err)
+		}
+		// The dashboards API doesn't filter by tag
+		if !o.hasTags(dashboard.GetTags()) {
+			continue
+		}
+		spec, err := datadogdashboard.ExportDashboardSpec(dashboard)
+		if err != nil {
+			o.warn("dashboard", id, err)
+			continue
+		}
+		if err = o.write(datadogDashboardKind, o.objectMeta(dashboard.GetTitle(), id, v1alpha1.DatadogDashboardAdoptIDAnnotationKey), &spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listDashboardIDs returns the IDs of the dashboards selected by the --id and --name flags.
+func (o *options) listDashboardIDs(ddClient datadogclient.DatadogDashboardClient) ([]string, error) {
+	if len(o.ids) > 0 {
+		return o.ids, nil
+	}
+
+	params := datadogV1.NewListDashboardsOptionalParameters().WithCount(pageSize)
+	var ids []string
+	for start := int64(0); ; start += pageSize {
+		list, _, err := ddClient.Client.ListDashboards(ddClient.Auth, *params.WithStart(start))
+		if err != nil {
+			return nil, fmt.Errorf("unable to list dashboards: %w", err)
+		}
+		for _, summary := range list.GetDashboards() {
+			if o.matchesName(summary.GetTitle()) {
+				ids = append(ids, summary.GetId())
+			}
+		}
+		if len(list.GetDashboards()) < pageSize {
+			return ids, nil
+		}
+	}
+}
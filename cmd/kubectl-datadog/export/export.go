@@ -0,0 +1,242 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package export
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/config"
+)
+
+const (
+	// maxNameLength keeps the exported object names readable, they are suffixed with the Datadog ID to be unique.
+	maxNameLength = 40
+	pageSize      = 100
+)
+
+var (
+	exportExample = `
+  # export all the monitors tagged with team:checkout
+  %[1]s export monitors --tags team:checkout > monitors.yaml
+
+  # export two SLOs by ID, to the namespace datadog
+  %[1]s export slos --id 4fa2b2a0c3d75ef0b8d7e1b3a0bfe9b5 --id 9c1e3d5b7a9f4e2d8c6b4a2f0e8d6c4b -n datadog
+
+  # export the dashboards whose title contains "Checkout", one file per dashboard
+  %[1]s export dashboards --name Checkout --output-dir ./dashboards
+`
+	invalidNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// options provides information required by the export commands.
+type options struct {
+	genericclioptions.IOStreams
+
+	apiKey    string
+	appKey    string
+	ids       []string
+	name      string
+	tags      []string
+	adopt     bool
+	outputDir string
+	namespace string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	return &options{
+		IOStreams: streams,
+		adopt:     true,
+	}
+}
+
+// New provides a cobra command wrapping options for "export" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:     "export [subcommand] [flags]",
+		Short:   "Export Datadog monitors, SLOs and dashboards as Kubernetes manifests",
+		Example: fmt.Sprintf(exportExample, "kubectl datadog"),
+	}
+
+	cmd.AddCommand(newSubCmd(o, "monitors", "Export Datadog monitors as DatadogMonitor manifests", o.runMonitors))
+	cmd.AddCommand(newSubCmd(o, "slos", "Export Datadog SLOs as DatadogSLO manifests", o.runSLOs))
+	cmd.AddCommand(newSubCmd(o, "dashboards", "Export Datadog dashboards as DatadogDashboard manifests", o.runDashboards))
+
+	cmd.PersistentFlags().StringVar(&o.apiKey, "api-key", "", "Datadog API key, defaults to the DD_API_KEY environment variable")
+	cmd.PersistentFlags().StringVar(&o.appKey, "app-key", "", "Datadog application key, defaults to the DD_APP_KEY environment variable")
+	cmd.PersistentFlags().StringSliceVar(&o.ids, "id", nil, "ID of an object to export, can be repeated")
+	cmd.PersistentFlags().StringVar(&o.name, "name", "", "Only export the objects whose name contains this value")
+	cmd.PersistentFlags().StringSliceVar(&o.tags, "tags", nil, "Only export the objects with all these tags")
+	cmd.PersistentFlags().BoolVar(&o.adopt, "adopt", o.adopt, "Annotate the manifests so that the Operator adopts the exported objects instead of creating new ones")
+	cmd.PersistentFlags().StringVar(&o.outputDir, "output-dir", "", "Write one file per object in this directory instead of the standard output")
+	cmd.PersistentFlags().StringVarP(&o.namespace, "namespace", "n", "", "Namespace of the exported manifests")
+
+	return cmd
+}
+
+func newSubCmd(o *options, use, short string, run func() error) *cobra.Command {
+	return &cobra.Command{
+		Use:          use + " [flags]",
+		Short:        short,
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return run()
+		},
+	}
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(args []string) error {
+	if len(args) > 0 {
+		return errors.New("no arguments are allowed")
+	}
+	if o.apiKey == "" {
+		o.apiKey = os.Getenv(config.DDAPIKeyEnvVar)
+	}
+	if o.appKey == "" {
+		o.appKey = os.Getenv(config.DDAppKeyEnvVar)
+	}
+
+	return nil
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if o.apiKey == "" || o.appKey == "" {
+		return fmt.Errorf("an API key and an application key are required, set them with --api-key and --app-key or the %s and %s environment variables", config.DDAPIKeyEnvVar, config.DDAppKeyEnvVar)
+	}
+	if len(o.ids) > 0 && (o.name != "" || len(o.tags) > 0) {
+		return errors.New("--id can't be used with --name or --tags")
+	}
+
+	return nil
+}
+
+func (o *options) creds() config.Creds {
+	return config.Creds{APIKey: o.apiKey, AppKey: o.appKey}
+}
+
+// objectMeta returns the metadata of an exported object.
+func (o *options) objectMeta(title, id, adoptAnnotationKey string) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"name": objectName(title, id),
+	}
+	if o.namespace != "" {
+		metadata["namespace"] = o.namespace
+	}
+	if o.adopt {
+		metadata["annotations"] = map[string]interface{}{adoptAnnotationKey: id}
+	}
+
+	return metadata
+}
+
+// write writes the manifest of an exported object, without its status.
+func (o *options) write(kind string, metadata map[string]interface{}, spec interface{}) error {
+	rawSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+	if err != nil {
+		return fmt.Errorf("unable to convert %s %s: %w", kind, metadata["name"], err)
+	}
+	pruneEmptyObjects(rawSpec)
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": v1alpha1.GroupVersion.String(),
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       rawSpec,
+	}}
+
+	manifest, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return fmt.Errorf("unable to marshal %s %s: %w", kind, obj.GetName(), err)
+	}
+
+	if o.outputDir == "" {
+		_, err = fmt.Fprintf(o.Out, "---\n%s", manifest)
+		return err
+	}
+
+	if err = os.MkdirAll(o.outputDir, 0o755); err != nil {
+		return err
+	}
+	fileName := filepath.Join(o.outputDir, fmt.Sprintf("%s-%s.yaml", strings.ToLower(kind), obj.GetName()))
+	return os.WriteFile(fileName, manifest, 0o644)
+}
+
+// pruneEmptyObjects removes the empty nested objects, like the struct fields that can't be omitted when they are empty.
+func pruneEmptyObjects(obj map[string]interface{}) {
+	for key, value := range obj {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		pruneEmptyObjects(nested)
+		if len(nested) == 0 {
+			delete(obj, key)
+		}
+	}
+}
+
+// warn reports an object that can't be exported, without failing the export of the others.
+func (o *options) warn(kind, id string, err error) {
+	fmt.Fprintf(o.ErrOut, "Skipping %s %s: %v\n", kind, id, err)
+}
+
+// matchesName returns true if the name of an object contains the --name filter.
+func (o *options) matchesName(name string) bool {
+	return o.name == "" || strings.Contains(strings.ToLower(name), strings.ToLower(o.name))
+}
+
+// hasTags returns true if an object has all the tags of the --tags filter.
+func (o *options) hasTags(tags []string) bool {
+	for _, wanted := range o.tags {
+		found := false
+		for _, tag := range tags {
+			if tag == wanted {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// objectName returns a valid Kubernetes object name for an object exported from Datadog.
+func objectName(title, id string) string {
+	name := strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(name) > maxNameLength {
+		name = strings.TrimRight(name[:maxNameLength], "-")
+	}
+	id = strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(id), "-"), "-")
+	if name == "" {
+		return id
+	}
+
+	return name + "-" + id
+}
@@ -0,0 +1,241 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/config"
+)
+
+func Test_export(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(o *options)
+		run        func(o *options) error
+		wantOut    []string
+		wantErrOut string
+		wantQuery  map[string]string
+	}{
+		{
+			name: "export monitors by tag",
+			setup: func(o *options) {
+				o.tags = []string{"team:checkout"}
+				o.namespace = "datadog"
+			},
+			run: func(o *options) error { return o.runMonitors() },
+			wantOut: []string{`---
+apiVersion: datadoghq.com/v1alpha1
+kind: DatadogMonitor
+metadata:
+  annotations:
+    datadoghq.com/adopt-monitor-id: "1234"
+  name: checkout-error-rate-1234
+  namespace: datadog
+spec:
+  message: Errors are increasing
+  name: Checkout error rate
+  options:
+    thresholds:
+      critical: "10"
+  query: sum(last_5m):sum:trace.http.request.errors{service:checkout}.as_count() >
+    10
+  tags:
+  - team:checkout
+  type: query alert
+`},
+			wantQuery: map[string]string{"monitor_tags": "team:checkout"},
+		},
+		{
+			name: "export SLOs without the adoption annotation, skipping unsupported ones",
+			setup: func(o *options) {
+				o.adopt = false
+				o.ids = []string{"abc", "def"}
+			},
+			run: func(o *options) error { return o.runSLOs() },
+			wantOut: []string{`---
+apiVersion: datadoghq.com/v1alpha1
+kind: DatadogSLO
+metadata:
+  name: checkout-availability-abc
+spec:
+  monitorIDs:
+  - 1234
+  name: Checkout availability
+  targetThreshold: 99900m
+  timeframe: 30d
+  type: monitor
+`},
+			wantErrOut: "Skipping SLO def: SLO timeframe custom is not supported\n",
+			wantQuery:  map[string]string{"ids": "abc,def"},
+		},
+		{
+			name: "export dashboards by name and tag",
+			setup: func(o *options) {
+				o.name = "checkout"
+				o.tags = []string{"team:checkout"}
+			},
+			run: func(o *options) error { return o.runDashboards() },
+			wantOut: []string{`---
+apiVersion: datadoghq.com/v1alpha1
+kind: DatadogDashboard
+metadata:
+  annotations:
+    datadoghq.com/adopt-dashboard-id: abc-def-ghi
+  name: checkout-abc-def-ghi
+spec:
+  layoutType: ordered
+  tags:
+  - team:checkout
+  title: Checkout
+  widgets: '[{"definition":{"content":"Checkout service","has_padding":true,"type":"note"}}]'
+`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := map[string]string{}
+			httpServer := httptest.NewServer(fakeDatadogAPI(t, queries))
+			defer httpServer.Close()
+			t.Setenv(config.DDURLEnvVar, httpServer.URL)
+
+			streams, _, out, errOut := genericclioptions.NewTestIOStreams()
+			o := newOptions(streams)
+			o.apiKey = "api-key"
+			o.appKey = "app-key"
+			tt.setup(o)
+
+			require.NoError(t, tt.run(o))
+			assert.Equal(t, strings.Join(tt.wantOut, ""), out.String())
+			assert.Equal(t, tt.wantErrOut, errOut.String())
+			for key, value := range tt.wantQuery {
+				assert.Equal(t, value, queries[key])
+			}
+		})
+	}
+}
+
+func Test_export_outputDir(t *testing.T) {
+	httpServer := httptest.NewServer(fakeDatadogAPI(t, map[string]string{}))
+	defer httpServer.Close()
+	t.Setenv(config.DDURLEnvVar, httpServer.URL)
+
+	streams, _, out, _ := genericclioptions.NewTestIOStreams()
+	o := newOptions(streams)
+	o.apiKey = "api-key"
+	o.appKey = "app-key"
+	o.outputDir = t.TempDir()
+
+	require.NoError(t, o.runMonitors())
+	assert.Empty(t, out.String())
+
+	raw, err := os.ReadFile(filepath.Join(o.outputDir, "datadogmonitor-checkout-error-rate-1234.yaml"))
+	require.NoError(t, err)
+	monitor := &v1alpha1.DatadogMonitor{}
+	require.NoError(t, yaml.Unmarshal(raw, monitor))
+	assert.Equal(t, "Checkout error rate", monitor.Spec.Name)
+	assert.Equal(t, "1234", monitor.Annotations[v1alpha1.DatadogMonitorAdoptIDAnnotationKey])
+}
+
+func Test_objectName(t *testing.T) {
+	tests := []struct {
+		title string
+		id    string
+		want  string
+	}{
+		{title: "Checkout error rate", id: "1234", want: "checkout-error-rate-1234"},
+		{title: "[Prod] CPU > 90% on {{host.name}}", id: "1234", want: "prod-cpu-90-on-host-name-1234"},
+		{title: "A very long monitor name that goes on and on and on", id: "1234", want: "a-very-long-monitor-name-that-goes-on-an-1234"},
+		{title: "", id: "ABC-def", want: "abc-def"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, objectName(tt.title, tt.id))
+		})
+	}
+}
+
+// fakeDatadogAPI serves the Datadog API endpoints used by the export commands, and records the query parameters.
+func fakeDatadogAPI(t *testing.T, queries map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for key := range r.URL.Query() {
+			queries[key] = r.URL.Query().Get(key)
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		var body interface{}
+		switch r.URL.Path {
+		case "/api/v1/monitor":
+			monitor := datadogV1.NewMonitor("sum(last_5m):sum:trace.http.request.errors{service:checkout}.as_count() > 10", datadogV1.MONITORTYPE_QUERY_ALERT)
+			monitor.SetId(1234)
+			monitor.SetName("Checkout error rate")
+			monitor.SetMessage("Errors are increasing")
+			monitor.SetTags([]string{"team:checkout"})
+			monitor.SetOptions(datadogV1.MonitorOptions{Thresholds: &datadogV1.MonitorThresholds{Critical: datadog.PtrFloat64(10)}})
+			body = []datadogV1.Monitor{*monitor}
+		case "/api/v1/slo":
+			supported := datadogV1.NewServiceLevelObjective("Checkout availability", []datadogV1.SLOThreshold{{Target: 99.9, Timeframe: datadogV1.SLOTIMEFRAME_THIRTY_DAYS}}, datadogV1.SLOTYPE_MONITOR)
+			supported.SetId("abc")
+			supported.SetMonitorIds([]int64{1234})
+			unsupported := datadogV1.NewServiceLevelObjective("Custom", []datadogV1.SLOThreshold{{Target: 99, Timeframe: datadogV1.SLOTIMEFRAME_CUSTOM}}, datadogV1.SLOTYPE_MONITOR)
+			unsupported.SetId("def")
+			body = datadogV1.SLOListResponse{Data: []datadogV1.ServiceLevelObjective{*supported, *unsupported}}
+		case "/api/v1/dashboard":
+			body = datadogV1.DashboardSummary{Dashboards: []datadogV1.DashboardSummaryDefinition{
+				{Id: datadog.PtrString("abc-def-ghi"), Title: datadog.PtrString("Checkout")},
+				{Id: datadog.PtrString("jkl-mno-pqr"), Title: datadog.PtrString("Checkout (payments)")},
+				{Id: datadog.PtrString("stu-vwx-yza"), Title: datadog.PtrString("Cart")},
+			}}
+		case "/api/v1/dashboard/abc-def-ghi":
+			note := datadogV1.NoteWidgetDefinitionAsWidgetDefinition(datadogV1.NewNoteWidgetDefinitionWithDefaults())
+			note.NoteWidgetDefinition.SetContent("Checkout service")
+			dashboard := datadogV1.NewDashboard(datadogV1.DASHBOARDLAYOUTTYPE_ORDERED, "Checkout", []datadogV1.Widget{*datadogV1.NewWidget(note)})
+			dashboard.SetId("abc-def-ghi")
+			dashboard.SetTags([]string{"team:checkout"})
+			body = dashboard
+		case "/api/v1/dashboard/jkl-mno-pqr":
+			dashboard := datadogV1.NewDashboard(datadogV1.DASHBOARDLAYOUTTYPE_ORDERED, "Checkout (payments)", []datadogV1.Widget{})
+			dashboard.SetId("jkl-mno-pqr")
+			dashboard.SetTags([]string{"team:payments"})
+			body = dashboard
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func Test_validate(t *testing.T) {
+	o := newOptions(genericclioptions.IOStreams{Out: &bytes.Buffer{}})
+	assert.Error(t, o.validate())
+
+	o.apiKey = "api-key"
+	o.appKey = "app-key"
+	assert.NoError(t, o.validate())
+
+	o.ids = []string{"1234"}
+	o.name = "checkout"
+	assert.EqualError(t, o.validate(), "--id can't be used with --name or --tags")
+}
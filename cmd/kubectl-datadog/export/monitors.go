@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogmonitor"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const datadogMonitorKind = "DatadogMonitor"
+
+// runMonitors runs the export monitors command.
+func (o *options) runMonitors() error {
+	ddClient, err := datadogclient.InitDatadogMonitorClient(logr.Discard(), o.creds())
+	if err != nil {
+		return err
+	}
+
+	monitors, err := o.listMonitors(ddClient)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range monitors {
+		monitorID, spec := datadogmonitor.ExportMonitorSpec(m)
+		id := strconv.FormatInt(monitorID, 10)
+		if err = o.write(datadogMonitorKind, o.objectMeta(spec.Name, id, v1alpha1.DatadogMonitorAdoptIDAnnotationKey), &spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listMonitors returns the monitors selected by the command flags.
+func (o *options) listMonitors(ddClient datadogclient.DatadogMonitorClient) ([]datadogV1.Monitor, error) {
+	var monitors []datadogV1.Monitor
+	if len(o.ids) > 0 {
+		for _, id := range o.ids {
+			monitorID, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid monitor ID %s: %w", id, err)
+			}
+			m, _, err := ddClient.Client.GetMonitor(ddClient.Auth, monitorID)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get monitor %s: %w", id, err)
+			}
+			monitors = append(monitors, m)
+		}
+
+		return monitors, nil
+	}
+
+	params := datadogV1.NewListMonitorsOptionalParameters().WithPageSize(pageSize)
+	if o.name != "" {
+		params = params.WithName(o.name)
+	}
+	if len(o.tags) > 0 {
+		params = params.WithMonitorTags(strings.Join(o.tags, ","))
+	}
+	for page := int64(0); ; page++ {
+		list, _, err := ddClient.Client.ListMonitors(ddClient.Auth, *params.WithPage(page))
+		if err != nil {
+			return nil, fmt.Errorf("unable to list monitors: %w", err)
+		}
+		monitors = append(monitors, list...)
+		if len(list) < pageSize {
+			return monitors, nil
+		}
+	}
+}
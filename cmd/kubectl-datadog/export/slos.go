@@ -0,0 +1,72 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogslo"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const datadogSLOKind = "DatadogSLO"
+
+// runSLOs runs the export slos command.
+func (o *options) runSLOs() error {
+	ddClient, err := datadogclient.InitDatadogSLOClient(logr.Discard(), o.creds())
+	if err != nil {
+		return err
+	}
+
+	slos, err := o.listSLOs(ddClient)
+	if err != nil {
+		return err
+	}
+
+	for _, slo := range slos {
+		spec, err := datadogslo.ExportSLOSpec(slo)
+		if err != nil {
+			o.warn("SLO", slo.GetId(), err)
+			continue
+		}
+		if err = o.write(datadogSLOKind, o.objectMeta(slo.GetName(), slo.GetId(), v1alpha1.DatadogSLOAdoptIDAnnotationKey), &spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listSLOs returns the SLOs selected by the command flags.
+func (o *options) listSLOs(ddClient datadogclient.DatadogSLOClient) ([]datadogV1.ServiceLevelObjective, error) {
+	params := datadogV1.NewListSLOsOptionalParameters().WithLimit(pageSize)
+	if len(o.ids) > 0 {
+		params = params.WithIds(strings.Join(o.ids, ","))
+	}
+	if o.name != "" {
+		params = params.WithQuery(o.name)
+	}
+	if len(o.tags) > 0 {
+		params = params.WithTagsQuery(strings.Join(o.tags, ","))
+	}
+
+	var slos []datadogV1.ServiceLevelObjective
+	for offset := int64(0); ; offset += pageSize {
+		list, _, err := ddClient.Client.ListSLOs(ddClient.Auth, *params.WithOffset(offset))
+		if err != nil {
+			return nil, fmt.Errorf("unable to list SLOs: %w", err)
+		}
+		slos = append(slos, list.GetData()...)
+		if len(list.GetData()) < pageSize {
+			return slos, nil
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var getExample = `
+  # show the details of the DatadogMonitor named foo, with a link to the monitor in Datadog
+  %[1]s get foo --site datadoghq.eu
+`
+
+// options provides information required by Datadog monitor get command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args        []string
+	monitorName string
+	site        string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "get" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "get <DatadogMonitor name> [flags]",
+		Short:        "Show the details of a DatadogMonitor",
+		Example:      fmt.Sprintf(getExample, "kubectl datadog monitor"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.site, "site", "", "Datadog site of the links to Datadog, defaults to the DD_SITE environment variable, then to datadoghq.com")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.monitorName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogMonitor is required")
+	}
+
+	return nil
+}
+
+// run runs the get command.
+func (o *options) run() error {
+	dm, err := common.GetDatadogMonitor(context.TODO(), o.Client, o.UserNamespace, o.monitorName)
+	if err != nil {
+		return err
+	}
+
+	printMonitor(o.Out, dm, common.DatadogAppURL(o.site), time.Now())
+
+	return nil
+}
+
+// printMonitor prints the details of a DatadogMonitor.
+func printMonitor(out io.Writer, dm *v1alpha1.DatadogMonitor, appURL string, now time.Time) {
+	common.PrintField(out, "Name", dm.Namespace+"/"+dm.Name)
+	if dm.Status.ID != 0 {
+		common.PrintField(out, "ID", strconv.Itoa(dm.Status.ID))
+		common.PrintField(out, "URL", common.DatadogMonitorURL(appURL, dm))
+	}
+	common.PrintField(out, "Type", string(dm.Spec.Type))
+	common.PrintField(out, "Query", dm.Spec.Query)
+	common.PrintField(out, "State", string(dm.Status.MonitorState))
+	if dm.Status.MonitorStateLastTransitionTime != nil {
+		common.PrintField(out, "Last transition", duration.HumanDuration(now.Sub(dm.Status.MonitorStateLastTransitionTime.Time))+" ago")
+	}
+	common.PrintField(out, "Triggered groups", strconv.Itoa(len(dm.Status.TriggeredState)))
+	common.PrintField(out, "Muted", strconv.FormatBool(common.IsDatadogMonitorMuted(dm)))
+	if len(dm.Status.DowntimeStatus.RolloutWorkloads) > 0 {
+		common.PrintField(out, "Rolling out", fmt.Sprint(dm.Status.DowntimeStatus.RolloutWorkloads))
+	}
+	common.PrintField(out, "Sync status", string(dm.Status.MonitorStateSyncStatus))
+	if dm.Status.MonitorLastForceSyncTime != nil {
+		common.PrintField(out, "Last force sync", duration.HumanDuration(now.Sub(dm.Status.MonitorLastForceSyncTime.Time))+" ago")
+	}
+
+	if len(dm.Status.TriggeredState) > 0 {
+		fmt.Fprintln(out, "\nTriggered groups:")
+		table := common.NewTable(out, "GROUP", "STATE", "LAST TRANSITION")
+		for _, group := range dm.Status.TriggeredState {
+			table.Append([]string{group.MonitorGroup, string(group.State), duration.HumanDuration(now.Sub(group.LastTransitionTime.Time))})
+		}
+		table.Render()
+	}
+
+	if len(dm.Status.Conditions) > 0 {
+		fmt.Fprintln(out, "\nConditions:")
+		table := common.NewTable(out, "TYPE", "STATUS", "LAST TRANSITION", "MESSAGE")
+		for _, condition := range dm.Status.Conditions {
+			table.Append([]string{string(condition.Type), string(condition.Status), duration.HumanDuration(now.Sub(condition.LastTransitionTime.Time)), condition.Message})
+		}
+		table.Render()
+	}
+}
@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var groupsExample = `
+  # list the groups of the DatadogMonitor named foo, from the most to the least severe
+  %[1]s groups foo
+`
+
+// options provides information required by Datadog monitor groups command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args        []string
+	monitorName string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "groups" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "groups <DatadogMonitor name> [flags]",
+		Short:        "List the groups of a DatadogMonitor with their state",
+		Example:      fmt.Sprintf(groupsExample, "kubectl datadog monitor"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.monitorName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogMonitor is required")
+	}
+
+	return nil
+}
+
+// run runs the groups command.
+func (o *options) run() error {
+	key := client.ObjectKey{Namespace: o.UserNamespace, Name: o.monitorName}
+	if err := o.Client.Get(context.TODO(), key, &v1alpha1.DatadogMonitor{}); err != nil && apierrors.IsNotFound(err) {
+		return fmt.Errorf("DatadogMonitor %s/%s not found", o.UserNamespace, o.monitorName)
+	} else if err != nil {
+		return fmt.Errorf("unable to get DatadogMonitor: %w", err)
+	}
+
+	monitorGroups := &v1alpha1.DatadogMonitorGroups{}
+	if err := o.Client.Get(context.TODO(), key, monitorGroups); err != nil && apierrors.IsNotFound(err) {
+		fmt.Fprintf(o.ErrOut, "DatadogMonitor %s/%s has no groups\n", o.UserNamespace, o.monitorName)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to get DatadogMonitorGroups: %w", err)
+	}
+
+	printGroups(o.Out, monitorGroups.Groups, time.Now())
+	if monitorGroups.GroupCount > len(monitorGroups.Groups) {
+		fmt.Fprintf(o.ErrOut, "Showing %d of %d groups, the OK groups aren't stored\n", len(monitorGroups.Groups), monitorGroups.GroupCount)
+	}
+
+	return nil
+}
+
+// printGroups prints the groups from the most to the least severe.
+func printGroups(out io.Writer, groups []v1alpha1.DatadogMonitorGroup, now time.Time) {
+	groups = append([]v1alpha1.DatadogMonitorGroup(nil), groups...)
+	v1alpha1.SortDatadogMonitorGroupsBySeverity(groups)
+
+	table := newTable(out)
+	for _, group := range groups {
+		lastTransition := ""
+		if !group.LastTransitionTime.IsZero() {
+			lastTransition = duration.HumanDuration(now.Sub(group.LastTransitionTime.Time))
+		}
+		table.Append([]string{group.Name, string(group.State), lastTransition})
+	}
+	table.Render()
+}
+
+func newTable(out io.Writer) *tablewriter.Table {
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"GROUP", "STATE", "LAST TRANSITION"})
+	table.SetBorders(tablewriter.Border{Left: false, Top: false, Right: false, Bottom: false})
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(false)
+	table.SetAutoWrapText(false)
+	return table
+}
@@ -0,0 +1,49 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package groups
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_printGroups(t *testing.T) {
+	now := time.Unix(1612244495, 0)
+	groups := []v1alpha1.DatadogMonitorGroup{
+		{Name: "host:a", State: v1alpha1.DatadogMonitorStateOK, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+		{Name: "host:b", State: v1alpha1.DatadogMonitorStateNoData, LastTransitionTime: metav1.NewTime(now.Add(-2 * time.Minute))},
+		{Name: "host:c", State: v1alpha1.DatadogMonitorStateAlert, LastTransitionTime: metav1.NewTime(now.Add(-10 * time.Minute))},
+		{Name: "host:d", State: v1alpha1.DatadogMonitorStateWarn, LastTransitionTime: metav1.NewTime(now.Add(-5 * time.Minute))},
+		{Name: "host:e", State: v1alpha1.DatadogMonitorStateAlert, LastTransitionTime: metav1.NewTime(now.Add(-time.Minute))},
+		{Name: "host:f", State: v1alpha1.DatadogMonitorStateUnknown},
+	}
+
+	out := &bytes.Buffer{}
+	printGroups(out, groups, now)
+
+	var got [][]string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		got = append(got, strings.Fields(line))
+	}
+	want := [][]string{
+		{"GROUP", "STATE", "LAST", "TRANSITION"},
+		{"host:e", "Alert", "60s"},
+		{"host:c", "Alert", "10m"},
+		{"host:d", "Warn", "5m"},
+		{"host:b", "No", "Data", "2m"},
+		{"host:f", "Unknown"},
+		{"host:a", "OK", "60m"},
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, "host:a", groups[0].Name, "the groups must not be sorted in place")
+}
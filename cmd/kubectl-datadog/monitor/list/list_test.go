@@ -0,0 +1,48 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package list
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_printMonitors(t *testing.T) {
+	monitors := []v1alpha1.DatadogMonitor{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo"},
+			Status: v1alpha1.DatadogMonitorStatus{
+				ID:                     12345,
+				MonitorState:           v1alpha1.DatadogMonitorStateAlert,
+				MonitorStateSyncStatus: v1alpha1.MonitorStateSyncStatusOK,
+				DowntimeStatus:         v1alpha1.DatadogMonitorDowntimeStatus{IsDowntimed: true},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "new"},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	printMonitors(out, monitors, "https://app.datadoghq.eu")
+
+	var got [][]string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		got = append(got, strings.Fields(line))
+	}
+	want := [][]string{
+		{"NAMESPACE", "NAME", "ID", "STATE", "MUTED", "SYNC", "STATUS", "URL"},
+		{"bar", "foo", "12345", "Alert", "true", "OK", "https://app.datadoghq.eu/monitors/12345"},
+		{"bar", "new", "false"},
+	}
+	assert.Equal(t, want, got)
+}
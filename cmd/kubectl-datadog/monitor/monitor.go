@@ -0,0 +1,52 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package monitor
+
+import (
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor/get"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor/groups"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor/list"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor/mute"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor/resync"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor/unmute"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// options provides information required by monitor command
+type options struct {
+	genericclioptions.IOStreams
+	configFlags *genericclioptions.ConfigFlags
+}
+
+// newOptions provides an instance of options with default values
+func newOptions(streams genericclioptions.IOStreams) *options {
+	return &options{
+		configFlags: genericclioptions.NewConfigFlags(false),
+		IOStreams:   streams,
+	}
+}
+
+// New provides a cobra command wrapping options for "monitor" sub command
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "monitor [subcommand] [flags]",
+		Short: "Inspect and operate the DatadogMonitors",
+	}
+
+	cmd.AddCommand(list.New(streams))
+	cmd.AddCommand(get.New(streams))
+	cmd.AddCommand(mute.New(streams))
+	cmd.AddCommand(unmute.New(streams))
+	cmd.AddCommand(resync.New(streams))
+	cmd.AddCommand(groups.New(streams))
+
+	o := newOptions(streams)
+	o.configFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,170 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package mute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var muteExample = `
+  # mute the DatadogMonitor named foo until it's unmuted
+  %[1]s mute foo
+
+  # mute the prod groups of the DatadogMonitor named foo for an hour
+  %[1]s mute foo --duration 1h --scope env:prod
+`
+
+// options provides information required by Datadog monitor mute command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args        []string
+	monitorName string
+	duration    time.Duration
+	scope       string
+	message     string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "mute" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "mute <DatadogMonitor name> [flags]",
+		Short:        "Mute a DatadogMonitor with a DatadogDowntime",
+		Example:      fmt.Sprintf(muteExample, "kubectl datadog monitor"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().DurationVar(&o.duration, "duration", 0, "Duration of the downtime, it never ends when it isn't set")
+	cmd.Flags().StringVar(&o.scope, "scope", "", "Datadog scope query of the downtime, for example env:prod, all the scopes are muted when it isn't set")
+	cmd.Flags().StringVar(&o.message, "message", "", "Message of the downtime")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.monitorName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogMonitor is required")
+	}
+	if o.duration < 0 {
+		return errors.New("--duration must be positive")
+	}
+
+	return nil
+}
+
+// run runs the mute command. The DatadogDowntime is owned by the DatadogMonitor, so that it's deleted with it.
+func (o *options) run() error {
+	dm, err := common.GetDatadogMonitor(context.TODO(), o.Client, o.UserNamespace, o.monitorName)
+	if err != nil {
+		return err
+	}
+
+	downtime := &v1alpha1.DatadogDowntime{}
+	key := client.ObjectKey{Namespace: dm.Namespace, Name: DowntimeName(dm.Name)}
+	err = o.Client.Get(context.TODO(), key, downtime)
+	switch {
+	case apierrors.IsNotFound(err):
+		downtime = &v1alpha1.DatadogDowntime{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+	case err != nil:
+		return fmt.Errorf("unable to get DatadogDowntime: %w", err)
+	case !Mutes(downtime, dm):
+		return fmt.Errorf("DatadogDowntime %s/%s already exists and doesn't mute DatadogMonitor %s", key.Namespace, key.Name, dm.Name)
+	}
+
+	downtime.Spec = o.downtimeSpec(dm, time.Now())
+	if err = controllerutil.SetOwnerReference(dm, downtime, o.Client.Scheme()); err != nil {
+		return fmt.Errorf("unable to set the owner of DatadogDowntime: %w", err)
+	}
+
+	if downtime.ResourceVersion == "" {
+		err = o.Client.Create(context.TODO(), downtime)
+	} else {
+		err = o.Client.Update(context.TODO(), downtime)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to mute DatadogMonitor %s/%s: %w", dm.Namespace, dm.Name, err)
+	}
+
+	fmt.Fprintf(o.Out, "DatadogMonitor %s/%s muted by DatadogDowntime %s\n", dm.Namespace, dm.Name, downtime.Name)
+
+	return nil
+}
+
+// downtimeSpec returns the spec of the DatadogDowntime muting a DatadogMonitor.
+func (o *options) downtimeSpec(dm *v1alpha1.DatadogMonitor, now time.Time) v1alpha1.DatadogDowntimeSpec {
+	spec := v1alpha1.DatadogDowntimeSpec{
+		Scope:       o.scope,
+		MonitorRefs: []v1alpha1.DatadogDowntimeMonitorReference{{Name: dm.Name}},
+	}
+	if o.message != "" {
+		spec.Message = &o.message
+	}
+	if o.duration > 0 {
+		start := metav1.NewTime(now.Truncate(time.Second))
+		end := metav1.NewTime(start.Add(o.duration))
+		spec.Schedule = &v1alpha1.DatadogDowntimeSchedule{Start: &start, End: &end}
+	}
+
+	return spec
+}
+
+// DowntimeName returns the name of the DatadogDowntime muting a DatadogMonitor.
+func DowntimeName(monitorName string) string {
+	return monitorName + "-mute"
+}
+
+// Mutes returns whether a DatadogDowntime references a DatadogMonitor to mute.
+func Mutes(downtime *v1alpha1.DatadogDowntime, dm *v1alpha1.DatadogMonitor) bool {
+	return slices.ContainsFunc(downtime.Spec.MonitorRefs, func(ref v1alpha1.DatadogDowntimeMonitorReference) bool {
+		return ref.Name == dm.Name
+	})
+}
@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package mute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_run(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	now := time.Now().Truncate(time.Second)
+	dm := &v1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo", UID: "uid"}}
+	otherDowntime := &v1alpha1.DatadogDowntime{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo-mute"},
+		Spec:       v1alpha1.DatadogDowntimeSpec{Scope: "env:prod"},
+	}
+	muteDowntime := &v1alpha1.DatadogDowntime{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo-mute"},
+		Spec:       v1alpha1.DatadogDowntimeSpec{Scope: "env:staging", MonitorRefs: []v1alpha1.DatadogDowntimeMonitorReference{{Name: "foo"}}},
+	}
+
+	tests := []struct {
+		name     string
+		existing []client.Object
+		duration time.Duration
+		wantErr  string
+		wantEnd  *time.Time
+	}{
+		{
+			name:     "mute",
+			existing: []client.Object{dm},
+		},
+		{
+			name:     "mute for a duration",
+			existing: []client.Object{dm},
+			duration: time.Hour,
+			wantEnd:  func() *time.Time { end := now.Add(time.Hour); return &end }(),
+		},
+		{
+			name:     "already muted",
+			existing: []client.Object{dm, muteDowntime},
+		},
+		{
+			name:    "monitor not found",
+			wantErr: "DatadogMonitor bar/foo not found",
+		},
+		{
+			name:     "other downtime",
+			existing: []client.Object{dm, otherDowntime},
+			wantErr:  "DatadogDowntime bar/foo-mute already exists and doesn't mute DatadogMonitor foo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newOptions(genericclioptions.NewTestIOStreamsDiscard())
+			o.SetClient(fake.NewClientBuilder().WithScheme(s).WithObjects(tt.existing...).Build())
+			o.SetNamespace("bar")
+			o.monitorName = "foo"
+			o.duration = tt.duration
+			o.scope = "env:prod"
+
+			err := o.run()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			downtime := &v1alpha1.DatadogDowntime{}
+			require.NoError(t, o.Client.Get(context.TODO(), client.ObjectKey{Namespace: "bar", Name: "foo-mute"}, downtime))
+			assert.Equal(t, "env:prod", downtime.Spec.Scope)
+			assert.Equal(t, []v1alpha1.DatadogDowntimeMonitorReference{{Name: "foo"}}, downtime.Spec.MonitorRefs)
+			require.Len(t, downtime.OwnerReferences, 1)
+			assert.Equal(t, dm.UID, downtime.OwnerReferences[0].UID)
+			if tt.wantEnd == nil {
+				assert.Nil(t, downtime.Spec.Schedule)
+			} else {
+				require.NotNil(t, downtime.Spec.Schedule)
+				assert.WithinDuration(t, *tt.wantEnd, downtime.Spec.Schedule.End.Time, time.Minute)
+			}
+		})
+	}
+}
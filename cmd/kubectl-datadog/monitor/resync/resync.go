@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package resync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var resyncExample = `
+  # sync the DatadogMonitor named foo with Datadog now
+  %[1]s resync foo
+`
+
+// options provides information required by Datadog monitor resync command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args        []string
+	monitorName string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "resync" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "resync <DatadogMonitor name> [flags]",
+		Short:        "Request an immediate sync of a DatadogMonitor with Datadog",
+		Example:      fmt.Sprintf(resyncExample, "kubectl datadog monitor"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.monitorName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogMonitor is required")
+	}
+
+	return nil
+}
+
+// run runs the resync command. The controller syncs the DatadogMonitor with Datadog on its next reconcile.
+func (o *options) run() error {
+	dm, err := common.GetDatadogMonitor(context.TODO(), o.Client, o.UserNamespace, o.monitorName)
+	if err != nil {
+		return err
+	}
+
+	if err = common.RequestForceSync(context.TODO(), o.Client, dm, time.Now()); err != nil {
+		return fmt.Errorf("unable to resync DatadogMonitor %s/%s: %w", dm.Namespace, dm.Name, err)
+	}
+
+	fmt.Fprintf(o.Out, "Sync of DatadogMonitor %s/%s with Datadog requested\n", dm.Namespace, dm.Name)
+
+	return nil
+}
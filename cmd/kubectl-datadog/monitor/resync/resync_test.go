@@ -0,0 +1,45 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package resync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_run(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	dm := &v1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "bar",
+		Name:        "foo",
+		Annotations: map[string]string{"foo": "bar", v1alpha1.ForceSyncAnnotationKey: "2021-02-02T05:41:35Z"},
+	}}
+
+	o := newOptions(genericclioptions.NewTestIOStreamsDiscard())
+	o.SetClient(fake.NewClientBuilder().WithScheme(s).WithObjects(dm).Build())
+	o.SetNamespace("bar")
+	o.monitorName = "foo"
+	require.NoError(t, o.run())
+
+	got := &v1alpha1.DatadogMonitor{}
+	require.NoError(t, o.Client.Get(context.TODO(), client.ObjectKeyFromObject(dm), got))
+	assert.Equal(t, "bar", got.Annotations["foo"])
+	requestTime, err := time.Parse(time.RFC3339, got.Annotations[v1alpha1.ForceSyncAnnotationKey])
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), requestTime, time.Minute)
+}
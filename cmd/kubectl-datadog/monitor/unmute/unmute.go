@@ -0,0 +1,114 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package unmute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor/mute"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var unmuteExample = `
+  # unmute the DatadogMonitor named foo muted by the mute command
+  %[1]s unmute foo
+`
+
+// options provides information required by Datadog monitor unmute command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args        []string
+	monitorName string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "unmute" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "unmute <DatadogMonitor name> [flags]",
+		Short:        "Delete the DatadogDowntime created by mute",
+		Example:      fmt.Sprintf(unmuteExample, "kubectl datadog monitor"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.monitorName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogMonitor is required")
+	}
+
+	return nil
+}
+
+// run runs the unmute command.
+func (o *options) run() error {
+	dm, err := common.GetDatadogMonitor(context.TODO(), o.Client, o.UserNamespace, o.monitorName)
+	if err != nil {
+		return err
+	}
+
+	downtime := &v1alpha1.DatadogDowntime{}
+	key := client.ObjectKey{Namespace: dm.Namespace, Name: mute.DowntimeName(dm.Name)}
+	if err = o.Client.Get(context.TODO(), key, downtime); err != nil && apierrors.IsNotFound(err) {
+		return fmt.Errorf("DatadogMonitor %s/%s isn't muted by the mute command", dm.Namespace, dm.Name)
+	} else if err != nil {
+		return fmt.Errorf("unable to get DatadogDowntime: %w", err)
+	}
+	if !mute.Mutes(downtime, dm) {
+		return fmt.Errorf("DatadogDowntime %s/%s doesn't mute DatadogMonitor %s", key.Namespace, key.Name, dm.Name)
+	}
+
+	if err = o.Client.Delete(context.TODO(), downtime); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to unmute DatadogMonitor %s/%s: %w", dm.Namespace, dm.Name, err)
+	}
+
+	fmt.Fprintf(o.Out, "DatadogMonitor %s/%s unmuted\n", dm.Namespace, dm.Name)
+
+	return nil
+}
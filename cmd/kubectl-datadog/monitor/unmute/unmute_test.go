@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package unmute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_run(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	dm := &v1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo"}}
+	muteDowntime := &v1alpha1.DatadogDowntime{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo-mute"},
+		Spec:       v1alpha1.DatadogDowntimeSpec{MonitorRefs: []v1alpha1.DatadogDowntimeMonitorReference{{Name: "foo"}}},
+	}
+	otherDowntime := &v1alpha1.DatadogDowntime{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo-mute"},
+		Spec:       v1alpha1.DatadogDowntimeSpec{Scope: "env:prod"},
+	}
+
+	tests := []struct {
+		name     string
+		existing []client.Object
+		wantErr  string
+	}{
+		{
+			name:     "unmute",
+			existing: []client.Object{dm, muteDowntime},
+		},
+		{
+			name:     "not muted",
+			existing: []client.Object{dm},
+			wantErr:  "DatadogMonitor bar/foo isn't muted by the mute command",
+		},
+		{
+			name:     "other downtime",
+			existing: []client.Object{dm, otherDowntime},
+			wantErr:  "DatadogDowntime bar/foo-mute doesn't mute DatadogMonitor foo",
+		},
+		{
+			name:    "monitor not found",
+			wantErr: "DatadogMonitor bar/foo not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newOptions(genericclioptions.NewTestIOStreamsDiscard())
+			o.SetClient(fake.NewClientBuilder().WithScheme(s).WithObjects(tt.existing...).Build())
+			o.SetNamespace("bar")
+			o.monitorName = "foo"
+
+			err := o.run()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			err = o.Client.Get(context.TODO(), client.ObjectKey{Namespace: "bar", Name: "foo-mute"}, &v1alpha1.DatadogDowntime{})
+			assert.True(t, apierrors.IsNotFound(err))
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var getExample = `
+  # show the details of the DatadogSLO named foo, with a link to the SLO in Datadog
+  %[1]s get foo --site datadoghq.eu
+`
+
+// options provides information required by Datadog SLO get command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args    []string
+	sloName string
+	site    string
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "get" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "get <DatadogSLO name> [flags]",
+		Short:        "Show the details of a DatadogSLO",
+		Example:      fmt.Sprintf(getExample, "kubectl datadog slo"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.site, "site", "", "Datadog site of the links to Datadog, defaults to the DD_SITE environment variable, then to datadoghq.com")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.sloName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogSLO is required")
+	}
+
+	return nil
+}
+
+// run runs the get command.
+func (o *options) run() error {
+	slo, err := common.GetDatadogSLO(context.TODO(), o.Client, o.UserNamespace, o.sloName)
+	if err != nil {
+		return err
+	}
+
+	printSLO(o.Out, slo, common.DatadogAppURL(o.site), time.Now())
+
+	return nil
+}
+
+// printSLO prints the details of a DatadogSLO.
+func printSLO(out io.Writer, slo *v1alpha1.DatadogSLO, appURL string, now time.Time) {
+	common.PrintField(out, "Name", slo.Namespace+"/"+slo.Name)
+	if slo.Status.ID != "" {
+		common.PrintField(out, "ID", slo.Status.ID)
+		common.PrintField(out, "URL", common.DatadogSLOURL(appURL, slo))
+	}
+	common.PrintField(out, "Type", string(slo.Spec.Type))
+	common.PrintField(out, "Target", fmt.Sprintf("%s%% over %s", strconv.FormatFloat(slo.Spec.TargetThreshold.AsApproximateFloat64(), 'f', -1, 64), slo.Spec.Timeframe))
+	common.PrintField(out, "State", string(slo.Status.State))
+	common.PrintField(out, "SLI", apiutils.StringValue(slo.Status.SLIValue))
+	common.PrintField(out, "Error budget", apiutils.StringValue(slo.Status.ErrorBudgetRemaining))
+	if slo.Status.StateLastUpdateTime != nil {
+		common.PrintField(out, "Last update", duration.HumanDuration(now.Sub(slo.Status.StateLastUpdateTime.Time))+" ago")
+	}
+	common.PrintField(out, "Sync status", string(slo.Status.SyncStatus))
+	if slo.Status.LastForceSyncTime != nil {
+		common.PrintField(out, "Last force sync", duration.HumanDuration(now.Sub(slo.Status.LastForceSyncTime.Time))+" ago")
+	}
+
+	if len(slo.Status.Conditions) > 0 {
+		fmt.Fprintln(out, "\nConditions:")
+		table := common.NewTable(out, "TYPE", "STATUS", "LAST TRANSITION", "MESSAGE")
+		for _, condition := range slo.Status.Conditions {
+			table.Append([]string{condition.Type, string(condition.Status), duration.HumanDuration(now.Sub(condition.LastTransitionTime.Time)), condition.Message})
+		}
+		table.Render()
+	}
+}
@@ -0,0 +1,124 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var listExample = `
+  # list the DatadogSLOs of the current namespace with their state
+  %[1]s list
+
+  # list the DatadogSLOs of all the namespaces, with links to the SLOs in Datadog EU
+  %[1]s list -A --site datadoghq.eu
+`
+
+// options provides information required by Datadog SLO list command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args          []string
+	site          string
+	allNamespaces bool
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "list" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "list [flags]",
+		Short:        "List the DatadogSLOs with their sync status, Datadog ID and state",
+		Example:      fmt.Sprintf(listExample, "kubectl datadog slo"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&o.allNamespaces, "all-namespaces", "A", false, "List the DatadogSLOs of all the namespaces")
+	cmd.Flags().StringVar(&o.site, "site", "", "Datadog site of the links to Datadog, defaults to the DD_SITE environment variable, then to datadoghq.com")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) > 0 {
+		return errors.New("no arguments are allowed")
+	}
+
+	return nil
+}
+
+// run runs the list command.
+func (o *options) run() error {
+	namespace := o.UserNamespace
+	if o.allNamespaces {
+		namespace = ""
+	}
+	slos := &v1alpha1.DatadogSLOList{}
+	if err := o.Client.List(context.TODO(), slos, &client.ListOptions{Namespace: namespace}); err != nil {
+		return fmt.Errorf("unable to list DatadogSLO: %w", err)
+	}
+
+	printSLOs(o.Out, slos.Items, common.DatadogAppURL(o.site))
+
+	return nil
+}
+
+// printSLOs prints a table of DatadogSLOs.
+func printSLOs(out io.Writer, slos []v1alpha1.DatadogSLO, appURL string) {
+	table := common.NewTable(out, "NAMESPACE", "NAME", "ID", "STATE", "SLI", "ERROR BUDGET", "SYNC STATUS", "URL")
+	for i := range slos {
+		slo := &slos[i]
+		table.Append([]string{
+			slo.Namespace,
+			slo.Name,
+			slo.Status.ID,
+			string(slo.Status.State),
+			apiutils.StringValue(slo.Status.SLIValue),
+			apiutils.StringValue(slo.Status.ErrorBudgetRemaining),
+			string(slo.Status.SyncStatus),
+			common.DatadogSLOURL(appURL, slo),
+		})
+	}
+	table.Render()
+}
@@ -0,0 +1,51 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package list
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_printSLOs(t *testing.T) {
+	sliValue := "99.95"
+	errorBudget := "50"
+	slos := []v1alpha1.DatadogSLO{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo"},
+			Status: v1alpha1.DatadogSLOStatus{
+				ID:                   "abc123",
+				State:                v1alpha1.DatadogSLOStateOK,
+				SLIValue:             &sliValue,
+				ErrorBudgetRemaining: &errorBudget,
+				SyncStatus:           v1alpha1.DatadogSLOSyncStatusOK,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "new"},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	printSLOs(out, slos, "https://us3.datadoghq.com")
+
+	var got [][]string
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		got = append(got, strings.Fields(line))
+	}
+	want := [][]string{
+		{"NAMESPACE", "NAME", "ID", "STATE", "SLI", "ERROR", "BUDGET", "SYNC", "STATUS", "URL"},
+		{"bar", "foo", "abc123", "OK", "99.95", "50", "OK", "https://us3.datadoghq.com/slo?slo_id=abc123"},
+		{"bar", "new"},
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,110 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package open
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DataDog/datadog-operator/pkg/plugin/common"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+var openExample = `
+  # open the DatadogSLO named foo in the browser
+  %[1]s open foo --site datadoghq.eu
+
+  # only print the link to the SLO of the DatadogSLO named foo
+  %[1]s open foo --print
+`
+
+// options provides information required by Datadog SLO open command.
+type options struct {
+	genericclioptions.IOStreams
+	common.Options
+	args      []string
+	sloName   string
+	site      string
+	printOnly bool
+}
+
+// newOptions provides an instance of options with default values.
+func newOptions(streams genericclioptions.IOStreams) *options {
+	o := &options{
+		IOStreams: streams,
+	}
+	o.SetConfigFlags()
+	return o
+}
+
+// New provides a cobra command wrapping options for "open" sub command.
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "open <DatadogSLO name> [flags]",
+		Short:        "Open the SLO of a DatadogSLO in the browser",
+		Example:      fmt.Sprintf(openExample, "kubectl datadog slo"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.printOnly, "print", false, "Only print the link to the SLO")
+	cmd.Flags().StringVar(&o.site, "site", "", "Datadog site of the links to Datadog, defaults to the DD_SITE environment variable, then to datadoghq.com")
+	o.ConfigFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command.
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.sloName = args[0]
+	}
+
+	return o.Init(cmd)
+}
+
+// validate ensures that all required arguments and flag values are provided.
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the name of a DatadogSLO is required")
+	}
+
+	return nil
+}
+
+// run runs the open command.
+func (o *options) run() error {
+	slo, err := common.GetDatadogSLO(context.TODO(), o.Client, o.UserNamespace, o.sloName)
+	if err != nil {
+		return err
+	}
+
+	url := common.DatadogSLOURL(common.DatadogAppURL(o.site), slo)
+	if url == "" {
+		return fmt.Errorf("DatadogSLO %s/%s isn't created in Datadog yet", slo.Namespace, slo.Name)
+	}
+
+	fmt.Fprintln(o.Out, url)
+	if o.printOnly {
+		return nil
+	}
+
+	return common.OpenBrowser(url)
+}
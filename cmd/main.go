@@ -6,6 +6,8 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
@@ -34,6 +36,7 @@ import (
 	"github.com/go-logr/logr"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	"github.com/DataDog/datadog-operator/api/datadoghq/common"
 	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
 	"github.com/DataDog/datadog-operator/internal/controller"
@@ -79,6 +82,26 @@ func (ss *stringSlice) Set(value string) error {
 	return nil
 }
 
+// stringMap implements flag.Value, parsing a JSON object of strings
+type stringMap map[string]string
+
+func (sm *stringMap) String() string {
+	if *sm == nil {
+		return ""
+	}
+	out, _ := json.Marshal(*sm)
+	return string(out)
+}
+
+func (sm *stringMap) Set(value string) error {
+	m := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &m); err != nil {
+		return fmt.Errorf("invalid JSON object of strings: %w", err)
+	}
+	*sm = m
+	return nil
+}
+
 const (
 	// ExtendedDaemonset default configuration values from https://github.com/DataDog/extendeddaemonset/blob/main/api/v1alpha1/extendeddaemonset_default.go
 	defaultCanaryAutoPauseEnabled = true
@@ -117,6 +140,8 @@ type options struct {
 	datadogAgentEnabled                    bool
 	datadogMonitorEnabled                  bool
 	datadogSLOEnabled                      bool
+	datadogSLOCorrectionEnabled            bool
+	datadogSyntheticTestEnabled            bool
 	operatorMetricsEnabled                 bool
 	maximumGoroutines                      int
 	introspectionEnabled                   bool
@@ -125,6 +150,12 @@ type options struct {
 	processChecksInCoreAgentEnabled        bool
 	otelAgentEnabled                       bool
 	datadogDashboardEnabled                bool
+	datadogDowntimeEnabled                 bool
+	datadogMonitorTemplateEnabled          bool
+	clusterName                            string
+	datadogDeletionPolicy                  string
+	namespaceLabelsAsTags                  stringMap
+	namespaceAnnotationsAsTags             stringMap
 
 	// Secret Backend options
 	secretBackendCommand string
@@ -152,6 +183,8 @@ func (opts *options) Parse() {
 	flag.BoolVar(&opts.datadogAgentEnabled, "datadogAgentEnabled", true, "Enable the DatadogAgent controller")
 	flag.BoolVar(&opts.datadogMonitorEnabled, "datadogMonitorEnabled", false, "Enable the DatadogMonitor controller")
 	flag.BoolVar(&opts.datadogSLOEnabled, "datadogSLOEnabled", false, "Enable the DatadogSLO controller")
+	flag.BoolVar(&opts.datadogSLOCorrectionEnabled, "datadogSLOCorrectionEnabled", false, "Enable the DatadogSLOCorrection controller")
+	flag.BoolVar(&opts.datadogSyntheticTestEnabled, "datadogSyntheticTestEnabled", false, "Enable the DatadogSyntheticTest controller")
 	flag.BoolVar(&opts.operatorMetricsEnabled, "operatorMetricsEnabled", true, "Enable sending operator metrics to Datadog")
 	flag.IntVar(&opts.maximumGoroutines, "maximumGoroutines", defaultMaximumGoroutines, "Override health check threshold for maximum number of goroutines.")
 	flag.BoolVar(&opts.introspectionEnabled, "introspectionEnabled", false, "Enable introspection (beta)")
@@ -160,6 +193,12 @@ func (opts *options) Parse() {
 	flag.BoolVar(&opts.processChecksInCoreAgentEnabled, "processChecksInCoreAgentEnabled", false, "Enable running process checks in the core agent (beta)")
 	flag.BoolVar(&opts.otelAgentEnabled, "otelAgentEnabled", false, "Enable the OTel agent container (beta)")
 	flag.BoolVar(&opts.datadogDashboardEnabled, "datadogDashboardEnabled", false, "Enable the DatadogDashboard controller")
+	flag.BoolVar(&opts.datadogDowntimeEnabled, "datadogDowntimeEnabled", false, "Enable the DatadogDowntime controller")
+	flag.BoolVar(&opts.datadogMonitorTemplateEnabled, "datadogMonitorTemplateEnabled", false, "Enable the DatadogMonitorTemplate controller")
+	flag.StringVar(&opts.clusterName, "clusterName", os.Getenv(common.DDClusterName), "Name of the Kubernetes cluster, available in the dashboards loaded from ConfigMaps")
+	flag.StringVar(&opts.datadogDeletionPolicy, "datadogDeletionPolicy", string(datadoghqv1alpha1.DeletionPolicyDelete), "Default deletion policy of the Datadog API resources: Delete or Orphan")
+	flag.Var(&opts.namespaceLabelsAsTags, "namespaceLabelsAsTags", "JSON mapping of namespace labels to the tag keys added to the monitors, SLOs, synthetic tests and dashboards of the namespace")
+	flag.Var(&opts.namespaceAnnotationsAsTags, "namespaceAnnotationsAsTags", "JSON mapping of namespace annotations to the tag keys added to the monitors, SLOs, synthetic tests and dashboards of the namespace")
 
 	// ExtendedDaemonset configuration
 	flag.BoolVar(&opts.supportExtendedDaemonset, "supportExtendedDaemonset", false, "Support usage of Datadog ExtendedDaemonset CRD.")
@@ -203,6 +242,11 @@ func run(opts *options) error {
 	}
 	version.PrintVersionLogs(setupLog)
 
+	deletionPolicy := datadoghqv1alpha1.DeletionPolicy(opts.datadogDeletionPolicy)
+	if deletionPolicy != datadoghqv1alpha1.DeletionPolicyDelete && deletionPolicy != datadoghqv1alpha1.DeletionPolicyOrphan {
+		return setupErrorf(setupLog, fmt.Errorf("unknown deletion policy: %s", deletionPolicy), "Invalid datadogDeletionPolicy flag")
+	}
+
 	if opts.profilingEnabled {
 		setupLog.Info("Starting datadog profiler")
 		if err := profiler.Start(
@@ -243,11 +287,15 @@ func run(opts *options) error {
 		RenewDeadline:              &renewDeadline,
 		RetryPeriod:                &retryPeriod,
 		Cache: config.CacheOptions(setupLog, config.WatchOptions{
-			DatadogAgentEnabled:        opts.datadogAgentEnabled,
-			DatadogMonitorEnabled:      opts.datadogMonitorEnabled,
-			DatadogSLOEnabled:          opts.datadogSLOEnabled,
-			DatadogAgentProfileEnabled: opts.datadogAgentProfileEnabled,
-			IntrospectionEnabled:       opts.introspectionEnabled,
+			DatadogAgentEnabled:           opts.datadogAgentEnabled,
+			DatadogMonitorEnabled:         opts.datadogMonitorEnabled,
+			DatadogSLOEnabled:             opts.datadogSLOEnabled,
+			DatadogSLOCorrectionEnabled:   opts.datadogSLOCorrectionEnabled,
+			DatadogSyntheticTestEnabled:   opts.datadogSyntheticTestEnabled,
+			DatadogDowntimeEnabled:        opts.datadogDowntimeEnabled,
+			DatadogMonitorTemplateEnabled: opts.datadogMonitorTemplateEnabled,
+			DatadogAgentProfileEnabled:    opts.datadogAgentProfileEnabled,
+			IntrospectionEnabled:          opts.introspectionEnabled,
 		}),
 	})
 	if err != nil {
@@ -259,7 +307,8 @@ func run(opts *options) error {
 	customSetupHealthChecks(setupLog, mgr, &opts.maximumGoroutines)
 
 	creds, err := config.NewCredentialManager().GetCredentials()
-	if err != nil && opts.datadogMonitorEnabled {
+	// The DatadogMonitors can reference their own credentials when the operator has none
+	if err != nil && !errors.Is(err, config.ErrEmptyCreds) && opts.datadogMonitorEnabled {
 		return setupErrorf(setupLog, err, "Unable to get credentials for DatadogMonitor")
 	}
 
@@ -295,6 +344,8 @@ func run(opts *options) error {
 		DatadogAgentEnabled:             opts.datadogAgentEnabled,
 		DatadogMonitorEnabled:           opts.datadogMonitorEnabled,
 		DatadogSLOEnabled:               opts.datadogSLOEnabled,
+		DatadogSLOCorrectionEnabled:     opts.datadogSLOCorrectionEnabled,
+		DatadogSyntheticTestEnabled:     opts.datadogSyntheticTestEnabled,
 		OperatorMetricsEnabled:          opts.operatorMetricsEnabled,
 		V2APIEnabled:                    true,
 		IntrospectionEnabled:            opts.introspectionEnabled,
@@ -302,6 +353,12 @@ func run(opts *options) error {
 		ProcessChecksInCoreAgentEnabled: opts.processChecksInCoreAgentEnabled,
 		OtelAgentEnabled:                opts.otelAgentEnabled,
 		DatadogDashboardEnabled:         opts.datadogDashboardEnabled,
+		DatadogDowntimeEnabled:          opts.datadogDowntimeEnabled,
+		DatadogMonitorTemplateEnabled:   opts.datadogMonitorTemplateEnabled,
+		ClusterName:                     opts.clusterName,
+		DatadogDeletionPolicy:           deletionPolicy,
+		NamespaceLabelsAsTags:           opts.namespaceLabelsAsTags,
+		NamespaceAnnotationsAsTags:      opts.namespaceAnnotationsAsTags,
 	}
 
 	if err = controller.SetupControllers(setupLog, mgr, options); err != nil {
@@ -44,6 +44,7 @@ require (
 	github.com/DataDog/datadog-agent/pkg/remoteconfig/state v0.55.0-rc.10
 	github.com/prometheus/client_golang v1.16.0
 	golang.org/x/exp v0.0.0-20240222234643-814bf88cf225
+	golang.org/x/time v0.3.0
 )
 
 require (
@@ -156,7 +157,6 @@ require (
 	golang.org/x/sys v0.19.0 // indirect
 	golang.org/x/term v0.19.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
-	golang.org/x/time v0.3.0 // indirect
 	golang.org/x/tools v0.18.0 // indirect
 	gomodules.xyz/jsonpatch/v2 v2.4.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
@@ -0,0 +1,145 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package apiresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// Adapter adapts a custom resource backed by a Datadog API resource to the Reconciler. T is the custom resource type,
+// and R the type of the Datadog resource returned by the API.
+//
+// An adapter can implement the optional Credentialer, Resolver, Replacer, Tagger, TagRestricter, Orphaner, Adopter,
+// Drifter, Observer, Refresher and Ender interfaces.
+type Adapter[T client.Object, R any] interface {
+	// Kind returns the kind of the custom resource, used in logs, events and metrics.
+	Kind() string
+	// Finalizer returns the name of the finalizer deleting the Datadog resource.
+	Finalizer() string
+	// NewObject returns an empty custom resource.
+	NewObject() T
+	// Status returns the status of the custom resource.
+	Status(obj T) Status
+	// Validate returns an error if the spec of the custom resource is invalid.
+	Validate(obj T) error
+	// HashedSpec returns the spec that is synced with Datadog. The Datadog resource is updated when its hash changes.
+	HashedSpec(obj T) interface{}
+	// DeletionPolicy returns the deletion policy of the custom resource, empty for the default policy.
+	DeletionPolicy(obj T) v1alpha1.DeletionPolicy
+
+	// Create creates the Datadog resource.
+	Create(ctx context.Context, obj T) (R, error)
+	// Get gets the Datadog resource with the given ID.
+	Get(ctx context.Context, id string) (R, error)
+	// Update updates the Datadog resource with the ID set in the status of the custom resource.
+	Update(ctx context.Context, obj T) (R, error)
+	// Delete deletes the Datadog resource with the ID set in the status of the custom resource.
+	Delete(ctx context.Context, obj T) error
+	// Info returns the information of a Datadog resource that is set in the status of the custom resource.
+	Info(remote R) Info
+}
+
+// Info is the information of a Datadog resource that is set in the status of the custom resource.
+type Info struct {
+	// ID is the ID of the Datadog resource.
+	ID string
+	// Creator is the handle of the creator of the Datadog resource, if any.
+	Creator string
+	// Created is the creation time of the Datadog resource, if known.
+	Created time.Time
+	// Modified is the last modification time of the Datadog resource, if known.
+	Modified time.Time
+}
+
+// Credentialer is implemented by the adapters of the custom resources that can be managed with the Datadog credentials
+// referenced by the custom resource or its namespace, instead of those of the operator. The context passed to the
+// adapter then carries their authentication context, see datadogclient.AuthFromContext.
+type Credentialer[T client.Object] interface {
+	// CredentialsRef returns the reference to the Secret with the Datadog credentials of the custom resource, if any.
+	CredentialsRef(obj T) *v1alpha1.DatadogCredentialsReference
+}
+
+// Resolver is implemented by the adapters of the custom resources referencing other custom resources.
+type Resolver[T client.Object] interface {
+	// Resolve returns the custom resource to sync with Datadog, once its references are resolved. The resolved
+	// values must be part of the hashed spec, so that the Datadog resource is updated when they change.
+	Resolve(ctx context.Context, obj T) (T, error)
+	// SetResolved sets the resolved references of desired, once synced with Datadog, in the status of obj.
+	SetResolved(obj, desired T)
+}
+
+// Replacer is implemented by the adapters of the Datadog resources that can't be updated to some specs, and must be
+// created again instead.
+type Replacer[T client.Object] interface {
+	// Replace returns true if the Datadog resource synced with obj must be created again to match desired.
+	Replace(obj, desired T) bool
+}
+
+// Tagger is implemented by the adapters of the Datadog resources that must have the required tags, and the tags mapped
+// from the labels and annotations of their namespace.
+type Tagger[T client.Object] interface {
+	// Tags returns the tags of the custom resource, or nil if the required tags are disabled.
+	Tags(obj T) *[]string
+}
+
+// TagRestricter is implemented by the adapters of the Tagger resources that only accept some tag keys. The other
+// required and namespace tags aren't added.
+type TagRestricter interface {
+	// AllowedTagKeys returns the tag keys accepted by the Datadog resource.
+	AllowedTagKeys() []string
+}
+
+// Orphaner is implemented by the adapters of the Datadog resources that are tagged as no longer managed by the
+// operator, when their custom resource is deleted with the Orphan deletion policy. The others are left as they are.
+type Orphaner[T client.Object] interface {
+	// Orphan tags the Datadog resource with the ID set in the status of the custom resource as no longer managed by
+	// the operator, see utils.AddOrphanedTag.
+	Orphan(ctx context.Context, obj T) error
+}
+
+// Adopter is implemented by the adapters of the custom resources that can adopt an existing Datadog resource.
+type Adopter[T client.Object] interface {
+	// AdoptID returns the ID of the Datadog resource to adopt, if the custom resource hasn't adopted it yet.
+	AdoptID(obj T) string
+}
+
+// Drifter is implemented by the adapters of the Datadog resources whose changes made outside of Kubernetes are
+// detected.
+type Drifter[T client.Object, R any] interface {
+	// DriftPolicy returns the drift policy of the custom resource.
+	DriftPolicy(obj T) v1alpha1.DriftPolicy
+	// Drift returns the JSON paths of the spec fields that differ in the Datadog resource.
+	Drift(obj T, remote R) ([]string, error)
+}
+
+// Observer is implemented by the adapters setting the state of the Datadog resource in the status of the custom
+// resource, every time it's created, updated or read.
+type Observer[T client.Object, R any] interface {
+	// Observe sets the state of the Datadog resource in the status of the custom resource.
+	Observe(obj T, remote R, now metav1.Time)
+}
+
+// Refresher is implemented by the adapters setting information that requires additional API calls in the status of
+// the custom resource, like the results of a synthetic test.
+type Refresher[T client.Object] interface {
+	// Refresh refreshes the status of the custom resource. It's called on every sync, and is responsible for
+	// limiting the rate of its API calls.
+	Refresh(ctx context.Context, logger logr.Logger, obj T, now metav1.Time)
+}
+
+// Ender is implemented by the adapters of the Datadog resources that end, like downtimes.
+type Ender[R any] interface {
+	// Ended returns true if the Datadog resource has ended and can't be updated anymore, and whether it must be
+	// created again.
+	Ended(remote R) (ended bool, recreate bool)
+}
@@ -0,0 +1,75 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package apiresource
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/config"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+// credentialsSiteKey is the key of the Datadog site in the Secret with the Datadog credentials of a custom resource.
+const credentialsSiteKey = "site"
+
+// authenticate returns a copy of ctx carrying the authentication context of the Datadog credentials referenced by the
+// custom resource or its namespace, if any. ctx is returned as is otherwise, so that the adapter uses the credentials
+// of the operator. The Secret and the namespace are read from the API server rather than the cache, so that the
+// operator doesn't watch all the Secrets of the cluster.
+func (r *Reconciler[T, R]) authenticate(ctx context.Context, instance T) (context.Context, error) {
+	credentialer, ok := r.adapter.(Credentialer[T])
+	if !ok {
+		return ctx, nil
+	}
+
+	secretName, err := r.credentialsSecretName(ctx, credentialer, instance)
+	if err != nil || secretName == "" {
+		return ctx, err
+	}
+
+	secret := &corev1.Secret{}
+	if err = r.apiReader.Get(ctx, types.NamespacedName{Namespace: instance.GetNamespace(), Name: secretName}, secret); err != nil {
+		return ctx, fmt.Errorf("error getting Datadog credentials Secret %s: %w", secretName, err)
+	}
+
+	auth, err := r.auths.Get(datadogclient.Credentials{
+		Creds: config.Creds{
+			APIKey: string(secret.Data[apicommon.DefaultAPIKeyKey]),
+			AppKey: string(secret.Data[apicommon.DefaultAPPKeyKey]),
+		},
+		Site: string(secret.Data[credentialsSiteKey]),
+	})
+	if err != nil {
+		return ctx, fmt.Errorf("invalid Datadog credentials in Secret %s: %w", secretName, err)
+	}
+
+	return datadogclient.WithAuth(ctx, auth), nil
+}
+
+// credentialsSecretName returns the name of the Secret with the Datadog credentials of the custom resource: the one
+// it references, or else the one referenced by its namespace, if any.
+func (r *Reconciler[T, R]) credentialsSecretName(ctx context.Context, credentialer Credentialer[T], instance T) (string, error) {
+	if ref := credentialer.CredentialsRef(instance); ref != nil {
+		return ref.SecretName, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.apiReader.Get(ctx, types.NamespacedName{Name: instance.GetNamespace()}, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error getting namespace %s: %w", instance.GetNamespace(), err)
+	}
+
+	return namespace.Annotations[v1alpha1.DatadogCredentialsSecretAnnotationKey], nil
+}
@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package apiresource
+
+import (
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/metrics"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
+)
+
+// detectDrift compares the Datadog resource with the resolved spec, and returns the fields that were changed outside
+// of Kubernetes. The comparison is skipped if the Datadog resource wasn't modified since the last sync. With the
+// observe drift policy, the drift is reported in the status and in an event; with the enforce policy, it's reported
+// once reverted.
+func (r *Reconciler[T, R]) detectDrift(logger logr.Logger, instance, desired T, remote R, status Status, now metav1.Time) []string {
+	drifter, ok := r.adapter.(Drifter[T, R])
+	if !ok || !comparison.ModifiedSince(r.adapter.Info(remote).Modified, status.GetLastForceSyncTime()) {
+		return nil
+	}
+
+	drift, err := drifter.Drift(desired, remote)
+	if err != nil {
+		logger.Error(err, "error comparing the Datadog resource with the "+r.adapter.Kind()+" spec", "ID", status.GetID())
+		return nil
+	}
+	if len(drift) == 0 {
+		r.clearDrift(instance, status, now)
+		return nil
+	}
+
+	logger.Info(r.noun()+" differs from the "+r.adapter.Kind()+" spec in Datadog", "ID", status.GetID(), "fields", drift)
+	if r.isObservingDrift(instance) {
+		r.reportDrift(instance, status, drift, false, now)
+	}
+
+	return drift
+}
+
+// reportDrift sets the Drifted condition and records an event. An observed drift is only recorded when it changes,
+// to avoid an event on every sync.
+func (r *Reconciler[T, R]) reportDrift(instance T, status Status, drift []string, reverted bool, now metav1.Time) {
+	conditionStatus := metav1.ConditionTrue
+	reason := "DriftDetected"
+	msg := r.noun() + " changed outside of Kubernetes: " + strings.Join(drift, ", ")
+	if reverted {
+		conditionStatus = metav1.ConditionFalse
+		reason = "DriftReverted"
+		msg = "Reverted the " + r.noun() + " changes made outside of Kubernetes: " + strings.Join(drift, ", ")
+	} else if current, message, found := status.GetCondition(condition.DatadogConditionTypeDrifted); found && current == metav1.ConditionTrue && message == msg {
+		return
+	}
+
+	status.SetCondition(condition.DatadogConditionTypeDrifted, conditionStatus, reason, msg, now)
+	r.setDriftedMetric(instance, !reverted)
+	info := utils.BuildEventInfo(instance.GetName(), instance.GetNamespace(), r.adapter.Kind(), datadog.DriftEvent)
+	r.recorder.Eventf(instance, corev1.EventTypeWarning, info.GetReason(), "%s: %s", info.GetMessage(), msg)
+}
+
+// clearDrift sets the Drifted condition to false, if the Datadog resource has drifted before.
+func (r *Reconciler[T, R]) clearDrift(instance T, status Status, now metav1.Time) {
+	if current, _, found := status.GetCondition(condition.DatadogConditionTypeDrifted); found && current == metav1.ConditionTrue {
+		status.SetCondition(condition.DatadogConditionTypeDrifted, metav1.ConditionFalse, "NoDrift", "", now)
+	}
+	r.setDriftedMetric(instance, false)
+}
+
+func (r *Reconciler[T, R]) isObservingDrift(instance T) bool {
+	drifter, ok := r.adapter.(Drifter[T, R])
+	return ok && drifter.DriftPolicy(instance) == v1alpha1.DriftPolicyObserve
+}
+
+func (r *Reconciler[T, R]) setDriftedMetric(instance T, drifted bool) {
+	metrics.DatadogResourceDrifted.WithLabelValues(r.adapter.Kind(), instance.GetNamespace(), instance.GetName()).Set(boolToFloat(drifted))
+}
@@ -0,0 +1,116 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package apiresource
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+)
+
+// withNamespaceTags returns the resolved spec with the tags mapped from the labels and annotations of its namespace,
+// for the Tagger adapters. The tags set in the spec take precedence. The namespace tags aren't written in the custom
+// resource: they're part of the hashed spec, so that the Datadog resource follows the changes of the namespace.
+func (r *Reconciler[T, R]) withNamespaceTags(ctx context.Context, desired T) (T, error) {
+	tagger, ok := r.adapter.(Tagger[T])
+	if !ok || tagger.Tags(desired) == nil {
+		return desired, nil
+	}
+
+	namespaceTags, err := r.getNamespaceTags(ctx, desired)
+	if err != nil {
+		return desired, err
+	}
+	tagsToAdd := r.allowedTags(utils.GetTagsWithNewKeys(*tagger.Tags(desired), namespaceTags))
+	if len(tagsToAdd) == 0 {
+		return desired, nil
+	}
+
+	tagged := desired.DeepCopyObject().(T)
+	tags := tagger.Tags(tagged)
+	*tags = append(*tags, tagsToAdd...)
+	return tagged, nil
+}
+
+// getNamespaceTags returns the tags mapped from the labels and annotations of the namespace of the custom resource.
+func (r *Reconciler[T, R]) getNamespaceTags(ctx context.Context, instance T) ([]string, error) {
+	if r.namespaceTags.IsEmpty() {
+		return nil, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: instance.GetNamespace()}, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting namespace %s: %w", instance.GetNamespace(), err)
+	}
+
+	return r.namespaceTags.Tags(namespace), nil
+}
+
+// WatchNamespaces adds a watch on the namespaces to the controller of the custom resources, when their adapter is a
+// Tagger and namespace tags are mapped: the custom resources of a namespace are reconciled when its labels or
+// annotations change.
+func (r *Reconciler[T, R]) WatchNamespaces(b *builder.Builder) *builder.Builder {
+	if _, ok := r.adapter.(Tagger[T]); !ok || r.namespaceTags.IsEmpty() {
+		return b
+	}
+
+	return b.Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.enqueueNamespaceObjects),
+		builder.WithPredicates(predicate.Or(predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{})))
+}
+
+// enqueueNamespaceObjects enqueues the custom resources of a namespace.
+func (r *Reconciler[T, R]) enqueueNamespaceObjects(ctx context.Context, namespace client.Object) []reconcile.Request {
+	list, err := r.newList()
+	if err == nil {
+		err = r.client.List(ctx, list, client.InNamespace(namespace.GetName()))
+	}
+	if err != nil {
+		r.log.Error(err, "unable to list "+r.adapter.Kind(), "namespace", namespace.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	_ = meta.EachListItem(list, func(obj runtime.Object) error {
+		if o, ok := obj.(client.Object); ok {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+		}
+		return nil
+	})
+	return requests
+}
+
+// newList returns an empty list of the custom resources, from the scheme of the client.
+func (r *Reconciler[T, R]) newList() (client.ObjectList, error) {
+	gvk, err := apiutil.GVKForObject(r.adapter.NewObject(), r.client.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	list, err := r.client.Scheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	objectList, ok := list.(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list", gvk.Kind+"List")
+	}
+	return objectList, nil
+}
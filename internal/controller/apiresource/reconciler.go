@@ -0,0 +1,600 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package apiresource
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/finalizer"
+	"github.com/DataDog/datadog-operator/internal/controller/metrics"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	// DefaultRequeuePeriod is the period between two syncs of a custom resource in sync with Datadog.
+	DefaultRequeuePeriod = 60 * time.Second
+	// DefaultErrRequeuePeriod is the period before retrying a failed sync.
+	DefaultErrRequeuePeriod = 5 * time.Second
+	// DefaultForceSyncPeriod is the maximum period between two updates of a Datadog resource from the spec.
+	DefaultForceSyncPeriod = 60 * time.Minute
+
+	createOperation = "create"
+	getOperation    = "get"
+	updateOperation = "update"
+	deleteOperation = "delete"
+)
+
+// Reconciler reconciles a custom resource backed by a Datadog API resource, through its Adapter. All the kinds share
+// the same semantics: the Datadog resource is updated when the hash of the spec changes, and at least once per force
+// sync period, the changes made outside of Kubernetes are detected and reverted according to the drift policy, and
+// the Datadog resource is deleted or orphaned by a finalizer, according to the deletion policy. Every sync is reported
+// in the status, events and metrics.
+type Reconciler[T client.Object, R any] struct {
+	client         client.Client
+	apiReader      client.Reader
+	adapter        Adapter[T, R]
+	versionInfo    *version.Info
+	log            logr.Logger
+	recorder       record.EventRecorder
+	auths          *datadogclient.AuthCache
+	deletionPolicy v1alpha1.DeletionPolicy
+	namespaceTags  utils.NamespaceTags
+}
+
+// NewReconciler returns a new Reconciler for the custom resources adapted by adapter. apiReader reads the Secrets and
+// namespaces referenced for the Datadog credentials, without caching them. deletionPolicy is the deletion
+// policy of the custom resources that don't set one, Delete if empty. namespaceTags maps the labels and annotations
+// of the namespaces to the tags added to the custom resources of Tagger adapters.
+func NewReconciler[T client.Object, R any](client client.Client, apiReader client.Reader, adapter Adapter[T, R], versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder, deletionPolicy v1alpha1.DeletionPolicy, namespaceTags utils.NamespaceTags) *Reconciler[T, R] {
+	return &Reconciler[T, R]{
+		client:         client,
+		apiReader:      apiReader,
+		adapter:        adapter,
+		versionInfo:    versionInfo,
+		log:            log,
+		recorder:       recorder,
+		auths:          datadogclient.NewAuthCache(log),
+		deletionPolicy: deletionPolicy,
+		namespaceTags:  namespaceTags,
+	}
+}
+
+func (r *Reconciler[T, R]) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	kind := r.adapter.Kind()
+	logger := r.log.WithValues(strings.ToLower(kind), req.NamespacedName)
+	logger.Info("Reconciling "+kind, "version", r.versionInfo.String())
+	now := metav1.NewTime(time.Now())
+
+	// Get instance
+	instance := r.adapter.NewObject()
+	if err := r.client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: DefaultErrRequeuePeriod}, err
+	}
+
+	final := finalizer.NewFinalizer(logger, r.client, r.deleteResource(logger), DefaultRequeuePeriod, DefaultErrRequeuePeriod)
+	result, err := final.HandleFinalizer(ctx, instance, r.adapter.Status(instance).GetID(), r.adapter.Finalizer())
+	if ctrutils.ShouldReturn(result, err) || !instance.GetDeletionTimestamp().IsZero() {
+		return result, err
+	}
+
+	original := instance.DeepCopyObject().(T)
+	result, err = r.sync(ctx, logger, instance, r.adapter.Status(instance), now)
+	r.setSyncedMetric(instance, err == nil)
+
+	return r.updateStatusIfNeeded(ctx, logger, original, instance, result)
+}
+
+// sync syncs the Datadog resource with the custom resource, and returns the error of the sync, if any. The error is
+// reported in the status rather than returned to the controller.
+func (r *Reconciler[T, R]) sync(ctx context.Context, logger logr.Logger, instance T, status Status, now metav1.Time) (ctrl.Result, error) {
+	kind := r.adapter.Kind()
+
+	if err := r.adapter.Validate(instance); err != nil {
+		logger.Error(err, "invalid "+kind)
+		status.SetSyncError(StepValidate, r.reason(StepValidate), err, now)
+		return ctrl.Result{}, err
+	}
+
+	ctx, err := r.authenticate(ctx, instance)
+	if err != nil {
+		logger.Error(err, "error loading Datadog credentials")
+		status.SetSyncError(StepAuthenticate, r.reason(StepAuthenticate), err, now)
+		return errRequeueResult(err), err
+	}
+
+	desired := instance
+	if resolver, ok := r.adapter.(Resolver[T]); ok {
+		if desired, err = resolver.Resolve(ctx, instance); err != nil {
+			logger.Error(err, "error resolving references")
+			status.SetSyncError(StepResolve, r.reason(StepResolve), err, now)
+			return errRequeueResult(err), err
+		}
+	}
+
+	if desired, err = r.withNamespaceTags(ctx, desired); err != nil {
+		logger.Error(err, "error getting namespace tags")
+		status.SetSyncError(StepUpdate, "GettingNamespaceTags", err, now)
+		return errRequeueResult(err), err
+	}
+
+	hash, err := comparison.GenerateMD5ForSpec(r.adapter.HashedSpec(desired))
+	if err != nil {
+		logger.Error(err, "error generating hash")
+		status.SetSyncError(StepUpdate, "Generating"+r.noun()+"SpecHash", err, now)
+		return ctrl.Result{}, err
+	}
+
+	shouldCreate := false
+	shouldUpdate := false
+	var drift []string
+	var forceSyncRequestTime *metav1.Time
+
+	switch {
+	case status.GetID() == "":
+		shouldCreate = true
+	case hash != status.GetCurrentHash():
+		logger.Info(kind+" spec has changed", "ID", status.GetID())
+		shouldUpdate = true
+	default:
+		// Get the Datadog resource on every sync to make sure it exists, and to detect the changes made outside of Kubernetes
+		var remote R
+		remote, err = r.get(ctx, status.GetID())
+		if err != nil {
+			if !isNotFound(err) {
+				logger.Error(err, "error getting Datadog resource", "ID", status.GetID())
+				status.SetSyncError(StepGet, r.reason(StepGet), err, now)
+				return errRequeueResult(err), err
+			}
+			logger.Info("Datadog resource not found; creating it again", "ID", status.GetID())
+			shouldCreate = true
+			break
+		}
+		r.observe(instance, remote, now)
+
+		if ender, ok := r.adapter.(Ender[R]); ok {
+			if ended, recreate := ender.Ended(remote); ended {
+				shouldCreate = recreate
+				break
+			}
+		}
+
+		if requestTime, requested := r.forceSyncRequest(logger, instance, status); requested {
+			logger.Info("Forcing a sync with Datadog on request", "ID", status.GetID())
+			forceSyncRequestTime = &requestTime
+			shouldUpdate = true
+		} else if !r.isObservingDrift(instance) && isForceSyncDue(status, now) {
+			// Periodically force a sync with the Datadog resource to ensure parity
+			logger.Info("Forcing a sync with Datadog", "ID", status.GetID())
+			status.SetLastForceSyncTime(&now)
+			shouldUpdate = true
+		} else {
+			drift = r.detectDrift(logger, instance, desired, remote, status, now)
+			shouldUpdate = len(drift) > 0 && !r.isObservingDrift(instance)
+		}
+	}
+
+	if shouldCreate || shouldUpdate {
+		// Check that required tags are present
+		var tagsUpdated bool
+		if tagsUpdated, err = r.checkRequiredTags(ctx, logger, instance, status); err != nil {
+			return errRequeueResult(err), err
+		} else if tagsUpdated {
+			// A reconcile is triggered by the update
+			return ctrl.Result{}, nil
+		}
+
+		if shouldCreate {
+			if adoptID := r.adoptID(instance); adoptID != "" {
+				err = r.adopt(ctx, logger, instance, desired, adoptID, status, hash, now)
+			} else {
+				err = r.create(ctx, logger, instance, desired, status, hash, now)
+			}
+		} else if r.mustReplace(instance, desired) {
+			err = r.replace(ctx, logger, instance, desired, status, hash, now)
+		} else {
+			err = r.update(ctx, logger, instance, desired, status, hash, now)
+			switch {
+			case err != nil && isNotFound(err):
+				// The Datadog resource was deleted, or the credentials now belong to another organization
+				logger.Info("Datadog resource not found; creating it again", "ID", status.GetID())
+				err = r.create(ctx, logger, instance, desired, status, hash, now)
+			case err == nil && len(drift) > 0:
+				r.reportDrift(instance, status, drift, true, now)
+			}
+		}
+	}
+
+	if lastSync := status.GetLastForceSyncTime(); err == nil && forceSyncRequestTime != nil && (lastSync == nil || lastSync.Before(forceSyncRequestTime)) {
+		// A force sync request in the future is recorded so that it's only honoured once
+		status.SetLastForceSyncTime(forceSyncRequestTime)
+	}
+
+	if refresher, ok := r.adapter.(Refresher[T]); ok && status.GetID() != "" {
+		refresher.Refresh(ctx, logger, instance, now)
+	}
+
+	if err != nil {
+		return errRequeueResult(err), err
+	}
+	status.SetSynced(now)
+
+	return ctrl.Result{RequeueAfter: DefaultRequeuePeriod}, nil
+}
+
+func (r *Reconciler[T, R]) create(ctx context.Context, logger logr.Logger, instance, desired T, status Status, hash string, now metav1.Time) error {
+	logger.V(1).Info("ID is not set; creating " + r.noun() + " in Datadog")
+
+	remote, err := r.adapter.Create(ctx, desired)
+	r.countRequest(createOperation, err)
+	if err != nil {
+		logger.Error(err, "error creating Datadog resource")
+		status.SetSyncError(StepCreate, r.reason(StepCreate), err, now)
+		return err
+	}
+
+	// Set condition and status
+	info := r.adapter.Info(remote)
+	created := timeOrNow(info.Created, now)
+	status.SetID(info.ID)
+	status.SetCreated(info.Creator, &created)
+	status.SetCurrentHash(hash)
+	status.SetLastForceSyncTime(lastSyncTime(info, now))
+	status.SetCondition(condition.DatadogConditionTypeCreated, metav1.ConditionTrue, r.reason(StepCreate), r.adapter.Kind()+" Created", now)
+	r.setResolved(instance, desired)
+	r.observe(instance, remote, now)
+
+	logger.Info("Created a new "+r.adapter.Kind(), "ID", info.ID)
+	r.recordEvent(instance, datadog.CreationEvent)
+
+	return nil
+}
+
+// adopt takes ownership of an existing Datadog resource instead of creating a new one: the Datadog resource is
+// updated from the spec and its static information is added to the status.
+func (r *Reconciler[T, R]) adopt(ctx context.Context, logger logr.Logger, instance, desired T, adoptID string, status Status, hash string, now metav1.Time) error {
+	logger.V(1).Info("ID is not set; adopting existing "+r.noun()+" in Datadog", "ID", adoptID)
+
+	// Get the Datadog resource to make sure it exists
+	existing, err := r.get(ctx, adoptID)
+	if err != nil {
+		logger.Error(err, "error getting Datadog resource to adopt", "ID", adoptID)
+		status.SetSyncError(StepGet, "Adopting"+r.noun(), err, now)
+		return err
+	}
+
+	// Apply the spec to the adopted Datadog resource
+	adopted := desired.DeepCopyObject().(T)
+	r.adapter.Status(adopted).SetID(adoptID)
+	if err = r.update(ctx, logger, instance, adopted, status, hash, now); err != nil {
+		return err
+	}
+
+	// Set condition and status
+	info := r.adapter.Info(existing)
+	created := timeOrNow(info.Created, now)
+	status.SetID(adoptID)
+	status.SetCreated(info.Creator, &created)
+	status.SetCondition(condition.DatadogConditionTypeCreated, metav1.ConditionTrue, "Adopting"+r.noun(), r.adapter.Kind()+" Adopted", now)
+
+	logger.Info("Adopted an existing "+r.noun(), "ID", adoptID)
+
+	return nil
+}
+
+func (r *Reconciler[T, R]) update(ctx context.Context, logger logr.Logger, instance, desired T, status Status, hash string, now metav1.Time) error {
+	remote, err := r.adapter.Update(ctx, desired)
+	r.countRequest(updateOperation, err)
+	if err != nil {
+		logger.Error(err, "error updating Datadog resource", "ID", r.adapter.Status(desired).GetID())
+		status.SetSyncError(StepUpdate, r.reason(StepUpdate), err, now)
+		return err
+	}
+	r.recordEvent(instance, datadog.UpdateEvent)
+
+	// Set condition and status, the Datadog resource now matches the spec
+	status.SetCondition(condition.DatadogConditionTypeUpdated, metav1.ConditionTrue, r.reason(StepUpdate), r.adapter.Kind()+" Updated", now)
+	r.clearDrift(instance, status, now)
+	status.SetCurrentHash(hash)
+	// Use the modification time from Datadog when available, so that this update isn't considered as a drift
+	status.SetLastForceSyncTime(lastSyncTime(r.adapter.Info(remote), now))
+	r.setResolved(instance, desired)
+	r.observe(instance, remote, now)
+
+	logger.Info("Updated "+r.adapter.Kind(), "ID", r.adapter.Status(desired).GetID())
+
+	return nil
+}
+
+// replace deletes the Datadog resource and creates it again, for the specs it can't be updated to.
+func (r *Reconciler[T, R]) replace(ctx context.Context, logger logr.Logger, instance, desired T, status Status, hash string, now metav1.Time) error {
+	logger.Info(r.noun()+" can't be updated; creating it again", "ID", status.GetID())
+
+	err := r.adapter.Delete(ctx, instance)
+	r.countRequest(deleteOperation, err)
+	if err != nil && !isNotFound(err) {
+		logger.Error(err, "error deleting Datadog resource", "ID", status.GetID())
+		status.SetSyncError(StepUpdate, "Deleting"+r.noun(), err, now)
+		return err
+	}
+
+	return r.create(ctx, logger, instance, desired, status, hash, now)
+}
+
+func (r *Reconciler[T, R]) get(ctx context.Context, id string) (R, error) {
+	remote, err := r.adapter.Get(ctx, id)
+	r.countRequest(getOperation, err)
+	return remote, err
+}
+
+func (r *Reconciler[T, R]) deleteResource(logger logr.Logger) finalizer.ResourceDeleteFunc {
+	return func(ctx context.Context, k8sObj client.Object, datadogID string) error {
+		instance := k8sObj.(T)
+		eventType := datadog.DeletionEvent
+		if datadogID != "" {
+			ctx, err := r.authenticate(ctx, instance)
+			if err != nil {
+				logger.Error(err, "error loading Datadog credentials", "ID", datadogID)
+				return err
+			}
+			if r.getDeletionPolicy(instance) == v1alpha1.DeletionPolicyOrphan {
+				if err = r.orphan(ctx, instance); err != nil && !isNotFound(err) {
+					logger.Error(err, "error orphaning Datadog resource", "ID", datadogID)
+					return err
+				}
+				logger.Info("Orphaned Datadog resource; it's no longer managed by the operator", "ID", datadogID)
+				eventType = datadog.OrphanEvent
+			} else {
+				err = r.adapter.Delete(ctx, instance)
+				r.countRequest(deleteOperation, err)
+				if err != nil && !isNotFound(err) {
+					logger.Error(err, "error deleting Datadog resource", "ID", datadogID)
+					return err
+				}
+				logger.Info("Successfully deleted Datadog resource", "ID", datadogID)
+			}
+		}
+		r.recordEvent(instance, eventType)
+		metrics.CleanupMetricsByDatadogResource(r.adapter.Kind(), instance)
+		return nil
+	}
+}
+
+// getDeletionPolicy returns the deletion policy of the custom resource, or else the one of the reconciler.
+func (r *Reconciler[T, R]) getDeletionPolicy(instance T) v1alpha1.DeletionPolicy {
+	if policy := r.adapter.DeletionPolicy(instance); policy != "" {
+		return policy
+	}
+	if r.deletionPolicy != "" {
+		return r.deletionPolicy
+	}
+	return v1alpha1.DeletionPolicyDelete
+}
+
+// orphan leaves the Datadog resource in Datadog, tagged as no longer managed by the operator if the adapter supports
+// it.
+func (r *Reconciler[T, R]) orphan(ctx context.Context, instance T) error {
+	orphaner, ok := r.adapter.(Orphaner[T])
+	if !ok {
+		return nil
+	}
+	err := orphaner.Orphan(ctx, instance)
+	r.countRequest(updateOperation, err)
+	return err
+}
+
+// checkRequiredTags adds the missing required tags to the custom resource, and returns true if it was updated.
+func (r *Reconciler[T, R]) checkRequiredTags(ctx context.Context, logger logr.Logger, instance T, status Status) (bool, error) {
+	tagger, ok := r.adapter.(Tagger[T])
+	if !ok {
+		return false, nil
+	}
+	tags := tagger.Tags(instance)
+	if tags == nil {
+		return false, nil
+	}
+
+	tagsToAdd := r.allowedTags(utils.GetTagsToAdd(*tags))
+	if len(tagsToAdd) == 0 {
+		return false, nil
+	}
+
+	*tags = append(*tags, tagsToAdd...)
+	if err := r.client.Update(ctx, instance); err != nil {
+		logger.Error(err, "failed to update "+r.adapter.Kind()+" with required tags")
+		return false, err
+	}
+	logger.Info("Added required tags", "ID", status.GetID(), "tags", tagsToAdd)
+
+	return true, nil
+}
+
+// allowedTags returns the tags accepted by the Datadog resource, see TagRestricter.
+func (r *Reconciler[T, R]) allowedTags(tags []string) []string {
+	if restricter, ok := r.adapter.(TagRestricter); ok {
+		return filterTagKeys(tags, restricter.AllowedTagKeys())
+	}
+	return tags
+}
+
+// filterTagKeys returns the tags with one of the allowed keys.
+func filterTagKeys(tags, allowedKeys []string) []string {
+	filtered := []string{}
+	for _, t := range tags {
+		if slices.Contains(allowedKeys, utils.TagKey(t)) {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+func (r *Reconciler[T, R]) adoptID(instance T) string {
+	if adopter, ok := r.adapter.(Adopter[T]); ok {
+		return adopter.AdoptID(instance)
+	}
+	return ""
+}
+
+func (r *Reconciler[T, R]) mustReplace(instance, desired T) bool {
+	replacer, ok := r.adapter.(Replacer[T])
+	return ok && replacer.Replace(instance, desired)
+}
+
+func (r *Reconciler[T, R]) setResolved(instance, desired T) {
+	if resolver, ok := r.adapter.(Resolver[T]); ok {
+		resolver.SetResolved(instance, desired)
+	}
+}
+
+func (r *Reconciler[T, R]) observe(instance T, remote R, now metav1.Time) {
+	if observer, ok := r.adapter.(Observer[T, R]); ok {
+		observer.Observe(instance, remote, now)
+	}
+}
+
+// updateStatusIfNeeded writes the status of the custom resource if the sync changed it. The whole status is compared,
+// including the fields set by Observer and Refresher adapters.
+func (r *Reconciler[T, R]) updateStatusIfNeeded(ctx context.Context, logger logr.Logger, original, instance T, result ctrl.Result) (ctrl.Result, error) {
+	if statusChanged(original, instance) {
+		if err := r.client.Status().Update(ctx, instance); err != nil {
+			if apierrors.IsConflict(err) {
+				logger.Error(err, "unable to update "+r.adapter.Kind()+" status due to update conflict")
+				return ctrl.Result{Requeue: true, RequeueAfter: DefaultErrRequeuePeriod}, nil
+			}
+			logger.Error(err, "unable to update "+r.adapter.Kind()+" status")
+			return ctrl.Result{Requeue: true, RequeueAfter: DefaultRequeuePeriod}, err
+		}
+	}
+	return result, nil
+}
+
+func (r *Reconciler[T, R]) recordEvent(instance T, eventType datadog.EventType) {
+	info := utils.BuildEventInfo(instance.GetName(), instance.GetNamespace(), r.adapter.Kind(), eventType)
+	r.recorder.Event(instance, corev1.EventTypeNormal, info.GetReason(), info.GetMessage())
+}
+
+func (r *Reconciler[T, R]) countRequest(operation string, err error) {
+	result := metrics.SuccessResult
+	if err != nil {
+		result = metrics.ErrorResult
+	}
+	metrics.DatadogResourceRequests.WithLabelValues(r.adapter.Kind(), operation, result).Inc()
+}
+
+func (r *Reconciler[T, R]) setSyncedMetric(instance T, synced bool) {
+	metrics.DatadogResourceSynced.WithLabelValues(r.adapter.Kind(), instance.GetNamespace(), instance.GetName()).Set(boolToFloat(synced))
+}
+
+// noun returns the name of the Datadog resource, e.g. SLO for DatadogSLO.
+func (r *Reconciler[T, R]) noun() string {
+	return strings.TrimPrefix(r.adapter.Kind(), "Datadog")
+}
+
+// reason returns the reason of the conditions set by a step, e.g. CreatingSLO.
+func (r *Reconciler[T, R]) reason(step Step) string {
+	return string(step) + r.noun()
+}
+
+// forceSyncRequest returns the time of the force sync requested with the force sync annotation, and whether it's more
+// recent than the last force sync. Invalid request times are ignored.
+func (r *Reconciler[T, R]) forceSyncRequest(logger logr.Logger, instance T, status Status) (metav1.Time, bool) {
+	value, found := instance.GetAnnotations()[v1alpha1.ForceSyncAnnotationKey]
+	if !found {
+		return metav1.Time{}, false
+	}
+	requestTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		logger.Error(err, "invalid force sync request time, ignoring it", "annotation", v1alpha1.ForceSyncAnnotationKey)
+		return metav1.Time{}, false
+	}
+	// The times are stored with a precision of a second in the status
+	requestTime = requestTime.Truncate(time.Second)
+	lastSync := status.GetLastForceSyncTime()
+	return metav1.NewTime(requestTime), lastSync == nil || lastSync.Time.Before(requestTime)
+}
+
+// statusChanged returns true if the status of the custom resource differs from the original one. The statuses are
+// compared as they are serialized, and are considered changed if they can't be.
+func statusChanged(original, instance client.Object) bool {
+	originalStatus, err := statusOf(original)
+	if err != nil {
+		return true
+	}
+	status, err := statusOf(instance)
+	if err != nil {
+		return true
+	}
+	return !apiequality.Semantic.DeepEqual(originalStatus, status)
+}
+
+func statusOf(obj client.Object) (interface{}, error) {
+	unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return unstructuredObj["status"], nil
+}
+
+func isForceSyncDue(status Status, now metav1.Time) bool {
+	lastSync := status.GetLastForceSyncTime()
+	return lastSync == nil || now.Sub(lastSync.Time) >= DefaultForceSyncPeriod
+}
+
+// errRequeueResult returns the result of a failed sync: the custom resource is synced again after the error requeue
+// period, or once the rate limit of the Datadog API is reset.
+func errRequeueResult(err error) ctrl.Result {
+	if retryAfter, rateLimited := datadogclient.RetryAfter(err); rateLimited && retryAfter > DefaultErrRequeuePeriod {
+		return ctrl.Result{RequeueAfter: retryAfter}
+	}
+	return ctrl.Result{RequeueAfter: DefaultErrRequeuePeriod}
+}
+
+func isNotFound(err error) bool {
+	return datadogclient.IsNotFound(err)
+}
+
+func timeOrNow(t time.Time, now metav1.Time) metav1.Time {
+	if t.IsZero() {
+		return now
+	}
+	return metav1.NewTime(t)
+}
+
+// lastSyncTime returns the modification time of the Datadog resource when known, the current time otherwise.
+func lastSyncTime(info Info, now metav1.Time) *metav1.Time {
+	syncTime := timeOrNow(info.Modified, now)
+	return &syncTime
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,931 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package apiresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const testFinalizer = "finalizer.test.datadoghq.com"
+
+// testResource is the Datadog resource of the testAdapter.
+type testResource struct {
+	ID       string
+	Name     string
+	Modified time.Time
+	Orphaned bool
+	State    v1alpha1.DatadogSLOState
+	Tags     []string
+}
+
+// testAdapter adapts DatadogSLOs to Datadog resources stored in memory, identified by an incrementing ID.
+type testAdapter struct {
+	resources map[string]testResource
+	nextID    int
+	calls     map[string]int
+	adoptID   string
+	replace   bool
+	// auth is the authentication context of the last request
+	auth context.Context
+}
+
+func newTestAdapter(resources ...testResource) *testAdapter {
+	a := &testAdapter{resources: map[string]testResource{}, nextID: 100, calls: map[string]int{}}
+	for _, resource := range resources {
+		a.resources[resource.ID] = resource
+	}
+	return a
+}
+
+func (a *testAdapter) Kind() string {
+	return "DatadogSLO"
+}
+
+func (a *testAdapter) Finalizer() string {
+	return testFinalizer
+}
+
+func (a *testAdapter) NewObject() *v1alpha1.DatadogSLO {
+	return &v1alpha1.DatadogSLO{}
+}
+
+func (a *testAdapter) Status(instance *v1alpha1.DatadogSLO) Status {
+	return &StatusFields[v1alpha1.DatadogSLOSyncStatus]{
+		Conditions:        &instance.Status.Conditions,
+		ID:                &instance.Status.ID,
+		Creator:           &instance.Status.Creator,
+		Created:           &instance.Status.Created,
+		SyncStatus:        &instance.Status.SyncStatus,
+		LastForceSyncTime: &instance.Status.LastForceSyncTime,
+		CurrentHash:       &instance.Status.CurrentHash,
+		SyncStatusOK:      v1alpha1.DatadogSLOSyncStatusOK,
+		SyncStatusErrors: map[Step]v1alpha1.DatadogSLOSyncStatus{
+			StepValidate:     v1alpha1.DatadogSLOSyncStatusValidateError,
+			StepAuthenticate: v1alpha1.DatadogSLOSyncStatusCredentialsError,
+			StepCreate:       v1alpha1.DatadogSLOSyncStatusCreateError,
+			StepGet:          v1alpha1.DatadogSLOSyncStatusGetError,
+			StepUpdate:       v1alpha1.DatadogSLOSyncStatusUpdateError,
+		},
+	}
+}
+
+func (a *testAdapter) DeletionPolicy(instance *v1alpha1.DatadogSLO) v1alpha1.DeletionPolicy {
+	return instance.Spec.DeletionPolicy
+}
+
+func (a *testAdapter) Validate(instance *v1alpha1.DatadogSLO) error {
+	if instance.Spec.Name == "" {
+		return errors.New("spec.Name must be defined")
+	}
+	return nil
+}
+
+func (a *testAdapter) HashedSpec(instance *v1alpha1.DatadogSLO) interface{} {
+	return &instance.Spec
+}
+
+func (a *testAdapter) Create(ctx context.Context, instance *v1alpha1.DatadogSLO) (testResource, error) {
+	a.calls[createOperation]++
+	a.auth = datadogclient.AuthFromContext(ctx, nil)
+	a.nextID++
+	resource := testResource{ID: strconv.Itoa(a.nextID), Name: instance.Spec.Name, Modified: time.Now(), Tags: instance.Spec.Tags}
+	a.resources[resource.ID] = resource
+	return resource, nil
+}
+
+// notFoundError returns the error of the Datadog API client when the resource isn't found.
+func notFoundError(msg, id string) error {
+	return datadogclient.TranslateClientError(datadogapi.GenericOpenAPIError{ErrorMessage: "404 Not Found"}, fmt.Sprintf(msg, id))
+}
+
+func (a *testAdapter) Get(ctx context.Context, id string) (testResource, error) {
+	a.calls[getOperation]++
+	resource, found := a.resources[id]
+	if !found {
+		return testResource{}, notFoundError("error getting resource %s", id)
+	}
+	return resource, nil
+}
+
+func (a *testAdapter) Update(ctx context.Context, instance *v1alpha1.DatadogSLO) (testResource, error) {
+	a.calls[updateOperation]++
+	if _, found := a.resources[instance.Status.ID]; !found {
+		return testResource{}, notFoundError("error updating resource %s", instance.Status.ID)
+	}
+	resource := testResource{ID: instance.Status.ID, Name: instance.Spec.Name, Modified: time.Now(), Tags: instance.Spec.Tags}
+	a.resources[resource.ID] = resource
+	return resource, nil
+}
+
+func (a *testAdapter) Delete(ctx context.Context, instance *v1alpha1.DatadogSLO) error {
+	a.calls[deleteOperation]++
+	a.auth = datadogclient.AuthFromContext(ctx, nil)
+	if _, found := a.resources[instance.Status.ID]; !found {
+		return notFoundError("error deleting resource %s", instance.Status.ID)
+	}
+	delete(a.resources, instance.Status.ID)
+	return nil
+}
+
+func (a *testAdapter) Orphan(ctx context.Context, instance *v1alpha1.DatadogSLO) error {
+	a.calls[updateOperation]++
+	resource, found := a.resources[instance.Status.ID]
+	if !found {
+		return notFoundError("error updating resource %s", instance.Status.ID)
+	}
+	resource.Orphaned = true
+	a.resources[resource.ID] = resource
+	return nil
+}
+
+func (a *testAdapter) Observe(instance *v1alpha1.DatadogSLO, resource testResource, now metav1.Time) {
+	instance.Status.State = resource.State
+}
+
+func (a *testAdapter) Info(resource testResource) Info {
+	return Info{ID: resource.ID, Creator: "test@datadoghq.com", Modified: resource.Modified}
+}
+
+func (a *testAdapter) CredentialsRef(instance *v1alpha1.DatadogSLO) *v1alpha1.DatadogCredentialsReference {
+	return instance.Spec.CredentialsRef
+}
+
+func (a *testAdapter) Tags(instance *v1alpha1.DatadogSLO) *[]string {
+	return &instance.Spec.Tags
+}
+
+func (a *testAdapter) AdoptID(instance *v1alpha1.DatadogSLO) string {
+	if a.adoptID == instance.Status.ID {
+		return ""
+	}
+	return a.adoptID
+}
+
+func (a *testAdapter) Replace(instance, desired *v1alpha1.DatadogSLO) bool {
+	return a.replace
+}
+
+func (a *testAdapter) DriftPolicy(instance *v1alpha1.DatadogSLO) v1alpha1.DriftPolicy {
+	if instance.Spec.ControllerOptions == nil {
+		return ""
+	}
+	return instance.Spec.ControllerOptions.DriftPolicy
+}
+
+func (a *testAdapter) Drift(instance *v1alpha1.DatadogSLO, resource testResource) ([]string, error) {
+	if instance.Spec.Name != resource.Name {
+		return []string{"name"}, nil
+	}
+	return nil, nil
+}
+
+var (
+	_ Adapter[*v1alpha1.DatadogSLO, testResource]  = (*testAdapter)(nil)
+	_ Credentialer[*v1alpha1.DatadogSLO]           = (*testAdapter)(nil)
+	_ Tagger[*v1alpha1.DatadogSLO]                 = (*testAdapter)(nil)
+	_ Adopter[*v1alpha1.DatadogSLO]                = (*testAdapter)(nil)
+	_ Replacer[*v1alpha1.DatadogSLO]               = (*testAdapter)(nil)
+	_ Drifter[*v1alpha1.DatadogSLO, testResource]  = (*testAdapter)(nil)
+	_ Orphaner[*v1alpha1.DatadogSLO]               = (*testAdapter)(nil)
+	_ Observer[*v1alpha1.DatadogSLO, testResource] = (*testAdapter)(nil)
+)
+
+func testSLO() *v1alpha1.DatadogSLO {
+	return &v1alpha1.DatadogSLO{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo", Finalizers: []string{testFinalizer}},
+		Spec: v1alpha1.DatadogSLOSpec{
+			Name: "test SLO",
+			Tags: utils.GetRequiredTags(),
+		},
+	}
+}
+
+// syncedSLO returns a DatadogSLO in sync with the Datadog resource 1, last synced at lastSync.
+func syncedSLO(lastSync time.Time) *v1alpha1.DatadogSLO {
+	slo := testSLO()
+	hash, _ := comparison.GenerateMD5ForSpec(&slo.Spec)
+	syncTime := metav1.NewTime(lastSync)
+	slo.Status = v1alpha1.DatadogSLOStatus{ID: "1", CurrentHash: hash, LastForceSyncTime: &syncTime}
+	return slo
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	lastSync := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name       string
+		instance   *v1alpha1.DatadogSLO
+		adapter    *testAdapter
+		wantResult reconcile.Result
+		wantCalls  map[string]int
+		wantFunc   func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter)
+	}{
+		{
+			name: "invalid spec",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := testSLO()
+				slo.Spec.Name = ""
+				return slo
+			}(),
+			adapter:    newTestAdapter(),
+			wantResult: reconcile.Result{},
+			wantCalls:  map[string]int{},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusValidateError, instance.Status.SyncStatus)
+				assert.True(t, meta.IsStatusConditionTrue(instance.Status.Conditions, string(condition.DatadogConditionTypeError)))
+			},
+		},
+		{
+			name:       "resource created",
+			instance:   testSLO(),
+			adapter:    newTestAdapter(),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{createOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "101", instance.Status.ID)
+				assert.Equal(t, "test@datadoghq.com", instance.Status.Creator)
+				assert.NotEmpty(t, instance.Status.CurrentHash)
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusOK, instance.Status.SyncStatus)
+				assert.True(t, meta.IsStatusConditionTrue(instance.Status.Conditions, string(condition.DatadogConditionTypeCreated)))
+			},
+		},
+		{
+			name: "required tags added before creating the resource",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := testSLO()
+				slo.Spec.Tags = nil
+				return slo
+			}(),
+			adapter:    newTestAdapter(),
+			wantResult: reconcile.Result{},
+			wantCalls:  map[string]int{},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, utils.GetRequiredTags(), instance.Spec.Tags)
+				assert.Empty(t, instance.Status.ID)
+			},
+		},
+		{
+			name:       "resource in sync",
+			instance:   syncedSLO(lastSync),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync.Add(-time.Minute)}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusOK, instance.Status.SyncStatus)
+			},
+		},
+		{
+			name: "state observed on a fully synced resource",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Status.SyncStatus = v1alpha1.DatadogSLOSyncStatusOK
+				slo.Status.State = v1alpha1.DatadogSLOStateOK
+				return slo
+			}(),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync.Add(-time.Minute), State: v1alpha1.DatadogSLOStateBreached}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, v1alpha1.DatadogSLOStateBreached, instance.Status.State)
+			},
+		},
+		{
+			name: "spec changed",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Spec.Name = "renamed SLO"
+				return slo
+			}(),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{updateOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "renamed SLO", adapter.resources["1"].Name)
+				hash, _ := comparison.GenerateMD5ForSpec(&instance.Spec)
+				assert.Equal(t, hash, instance.Status.CurrentHash)
+				assert.True(t, meta.IsStatusConditionTrue(instance.Status.Conditions, string(condition.DatadogConditionTypeUpdated)))
+			},
+		},
+		{
+			name:       "resource deleted in Datadog",
+			instance:   syncedSLO(lastSync),
+			adapter:    newTestAdapter(),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1, createOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "101", instance.Status.ID)
+			},
+		},
+		{
+			name: "resource deleted in Datadog while the spec changed",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Spec.Name = "renamed SLO"
+				return slo
+			}(),
+			adapter:    newTestAdapter(),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{updateOperation: 1, createOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "101", instance.Status.ID)
+				assert.Equal(t, "renamed SLO", adapter.resources["101"].Name)
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusOK, instance.Status.SyncStatus)
+			},
+		},
+		{
+			name:       "force sync due",
+			instance:   syncedSLO(time.Now().Add(-2 * DefaultForceSyncPeriod)),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO"}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1, updateOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.True(t, instance.Status.LastForceSyncTime.After(lastSync))
+			},
+		},
+		{
+			name: "force sync requested",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Annotations = map[string]string{v1alpha1.ForceSyncAnnotationKey: lastSync.Add(30 * time.Second).Format(time.RFC3339)}
+				return slo
+			}(),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync.Add(-time.Minute)}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1, updateOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.True(t, instance.Status.LastForceSyncTime.After(lastSync.Add(30*time.Second)))
+			},
+		},
+		{
+			name: "force sync requested in the future",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Annotations = map[string]string{v1alpha1.ForceSyncAnnotationKey: lastSync.Add(time.Hour).Format(time.RFC3339)}
+				return slo
+			}(),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync.Add(-time.Minute)}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1, updateOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, lastSync.Add(time.Hour).Truncate(time.Second), instance.Status.LastForceSyncTime.Time.Local())
+			},
+		},
+		{
+			name: "force sync request already honoured",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Annotations = map[string]string{v1alpha1.ForceSyncAnnotationKey: lastSync.Add(-time.Minute).Format(time.RFC3339)}
+				return slo
+			}(),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync.Add(-time.Minute)}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1},
+		},
+		{
+			name: "invalid force sync request",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Annotations = map[string]string{v1alpha1.ForceSyncAnnotationKey: "now"}
+				return slo
+			}(),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync.Add(-time.Minute)}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1},
+		},
+		{
+			name:       "drift reverted",
+			instance:   syncedSLO(lastSync),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "renamed in the UI", Modified: lastSync.Add(time.Second)}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1, updateOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "test SLO", adapter.resources["1"].Name)
+				drifted := meta.FindStatusCondition(instance.Status.Conditions, string(condition.DatadogConditionTypeDrifted))
+				require.NotNil(t, drifted)
+				assert.Equal(t, metav1.ConditionFalse, drifted.Status)
+				assert.Equal(t, "DriftReverted", drifted.Reason)
+				assert.Equal(t, "Reverted the SLO changes made outside of Kubernetes: name", drifted.Message)
+			},
+		},
+		{
+			name: "drift observed",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Spec.ControllerOptions = &v1alpha1.DatadogSLOControllerOptions{DriftPolicy: v1alpha1.DriftPolicyObserve}
+				hash, _ := comparison.GenerateMD5ForSpec(&slo.Spec)
+				slo.Status.CurrentHash = hash
+				return slo
+			}(),
+			adapter:    newTestAdapter(testResource{ID: "1", Name: "renamed in the UI", Modified: lastSync.Add(time.Second)}),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "renamed in the UI", adapter.resources["1"].Name)
+				drifted := meta.FindStatusCondition(instance.Status.Conditions, string(condition.DatadogConditionTypeDrifted))
+				require.NotNil(t, drifted)
+				assert.Equal(t, metav1.ConditionTrue, drifted.Status)
+				assert.Equal(t, "SLO changed outside of Kubernetes: name", drifted.Message)
+			},
+		},
+		{
+			name:     "resource adopted",
+			instance: testSLO(),
+			adapter: func() *testAdapter {
+				a := newTestAdapter(testResource{ID: "42", Name: "created in the UI"})
+				a.adoptID = "42"
+				return a
+			}(),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{getOperation: 1, updateOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "42", instance.Status.ID)
+				assert.Equal(t, "test SLO", adapter.resources["42"].Name)
+				created := meta.FindStatusCondition(instance.Status.Conditions, string(condition.DatadogConditionTypeCreated))
+				require.NotNil(t, created)
+				assert.Equal(t, "DatadogSLO Adopted", created.Message)
+			},
+		},
+		{
+			name: "resource replaced",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := syncedSLO(lastSync)
+				slo.Spec.Name = "renamed SLO"
+				return slo
+			}(),
+			adapter: func() *testAdapter {
+				a := newTestAdapter(testResource{ID: "1", Name: "test SLO", Modified: lastSync})
+				a.replace = true
+				return a
+			}(),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantCalls:  map[string]int{deleteOperation: 1, createOperation: 1},
+			wantFunc: func(t *testing.T, instance *v1alpha1.DatadogSLO, adapter *testAdapter) {
+				assert.Equal(t, "101", instance.Status.ID)
+				assert.NotContains(t, adapter.resources, "1")
+				assert.Equal(t, "renamed SLO", adapter.resources["101"].Name)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := newTestClient(t, tt.instance)
+			r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, k8sClient, tt.adapter, &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), "", utils.NamespaceTags{})
+
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tt.instance)})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantCalls, tt.adapter.calls)
+
+			got := &v1alpha1.DatadogSLO{}
+			require.NoError(t, k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(tt.instance), got))
+			if tt.wantFunc != nil {
+				tt.wantFunc(t, got, tt.adapter)
+			}
+		})
+	}
+}
+
+func TestReconciler_Reconcile_delete(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        v1alpha1.DeletionPolicy
+		defaultPolicy v1alpha1.DeletionPolicy
+		notCreated    bool
+		adapter       *testAdapter
+		wantCalls     map[string]int
+		wantResources map[string]testResource
+	}{
+		{
+			name:          "resource deleted",
+			adapter:       newTestAdapter(testResource{ID: "1", Name: "test SLO"}),
+			wantCalls:     map[string]int{deleteOperation: 1},
+			wantResources: map[string]testResource{},
+		},
+		{
+			name:          "resource already deleted in Datadog",
+			adapter:       newTestAdapter(),
+			wantCalls:     map[string]int{deleteOperation: 1},
+			wantResources: map[string]testResource{},
+		},
+		{
+			name:          "resource not created in Datadog",
+			notCreated:    true,
+			adapter:       newTestAdapter(testResource{ID: "1", Name: "test SLO"}),
+			wantCalls:     map[string]int{},
+			wantResources: map[string]testResource{"1": {ID: "1", Name: "test SLO"}},
+		},
+		{
+			name:          "resource orphaned by the spec",
+			policy:        v1alpha1.DeletionPolicyOrphan,
+			adapter:       newTestAdapter(testResource{ID: "1", Name: "test SLO"}),
+			wantCalls:     map[string]int{updateOperation: 1},
+			wantResources: map[string]testResource{"1": {ID: "1", Name: "test SLO", Orphaned: true}},
+		},
+		{
+			name:          "resource orphaned by the operator default",
+			defaultPolicy: v1alpha1.DeletionPolicyOrphan,
+			adapter:       newTestAdapter(testResource{ID: "1", Name: "test SLO"}),
+			wantCalls:     map[string]int{updateOperation: 1},
+			wantResources: map[string]testResource{"1": {ID: "1", Name: "test SLO", Orphaned: true}},
+		},
+		{
+			name:          "spec takes precedence over the operator default",
+			policy:        v1alpha1.DeletionPolicyDelete,
+			defaultPolicy: v1alpha1.DeletionPolicyOrphan,
+			adapter:       newTestAdapter(testResource{ID: "1", Name: "test SLO"}),
+			wantCalls:     map[string]int{deleteOperation: 1},
+			wantResources: map[string]testResource{},
+		},
+		{
+			name:          "orphaned resource already deleted in Datadog",
+			policy:        v1alpha1.DeletionPolicyOrphan,
+			adapter:       newTestAdapter(),
+			wantCalls:     map[string]int{updateOperation: 1},
+			wantResources: map[string]testResource{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := syncedSLO(time.Now())
+			instance.Spec.DeletionPolicy = tt.policy
+			if tt.notCreated {
+				instance.Status = v1alpha1.DatadogSLOStatus{}
+			}
+			now := metav1.Now()
+			instance.DeletionTimestamp = &now
+			k8sClient := newTestClient(t, instance)
+			r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, k8sClient, tt.adapter, &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), tt.defaultPolicy, utils.NamespaceTags{})
+
+			_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(instance)})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCalls, tt.adapter.calls)
+			assert.Equal(t, tt.wantResources, tt.adapter.resources)
+
+			// The finalizer is removed, so that the DatadogSLO is deleted
+			err = k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}, &v1alpha1.DatadogSLO{})
+			assert.True(t, apierrors.IsNotFound(err))
+		})
+	}
+}
+
+func TestReconciler_Reconcile_finalizer(t *testing.T) {
+	tests := []struct {
+		name          string
+		instance      *v1alpha1.DatadogSLO
+		wantFinalizer bool
+	}{
+		{
+			name: "finalizer added to a new custom resource",
+			instance: func() *v1alpha1.DatadogSLO {
+				slo := testSLO()
+				slo.Finalizers = nil
+				return slo
+			}(),
+			wantFinalizer: true,
+		},
+		{
+			name: "custom resource not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []client.Object
+			if tt.instance != nil {
+				objects = append(objects, tt.instance)
+			}
+			k8sClient := newTestClient(t, objects...)
+			adapter := newTestAdapter()
+			r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, k8sClient, adapter, &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), "", utils.NamespaceTags{})
+
+			key := types.NamespacedName{Namespace: "bar", Name: "foo"}
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+			require.NoError(t, err)
+			if tt.instance == nil {
+				assert.Equal(t, reconcile.Result{}, result)
+				assert.Empty(t, adapter.calls)
+				return
+			}
+
+			got := &v1alpha1.DatadogSLO{}
+			require.NoError(t, k8sClient.Get(context.TODO(), key, got))
+			assert.Equal(t, tt.wantFinalizer, slices.Contains(got.Finalizers, testFinalizer))
+		})
+	}
+}
+
+func TestReconciler_Reconcile_credentials(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "bar",
+			Annotations: map[string]string{v1alpha1.DatadogCredentialsSecretAnnotationKey: "namespace-credentials"},
+		},
+	}
+	credentialsSecret := func(name string, data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: name}, Data: map[string][]byte{}}
+		for key, value := range data {
+			secret.Data[key] = []byte(value)
+		}
+		return secret
+	}
+	namespaceSecret := credentialsSecret("namespace-credentials", map[string]string{"api_key": "namespace-api-key", "app_key": "namespace-app-key"})
+	objectSecret := credentialsSecret("object-credentials", map[string]string{"api_key": "object-api-key", "app_key": "object-app-key", "site": "datadoghq.eu"})
+	withCredentialsRef := func(secretName string) *v1alpha1.DatadogSLO {
+		slo := testSLO()
+		slo.Spec.CredentialsRef = &v1alpha1.DatadogCredentialsReference{SecretName: secretName}
+		return slo
+	}
+
+	tests := []struct {
+		name       string
+		instance   *v1alpha1.DatadogSLO
+		objects    []client.Object
+		wantResult reconcile.Result
+		wantAPIKey string
+		wantErr    string
+	}{
+		{
+			name:       "operator credentials",
+			instance:   testSLO(),
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+		},
+		{
+			name:       "namespace credentials",
+			instance:   testSLO(),
+			objects:    []client.Object{namespace, namespaceSecret},
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantAPIKey: "namespace-api-key",
+		},
+		{
+			name:       "object credentials take precedence",
+			instance:   withCredentialsRef("object-credentials"),
+			objects:    []client.Object{namespace, namespaceSecret, objectSecret},
+			wantResult: reconcile.Result{RequeueAfter: DefaultRequeuePeriod},
+			wantAPIKey: "object-api-key",
+		},
+		{
+			name:       "missing Secret",
+			instance:   withCredentialsRef("object-credentials"),
+			objects:    []client.Object{namespace, namespaceSecret},
+			wantResult: reconcile.Result{RequeueAfter: DefaultErrRequeuePeriod},
+			wantErr:    "error getting Datadog credentials Secret object-credentials",
+		},
+		{
+			name:       "invalid credentials",
+			instance:   withCredentialsRef("object-credentials"),
+			objects:    []client.Object{credentialsSecret("object-credentials", map[string]string{"api_key": "object-api-key"})},
+			wantResult: reconcile.Result{RequeueAfter: DefaultErrRequeuePeriod},
+			wantErr:    "invalid Datadog credentials in Secret object-credentials",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The Secrets and namespaces are only read from the API reader, not the cached client
+			k8sClient := newTestClient(t, tt.instance)
+			apiReader := newTestClient(t, tt.objects...)
+			adapter := newTestAdapter()
+			r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, apiReader, adapter, &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), "", utils.NamespaceTags{})
+
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tt.instance)})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+
+			got := &v1alpha1.DatadogSLO{}
+			require.NoError(t, k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(tt.instance), got))
+			if tt.wantErr != "" {
+				assert.Empty(t, adapter.calls)
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusCredentialsError, got.Status.SyncStatus)
+				errCondition := meta.FindStatusCondition(got.Status.Conditions, string(condition.DatadogConditionTypeError))
+				require.NotNil(t, errCondition)
+				assert.Equal(t, "AuthenticatingSLO", errCondition.Reason)
+				assert.Contains(t, errCondition.Message, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, "101", got.Status.ID)
+			if tt.wantAPIKey == "" {
+				assert.Nil(t, adapter.auth)
+				return
+			}
+			require.NotNil(t, adapter.auth)
+			keys := adapter.auth.Value(datadogapi.ContextAPIKeys).(map[string]datadogapi.APIKey)
+			assert.Equal(t, tt.wantAPIKey, keys["apiKeyAuth"].Key)
+		})
+	}
+}
+
+func TestReconciler_Reconcile_namespaceTags(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "bar",
+			Labels:      map[string]string{"team": "payments"},
+			Annotations: map[string]string{"example.com/service": "checkout"},
+		},
+	}
+	namespaceTags := utils.NamespaceTags{
+		LabelsAsTags:      map[string]string{"team": "team"},
+		AnnotationsAsTags: map[string]string{"example.com/service": "service"},
+	}
+
+	tests := []struct {
+		name          string
+		tags          []string
+		objects       []client.Object
+		namespaceTags utils.NamespaceTags
+		wantTags      []string
+	}{
+		{
+			name:          "namespace tags added",
+			tags:          utils.GetRequiredTags(),
+			objects:       []client.Object{namespace},
+			namespaceTags: namespaceTags,
+			wantTags:      []string{"generated:kubernetes", "service:checkout", "team:payments"},
+		},
+		{
+			name:          "tags set in the spec take precedence",
+			tags:          []string{"generated:kubernetes", "team:billing"},
+			objects:       []client.Object{namespace},
+			namespaceTags: namespaceTags,
+			wantTags:      []string{"generated:kubernetes", "team:billing", "service:checkout"},
+		},
+		{
+			name:          "namespace not found",
+			tags:          utils.GetRequiredTags(),
+			namespaceTags: namespaceTags,
+			wantTags:      []string{"generated:kubernetes"},
+		},
+		{
+			name:     "no mapping",
+			tags:     utils.GetRequiredTags(),
+			objects:  []client.Object{namespace},
+			wantTags: []string{"generated:kubernetes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := testSLO()
+			instance.Spec.Tags = tt.tags
+			k8sClient := newTestClient(t, append(tt.objects, instance)...)
+			adapter := newTestAdapter()
+			r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, k8sClient, adapter, &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), "", tt.namespaceTags)
+
+			_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(instance)})
+			require.NoError(t, err)
+
+			got := &v1alpha1.DatadogSLO{}
+			require.NoError(t, k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(instance), got))
+			// The namespace tags are only added to the Datadog resource
+			assert.Equal(t, tt.tags, got.Spec.Tags)
+			require.NotEmpty(t, got.Status.ID)
+			assert.Equal(t, tt.wantTags, adapter.resources[got.Status.ID].Tags)
+		})
+	}
+}
+
+func TestReconciler_Reconcile_namespaceTagsError(t *testing.T) {
+	instance := testSLO()
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	k8sClient := fake.NewClientBuilder().WithScheme(s).WithStatusSubresource(&v1alpha1.DatadogSLO{}).WithObjects(instance).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*corev1.Namespace); ok {
+					return errors.New("namespaces is forbidden")
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).Build()
+	adapter := newTestAdapter()
+	// The credentials of the namespace are read from the API reader, which isn't failing
+	r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, newTestClient(t), adapter, &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), "", utils.NamespaceTags{LabelsAsTags: map[string]string{"team": "team"}})
+
+	result, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(instance)})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultErrRequeuePeriod, result.RequeueAfter)
+	assert.Zero(t, adapter.calls[createOperation])
+
+	// The error is reported in the status
+	got := &v1alpha1.DatadogSLO{}
+	require.NoError(t, k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(instance), got))
+	assert.Equal(t, v1alpha1.DatadogSLOSyncStatusUpdateError, got.Status.SyncStatus)
+	errCondition := meta.FindStatusCondition(got.Status.Conditions, string(condition.DatadogConditionTypeError))
+	require.NotNil(t, errCondition)
+	assert.Equal(t, metav1.ConditionTrue, errCondition.Status)
+	assert.Equal(t, "GettingNamespaceTags", errCondition.Reason)
+}
+
+func TestReconciler_Reconcile_namespaceTagsChanged(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Labels: map[string]string{"team": "payments"}},
+	}
+	instance := testSLO()
+	k8sClient := newTestClient(t, namespace, instance)
+	adapter := newTestAdapter()
+	r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, k8sClient, adapter, &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), "", utils.NamespaceTags{LabelsAsTags: map[string]string{"team": "team"}})
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(instance)}
+
+	_, err := r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	got := &v1alpha1.DatadogSLO{}
+	require.NoError(t, k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(instance), got))
+	assert.Equal(t, []string{"generated:kubernetes", "team:payments"}, adapter.resources[got.Status.ID].Tags)
+
+	// The Datadog resource follows the labels of the namespace
+	namespace.Labels["team"] = "billing"
+	require.NoError(t, k8sClient.Update(context.TODO(), namespace))
+	_, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, adapter.calls[updateOperation])
+	assert.Equal(t, []string{"generated:kubernetes", "team:billing"}, adapter.resources[got.Status.ID].Tags)
+}
+
+func TestReconciler_enqueueNamespaceObjects(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "bar"}}
+	other := testSLO()
+	other.Namespace = "other"
+	k8sClient := newTestClient(t, namespace, testSLO(), other)
+	r := NewReconciler[*v1alpha1.DatadogSLO, testResource](k8sClient, k8sClient, newTestAdapter(), &version.Info{}, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10), "", utils.NamespaceTags{})
+
+	requests := r.enqueueNamespaceObjects(context.TODO(), namespace)
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "bar", Name: "foo"}}}, requests)
+}
+
+func Test_filterTagKeys(t *testing.T) {
+	tags := []string{"generated:kubernetes", "service:checkout", "team:payments"}
+	assert.Equal(t, []string{"team:payments"}, filterTagKeys(tags, []string{"team"}))
+	assert.Equal(t, []string{}, filterTagKeys(tags, nil))
+}
+
+func Test_errRequeueResult(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want reconcile.Result
+	}{
+		{
+			name: "error",
+			err:  errors.New("error getting test"),
+			want: reconcile.Result{RequeueAfter: DefaultErrRequeuePeriod},
+		},
+		{
+			name: "rate limit reset soon",
+			err:  fmt.Errorf("error getting test: %w", &datadogclient.RateLimitError{RetryAfter: time.Second}),
+			want: reconcile.Result{RequeueAfter: DefaultErrRequeuePeriod},
+		},
+		{
+			name: "rate limit reset later",
+			err:  fmt.Errorf("error getting test: %w", &datadogclient.RateLimitError{RetryAfter: 5 * time.Minute}),
+			want: reconcile.Result{RequeueAfter: 5 * time.Minute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errRequeueResult(tt.err))
+		})
+	}
+}
+
+func newTestClient(t *testing.T, objects ...client.Object) client.Client {
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	require.NoError(t, corev1.AddToScheme(s))
+	return fake.NewClientBuilder().WithScheme(s).WithStatusSubresource(&v1alpha1.DatadogSLO{}).WithObjects(objects...).Build()
+}
@@ -0,0 +1,134 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package apiresource
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+// Step is a step of the sync of a custom resource with Datadog.
+type Step string
+
+const (
+	// StepValidate validates the spec of the custom resource.
+	StepValidate Step = "Validating"
+	// StepAuthenticate loads the Datadog credentials of the custom resource.
+	StepAuthenticate Step = "Authenticating"
+	// StepResolve resolves the references of the custom resource to other custom resources.
+	StepResolve Step = "Resolving"
+	// StepCreate creates the Datadog resource.
+	StepCreate Step = "Creating"
+	// StepGet gets the Datadog resource.
+	StepGet Step = "Getting"
+	// StepUpdate updates the Datadog resource.
+	StepUpdate Step = "Updating"
+)
+
+// Status gives the Reconciler access to the status of a custom resource.
+type Status interface {
+	// GetID returns the ID of the Datadog resource, empty if it isn't created yet.
+	GetID() string
+	// SetID sets the ID of the Datadog resource.
+	SetID(id string)
+	// GetCurrentHash returns the hash of the spec synced with Datadog.
+	GetCurrentHash() string
+	// SetCurrentHash sets the hash of the spec synced with Datadog.
+	SetCurrentHash(hash string)
+	// GetLastForceSyncTime returns the last time the Datadog resource was synced with the spec.
+	GetLastForceSyncTime() *metav1.Time
+	// SetLastForceSyncTime sets the last time the Datadog resource was synced with the spec.
+	SetLastForceSyncTime(syncTime *metav1.Time)
+	// SetCreated sets the creator and creation time of the Datadog resource.
+	SetCreated(creator string, created *metav1.Time)
+	// SetSynced sets the sync status to OK.
+	SetSynced(now metav1.Time)
+	// SetSyncError sets the sync status to the error of the failed step.
+	SetSyncError(step Step, reason string, err error, now metav1.Time)
+	// SetCondition sets a condition of the custom resource.
+	SetCondition(conditionType condition.Type, conditionStatus metav1.ConditionStatus, reason, message string, now metav1.Time)
+	// GetCondition returns the status and message of a condition of the custom resource, and false if it isn't set.
+	GetCondition(conditionType condition.Type) (metav1.ConditionStatus, string, bool)
+}
+
+// StatusFields implements Status for the custom resources with the common status fields, by pointing to them.
+type StatusFields[S ~string] struct {
+	Conditions        *[]metav1.Condition
+	ID                *string
+	Creator           *string // Optional
+	Created           **metav1.Time
+	SyncStatus        *S
+	LastForceSyncTime **metav1.Time
+	CurrentHash       *string
+
+	// SyncStatusOK is the sync status of a custom resource in sync with Datadog.
+	SyncStatusOK S
+	// SyncStatusErrors are the sync statuses of a custom resource whose sync failed, by step.
+	SyncStatusErrors map[Step]S
+}
+
+var _ Status = (*StatusFields[string])(nil)
+
+func (s *StatusFields[S]) GetID() string {
+	return *s.ID
+}
+
+func (s *StatusFields[S]) SetID(id string) {
+	*s.ID = id
+}
+
+func (s *StatusFields[S]) GetCurrentHash() string {
+	return *s.CurrentHash
+}
+
+func (s *StatusFields[S]) SetCurrentHash(hash string) {
+	*s.CurrentHash = hash
+}
+
+func (s *StatusFields[S]) GetLastForceSyncTime() *metav1.Time {
+	return *s.LastForceSyncTime
+}
+
+func (s *StatusFields[S]) SetLastForceSyncTime(syncTime *metav1.Time) {
+	*s.LastForceSyncTime = syncTime
+}
+
+func (s *StatusFields[S]) SetCreated(creator string, created *metav1.Time) {
+	if s.Creator != nil {
+		*s.Creator = creator
+	}
+	*s.Created = created
+}
+
+// SetSynced sets the sync status to OK, and the Error condition to false if it was set.
+func (s *StatusFields[S]) SetSynced(now metav1.Time) {
+	*s.SyncStatus = s.SyncStatusOK
+	if meta.IsStatusConditionTrue(*s.Conditions, string(condition.DatadogConditionTypeError)) {
+		condition.UpdateStatusConditions(s.Conditions, now, condition.DatadogConditionTypeError, metav1.ConditionFalse, "Synced", "")
+	}
+}
+
+// SetSyncError sets the sync status of the failed step, and the Error condition.
+func (s *StatusFields[S]) SetSyncError(step Step, reason string, err error, now metav1.Time) {
+	condition.UpdateFailureStatusConditions(s.Conditions, now, condition.DatadogConditionTypeError, reason, err)
+	if syncStatus, found := s.SyncStatusErrors[step]; found {
+		*s.SyncStatus = syncStatus
+	}
+}
+
+func (s *StatusFields[S]) SetCondition(conditionType condition.Type, conditionStatus metav1.ConditionStatus, reason, message string, now metav1.Time) {
+	condition.UpdateStatusConditions(s.Conditions, now, conditionType, conditionStatus, reason, message)
+}
+
+func (s *StatusFields[S]) GetCondition(conditionType condition.Type) (metav1.ConditionStatus, string, bool) {
+	c := meta.FindStatusCondition(*s.Conditions, string(conditionType))
+	if c == nil {
+		return "", "", false
+	}
+	return c.Status, c.Message, true
+}
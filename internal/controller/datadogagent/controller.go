@@ -106,12 +106,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return resp, err
 }
 
-func reconcilerOptionsToFeatureOptions(opts *ReconcilerOptions, logger logr.Logger) *feature.Options {
+func reconcilerOptionsToFeatureOptions(opts *ReconcilerOptions, distribution kubernetes.Distribution, logger logr.Logger) *feature.Options {
 	return &feature.Options{
 		SupportExtendedDaemonset:        opts.ExtendedDaemonsetOptions.Enabled,
 		Logger:                          logger,
 		ProcessChecksInCoreAgentEnabled: opts.ProcessChecksInCoreAgentEnabled,
 		OtelAgentEnabled:                opts.OtelAgentEnabled,
+		Distribution:                    distribution,
 	}
 }
 
@@ -70,8 +70,18 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 			}
 		}
 
+		if datadoghqv2alpha1.IsGKEAutopilotEnabled(dda) {
+			override.ApplyAutopilotConstraints(logger, podManagers)
+		}
+
 		// If Override is defined for the node agent component, apply the override on the PodTemplateSpec, it will cascade to container.
 		var componentOverrides []*datadoghqv2alpha1.DatadogAgentComponentOverride
+		if r.options.IntrospectionEnabled {
+			// Apply provider defaults first, so they can be overridden by the manifest and the profiles.
+			if overrideFromProviderDefaults := kubernetes.DefaultComponentOverrideFromProvider(provider, dda.Spec.Global); overrideFromProviderDefaults != nil {
+				componentOverrides = append(componentOverrides, overrideFromProviderDefaults)
+			}
+		}
 		if componentOverride, ok := dda.Spec.Override[datadoghqv2alpha1.NodeAgentComponentName]; ok {
 			componentOverrides = append(componentOverrides, componentOverride)
 		}
@@ -123,6 +133,10 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 			return reconcile.Result{}, nil
 		}
 
+		if err := validateAutopilotPodTemplate(dda, &eds.Spec.Template, newStatus); err != nil {
+			return reconcile.Result{}, err
+		}
+
 		return r.createOrUpdateExtendedDaemonset(daemonsetLogger, dda, eds, newStatus, updateEDSStatusV2WithAgent)
 	}
 
@@ -145,8 +159,18 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 		}
 	}
 
+	if datadoghqv2alpha1.IsGKEAutopilotEnabled(dda) {
+		override.ApplyAutopilotConstraints(logger, podManagers)
+	}
+
 	// If Override is defined for the node agent component, apply the override on the PodTemplateSpec, it will cascade to container.
 	var componentOverrides []*datadoghqv2alpha1.DatadogAgentComponentOverride
+	if r.options.IntrospectionEnabled {
+		// Apply provider defaults first, so they can be overridden by the manifest and the profiles.
+		if overrideFromProviderDefaults := kubernetes.DefaultComponentOverrideFromProvider(provider, dda.Spec.Global); overrideFromProviderDefaults != nil {
+			componentOverrides = append(componentOverrides, overrideFromProviderDefaults)
+		}
+	}
 	if componentOverride, ok := dda.Spec.Override[datadoghqv2alpha1.NodeAgentComponentName]; ok {
 		componentOverrides = append(componentOverrides, componentOverride)
 	}
@@ -199,9 +223,29 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 		return reconcile.Result{}, nil
 	}
 
+	if err := validateAutopilotPodTemplate(dda, &daemonset.Spec.Template, newStatus); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	return r.createOrUpdateDaemonset(daemonsetLogger, dda, daemonset, newStatus, updateDSStatusV2WithAgent, profile)
 }
 
+// validateAutopilotPodTemplate fails the reconcile early when GKE Autopilot wouldn't admit the Agent pods,
+// rather than letting the Operator create a DaemonSet that never gets any pod scheduled.
+func validateAutopilotPodTemplate(dda *datadoghqv2alpha1.DatadogAgent, podTemplate *corev1.PodTemplateSpec, newStatus *datadoghqv2alpha1.DatadogAgentStatus) error {
+	if !datadoghqv2alpha1.IsGKEAutopilotEnabled(dda) {
+		return nil
+	}
+
+	now := metav1.NewTime(time.Now())
+	if err := override.ValidateAutopilotPodTemplate(podTemplate); err != nil {
+		datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(newStatus, now, datadoghqv2alpha1.GKEAutopilotConflictConditionType, metav1.ConditionTrue, "GKEAutopilotConflict", err.Error(), true)
+		return err
+	}
+	datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(newStatus, now, datadoghqv2alpha1.GKEAutopilotConflictConditionType, metav1.ConditionFalse, "GKEAutopilotCompatible", "Agent can be admitted on GKE Autopilot", false)
+	return nil
+}
+
 func updateDSStatusV2WithAgent(ds *appsv1.DaemonSet, newStatus *datadoghqv2alpha1.DatadogAgentStatus, updateTime metav1.Time, status metav1.ConditionStatus, reason, message string) {
 	newStatus.AgentList = datadoghqv2alpha1.UpdateDaemonSetStatus(ds, newStatus.AgentList, &updateTime)
 	datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(newStatus, updateTime, datadoghqv2alpha1.AgentReconcileConditionType, status, reason, message, true)
@@ -335,6 +379,38 @@ func (r *Reconciler) labelNodesWithProfiles(ctx context.Context, profilesByNode
 	return nil
 }
 
+// labelNodesWithContainerRuntime sets the "agent.datadoghq.com/container-runtime" label in
+// the nodes when the cluster uses more than one container runtime, and removes it otherwise.
+// The labels of the nodes in nodeList are updated accordingly.
+func (r *Reconciler) labelNodesWithContainerRuntime(ctx context.Context, nodeList []corev1.Node) error {
+	runtimeByNode := kubernetes.GetContainerRuntimeLabelByNode(nodeList)
+	for i := range nodeList {
+		node := &nodeList[i]
+		runtime := runtimeByNode[node.Name]
+		if node.Labels[kubernetes.RuntimeProviderLabel] == runtime {
+			continue
+		}
+
+		modifiedNode := node.DeepCopy()
+		if runtime == "" {
+			delete(modifiedNode.Labels, kubernetes.RuntimeProviderLabel)
+		} else {
+			if modifiedNode.Labels == nil {
+				modifiedNode.Labels = map[string]string{}
+			}
+			modifiedNode.Labels[kubernetes.RuntimeProviderLabel] = runtime
+		}
+
+		err := r.client.Patch(ctx, modifiedNode, client.MergeFrom(node))
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		node.Labels = modifiedNode.Labels
+	}
+
+	return nil
+}
+
 // cleanupPodsForProfilesThatNoLongerApply deletes the agent pods that should
 // not be running according to the profiles that need to be applied. This is
 // needed because in the affinities we use
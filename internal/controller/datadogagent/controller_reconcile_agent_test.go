@@ -1965,3 +1965,115 @@ func Test_labelNodesWithProfiles(t *testing.T) {
 		})
 	}
 }
+
+func Test_labelNodesWithContainerRuntime(t *testing.T) {
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	ctx := context.Background()
+
+	newNode := func(name, runtimeVersion string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+			Status: corev1.NodeStatus{
+				NodeInfo: corev1.NodeSystemInfo{
+					ContainerRuntimeVersion: runtimeVersion,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		description    string
+		nodes          []*corev1.Node
+		wantNodeLabels map[string]map[string]string
+	}{
+		{
+			name:        "single runtime",
+			description: "nodes should not be labeled",
+			nodes: []*corev1.Node{
+				newNode("node-1", "containerd://1.7.2", map[string]string{"foo": "bar"}),
+				newNode("node-2", "containerd://1.6.0", nil),
+			},
+			wantNodeLabels: map[string]map[string]string{
+				"node-1": {"foo": "bar"},
+				"node-2": nil,
+			},
+		},
+		{
+			name:        "mixed runtimes",
+			description: "nodes with a supported runtime should be labeled",
+			nodes: []*corev1.Node{
+				newNode("node-1", "containerd://1.7.2", map[string]string{"foo": "bar"}),
+				newNode("node-2", "cri-o://1.28.1", nil),
+				newNode("node-3", "foo://1.0.0", nil),
+			},
+			wantNodeLabels: map[string]map[string]string{
+				"node-1": {
+					"foo":                           "bar",
+					kubernetes.RuntimeProviderLabel: kubernetes.ContainerdRuntimeType,
+				},
+				"node-2": {
+					kubernetes.RuntimeProviderLabel: kubernetes.CRIORuntimeType,
+				},
+				"node-3": nil,
+			},
+		},
+		{
+			name:        "cluster no longer mixed",
+			description: "runtime label should be removed",
+			nodes: []*corev1.Node{
+				newNode("node-1", "containerd://1.7.2", map[string]string{
+					"foo":                           "bar",
+					kubernetes.RuntimeProviderLabel: kubernetes.ContainerdRuntimeType,
+				}),
+				newNode("node-2", "containerd://1.7.2", map[string]string{
+					"1":                             "1",
+					kubernetes.RuntimeProviderLabel: kubernetes.CRIORuntimeType,
+				}),
+			},
+			wantNodeLabels: map[string]map[string]string{
+				"node-1": {"foo": "bar"},
+				"node-2": {"1": "1"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []client.Object{}
+			nodes := []corev1.Node{}
+			for _, node := range tt.nodes {
+				objects = append(objects, node.DeepCopy())
+				nodes = append(nodes, *node)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(sch).WithObjects(objects...).Build()
+
+			r := &Reconciler{
+				client: fakeClient,
+			}
+
+			err := r.labelNodesWithContainerRuntime(ctx, nodes)
+			assert.NoError(t, err)
+
+			// the node list passed as argument is updated as well
+			for _, node := range nodes {
+				assert.Equal(t, tt.wantNodeLabels[node.Name], node.Labels)
+			}
+
+			nodeList := &corev1.NodeList{}
+			err = fakeClient.List(ctx, nodeList)
+			assert.NoError(t, err)
+			assert.Len(t, nodeList.Items, len(tt.wantNodeLabels))
+
+			for _, node := range nodeList.Items {
+				expectedNodeLabels, ok := tt.wantNodeLabels[node.Name]
+				assert.True(t, ok)
+				assert.Equal(t, expectedNodeLabels, node.Labels)
+			}
+		})
+	}
+}
@@ -90,6 +90,7 @@ func (r *Reconciler) internalReconcileV2(ctx context.Context, request reconcile.
 	// Set default values for GlobalConfig and Features
 	instanceCopy := instance.DeepCopy()
 	datadoghqv2alpha1.DefaultDatadogAgent(instanceCopy)
+	defaultDatadogAgentForDistribution(instanceCopy, r.platformInfo.GetDistribution())
 
 	return r.reconcileInstanceV2(ctx, reqLogger, instanceCopy)
 }
@@ -97,9 +98,11 @@ func (r *Reconciler) internalReconcileV2(ctx context.Context, request reconcile.
 func (r *Reconciler) reconcileInstanceV2(ctx context.Context, logger logr.Logger, instance *datadoghqv2alpha1.DatadogAgent) (reconcile.Result, error) {
 	var result reconcile.Result
 	newStatus := instance.Status.DeepCopy()
+	newStatus.Distribution = string(r.platformInfo.GetDistribution())
 	now := metav1.NewTime(time.Now())
 
-	features, requiredComponents := feature.BuildFeatures(instance, reconcilerOptionsToFeatureOptions(&r.options, logger))
+	features, requiredComponents, disabledFeatures := feature.BuildFeatures(instance, reconcilerOptionsToFeatureOptions(&r.options, r.platformInfo.GetDistribution(), logger))
+	updateDisabledFeaturesConditions(newStatus, now, disabledFeatures)
 	// update list of enabled features for metrics forwarder
 	r.updateMetricsForwardersFeatures(instance, features)
 
@@ -170,6 +173,10 @@ func (r *Reconciler) reconcileInstanceV2(ctx context.Context, logger logr.Logger
 		}
 
 		if r.options.IntrospectionEnabled {
+			// Label nodes with their container runtime first, so nodes using different runtimes get their own provider
+			if e = r.labelNodesWithContainerRuntime(ctx, nodeList); e != nil {
+				return r.updateStatusIfNeededV2(logger, instance, newStatus, result, e, now)
+			}
 			providerList = kubernetes.GetProviderListFromNodeList(nodeList, logger)
 			metrics.IntrospectionEnabled.Set(metrics.TrueValue)
 		}
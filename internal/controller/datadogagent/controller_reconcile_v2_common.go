@@ -8,10 +8,12 @@ package datadogagent
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/feature"
 	"github.com/DataDog/datadog-operator/pkg/agentprofile"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
@@ -406,3 +408,23 @@ func shouldCheckSlowStartStatus(profile *v1alpha1.DatadogAgentProfile) bool {
 
 	return profile.Status.SlowStart.Status != v1alpha1.CompletedStatus
 }
+
+// updateDisabledFeaturesConditions reports a condition for each feature disabled by the Operator,
+// and resets the conditions of the features that aren't disabled anymore.
+func updateDisabledFeaturesConditions(status *datadoghqv2alpha1.DatadogAgentStatus, now metav1.Time, disabledFeatures []feature.DisabledFeature) {
+	disabledConditionTypes := make(map[string]struct{}, len(disabledFeatures))
+	for _, disabled := range disabledFeatures {
+		conditionType := datadoghqv2alpha1.FeatureDisabledConditionTypePrefix + string(disabled.ID)
+		disabledConditionTypes[conditionType] = struct{}{}
+		datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(status, now, conditionType, metav1.ConditionTrue, "IncompatibleFeature", disabled.Reason, true)
+	}
+
+	for _, condition := range status.Conditions {
+		if !strings.HasPrefix(condition.Type, datadoghqv2alpha1.FeatureDisabledConditionTypePrefix) {
+			continue
+		}
+		if _, found := disabledConditionTypes[condition.Type]; !found {
+			datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(status, now, condition.Type, metav1.ConditionFalse, "CompatibleFeature", "Feature is enabled", false)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/DataDog/datadog-operator/api/datadoghq/common"
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/feature"
 
 	assert "github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -221,3 +223,27 @@ func Test_shouldCheckSlowStartStatus(t *testing.T) {
 		})
 	}
 }
+
+func Test_updateDisabledFeaturesConditions(t *testing.T) {
+	now := metav1.Now()
+	status := &v2alpha1.DatadogAgentStatus{}
+
+	updateDisabledFeaturesConditions(status, now, []feature.DisabledFeature{
+		{ID: feature.NPMIDType, Reason: "requires the system-probe container, which isn't allowed on GKE Autopilot"},
+		{ID: feature.CWSIDType, Reason: "requires the security-agent container, which isn't allowed on GKE Autopilot"},
+	})
+	assert.Len(t, status.Conditions, 2)
+	assert.Equal(t, "FeatureDisabled-npm", status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, status.Conditions[0].Status)
+	assert.Equal(t, "requires the system-probe container, which isn't allowed on GKE Autopilot", status.Conditions[0].Message)
+	assert.Equal(t, "FeatureDisabled-cws", status.Conditions[1].Type)
+	assert.Equal(t, metav1.ConditionTrue, status.Conditions[1].Status)
+
+	// CWS isn't disabled anymore
+	updateDisabledFeaturesConditions(status, now, []feature.DisabledFeature{
+		{ID: feature.NPMIDType, Reason: "requires the system-probe container, which isn't allowed on GKE Autopilot"},
+	})
+	assert.Len(t, status.Conditions, 2)
+	assert.Equal(t, metav1.ConditionTrue, status.Conditions[0].Status)
+	assert.Equal(t, metav1.ConditionFalse, status.Conditions[1].Status)
+}
@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	commonv1 "github.com/DataDog/datadog-operator/api/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+// defaultDatadogAgentForDistribution sets the default values that depend on the Kubernetes distribution.
+// It must be called after v2alpha1.DefaultDatadogAgent, and never overrides a value set by the user.
+func defaultDatadogAgentForDistribution(dda *v2alpha1.DatadogAgent, distribution kubernetes.Distribution) {
+	if dda.Spec.Global == nil {
+		dda.Spec.Global = &v2alpha1.GlobalConfig{}
+	}
+
+	switch distribution {
+	case kubernetes.AKSDistribution:
+		// AKS kubelets serve a self-signed certificate that isn't signed by the cluster CA
+		if dda.Spec.Global.Kubelet == nil {
+			dda.Spec.Global.Kubelet = &commonv1.KubeletConfig{}
+		}
+		if dda.Spec.Global.Kubelet.TLSVerify == nil {
+			dda.Spec.Global.Kubelet.TLSVerify = apiutils.NewBoolPointer(false)
+		}
+	case kubernetes.GKEAutopilotDistribution:
+		if dda.Spec.Global.GKEAutopilot == nil {
+			dda.Spec.Global.GKEAutopilot = apiutils.NewBoolPointer(true)
+		}
+	}
+}
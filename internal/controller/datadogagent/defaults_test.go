@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonv1 "github.com/DataDog/datadog-operator/api/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+func Test_defaultDatadogAgentForDistribution(t *testing.T) {
+	tests := []struct {
+		name          string
+		global        *v2alpha1.GlobalConfig
+		distribution  kubernetes.Distribution
+		wantKubelet   *commonv1.KubeletConfig
+		wantAutopilot *bool
+	}{
+		{
+			name:         "unknown distribution",
+			global:       &v2alpha1.GlobalConfig{},
+			distribution: kubernetes.UnknownDistribution,
+			wantKubelet:  nil,
+		},
+		{
+			name:         "aks disables kubelet tls verification",
+			global:       &v2alpha1.GlobalConfig{},
+			distribution: kubernetes.AKSDistribution,
+			wantKubelet:  &commonv1.KubeletConfig{TLSVerify: apiutils.NewBoolPointer(false)},
+		},
+		{
+			name: "aks keeps user kubelet tls verification",
+			global: &v2alpha1.GlobalConfig{
+				Kubelet: &commonv1.KubeletConfig{TLSVerify: apiutils.NewBoolPointer(true)},
+			},
+			distribution: kubernetes.AKSDistribution,
+			wantKubelet:  &commonv1.KubeletConfig{TLSVerify: apiutils.NewBoolPointer(true)},
+		},
+		{
+			name:          "gke autopilot enables the autopilot mode",
+			global:        &v2alpha1.GlobalConfig{},
+			distribution:  kubernetes.GKEAutopilotDistribution,
+			wantAutopilot: apiutils.NewBoolPointer(true),
+		},
+		{
+			name:          "gke autopilot keeps user autopilot mode",
+			global:        &v2alpha1.GlobalConfig{GKEAutopilot: apiutils.NewBoolPointer(false)},
+			distribution:  kubernetes.GKEAutopilotDistribution,
+			wantAutopilot: apiutils.NewBoolPointer(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dda := &v2alpha1.DatadogAgent{Spec: v2alpha1.DatadogAgentSpec{Global: tt.global}}
+			defaultDatadogAgentForDistribution(dda, tt.distribution)
+			assert.Equal(t, tt.wantKubelet, dda.Spec.Global.Kubelet)
+			assert.Equal(t, tt.wantAutopilot, dda.Spec.Global.GKEAutopilot)
+		})
+	}
+}
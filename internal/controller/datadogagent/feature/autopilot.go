@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package feature
+
+import (
+	"fmt"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+)
+
+// autopilotIncompatibleContainers are the Agent containers that GKE Autopilot doesn't admit,
+// as they need privileged access to the host (eBPF, kernel security modules).
+var autopilotIncompatibleContainers = []common.AgentContainerName{
+	common.SystemProbeContainerName,
+	common.SecurityAgentContainerName,
+}
+
+// autopilotIncompatibilityReason returns why a feature can't run on GKE Autopilot,
+// or an empty string if it can or if the GKE Autopilot mode is disabled.
+func autopilotIncompatibilityReason(dda *v2alpha1.DatadogAgent, reqComponents *RequiredComponents) string {
+	if !v2alpha1.IsGKEAutopilotEnabled(dda) {
+		return ""
+	}
+	for _, container := range reqComponents.Agent.Containers {
+		for _, incompatible := range autopilotIncompatibleContainers {
+			if container == incompatible {
+				return fmt.Sprintf("requires the %s container, which isn't allowed on GKE Autopilot", container)
+			}
+		}
+	}
+	return ""
+}
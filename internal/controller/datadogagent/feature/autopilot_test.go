@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package feature
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+)
+
+func Test_autopilotIncompatibilityReason(t *testing.T) {
+	autopilotDDA := &v2alpha1.DatadogAgent{
+		Spec: v2alpha1.DatadogAgentSpec{
+			Global: &v2alpha1.GlobalConfig{GKEAutopilot: apiutils.NewBoolPointer(true)},
+		},
+	}
+	systemProbeComponents := RequiredComponents{
+		Agent: RequiredComponent{
+			IsRequired: apiutils.NewBoolPointer(true),
+			Containers: []common.AgentContainerName{common.CoreAgentContainerName, common.SystemProbeContainerName},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		dda           *v2alpha1.DatadogAgent
+		reqComponents RequiredComponents
+		wantReason    string
+	}{
+		{
+			name:          "autopilot disabled",
+			dda:           &v2alpha1.DatadogAgent{},
+			reqComponents: systemProbeComponents,
+			wantReason:    "",
+		},
+		{
+			name: "autopilot, compatible feature",
+			dda:  autopilotDDA,
+			reqComponents: RequiredComponents{
+				Agent: RequiredComponent{
+					IsRequired: apiutils.NewBoolPointer(true),
+					Containers: []common.AgentContainerName{common.CoreAgentContainerName, common.TraceAgentContainerName},
+				},
+			},
+			wantReason: "",
+		},
+		{
+			name:          "autopilot, system-probe feature",
+			dda:           autopilotDDA,
+			reqComponents: systemProbeComponents,
+			wantReason:    "requires the system-probe container, which isn't allowed on GKE Autopilot",
+		},
+		{
+			name: "autopilot, security-agent feature",
+			dda:  autopilotDDA,
+			reqComponents: RequiredComponents{
+				Agent: RequiredComponent{
+					IsRequired: apiutils.NewBoolPointer(true),
+					Containers: []common.AgentContainerName{common.CoreAgentContainerName, common.SecurityAgentContainerName},
+				},
+			},
+			wantReason: "requires the security-agent container, which isn't allowed on GKE Autopilot",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantReason, autopilotIncompatibilityReason(tt.dda, &tt.reqComponents))
+		})
+	}
+}
@@ -52,6 +52,7 @@ func buildDefaultFeature(options *feature.Options) feature.Feature {
 	if options != nil {
 		dF.logger = options.Logger
 		dF.otelAgentEnabled = options.OtelAgentEnabled
+		dF.distribution = options.Distribution
 	}
 
 	return dF
@@ -68,6 +69,7 @@ type defaultFeature struct {
 	logger                  logr.Logger
 	disableNonResourceRules bool
 	otelAgentEnabled        bool
+	distribution            kubernetes.Distribution
 
 	customConfigAnnotationKey   string
 	customConfigAnnotationValue string
@@ -279,6 +281,15 @@ func (f *defaultFeature) agentDependencies(managers feature.ResourceManagers, re
 		errs = append(errs, err)
 	}
 
+	// OpenShift SCCs
+	if f.distribution == kubernetes.OpenShiftDistribution {
+		for _, scc := range getAgentSecurityContextConstraints(requiredComponent.IsPrivileged()) {
+			if err := managers.RBACManager().AddClusterRoleBinding(f.owner.GetNamespace(), getSecurityContextConstraintsClusterRoleBindingName(f.owner, scc), f.agent.serviceAccountName, getSecurityContextConstraintsRoleRef(scc)); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	// Create a configmap for the default seccomp profile in the System Probe.
 	// This is mounted in the init-volume container in the agent default code.
 	for _, containerName := range requiredComponent.Containers {
@@ -10,6 +10,7 @@ import (
 
 	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
 	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/common"
+	componentagent "github.com/DataDog/datadog-operator/internal/controller/datadogagent/component/agent"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils"
 	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
 
@@ -34,6 +35,31 @@ func getDefaultAgentClusterRolePolicyRules(excludeNonResourceRules bool) []rbacv
 	return policyRule
 }
 
+// getAgentSecurityContextConstraints returns the OpenShift SCCs the Agent needs to run with host access.
+// The privileged SCC is only needed when the Agent runs privileged containers (System Probe or Security Agent).
+func getAgentSecurityContextConstraints(privileged bool) []string {
+	sccs := []string{rbac.HostAccessSCC}
+	if privileged {
+		sccs = append(sccs, rbac.PrivilegedSCC)
+	}
+	return sccs
+}
+
+// getSecurityContextConstraintsClusterRoleBindingName returns the name of the binding of the Agent to an OpenShift SCC
+func getSecurityContextConstraintsClusterRoleBindingName(dda metav1.Object, scc string) string {
+	return fmt.Sprintf("%s-scc-%s", componentagent.GetAgentRoleName(dda), scc)
+}
+
+// getSecurityContextConstraintsRoleRef returns the ClusterRole OpenShift provides to use an SCC,
+// so the operator only needs to bind it and never uses the SCC itself
+func getSecurityContextConstraintsRoleRef(scc string) rbacv1.RoleRef {
+	return rbacv1.RoleRef{
+		APIGroup: rbac.RbacAPIGroup,
+		Kind:     rbac.ClusterRoleKind,
+		Name:     rbac.OpenShiftSCCClusterRolePrefix + scc,
+	}
+}
+
 func getMetricsEndpointPolicyRule() rbacv1.PolicyRule {
 	return rbacv1.PolicyRule{
 		NonResourceURLs: []string{
@@ -44,6 +70,21 @@ func getMetricsEndpointPolicyRule() rbacv1.PolicyRule {
 	}
 }
 
+// getSecurityContextConstraintsPolicyRule allows the Agent to run with the host access it needs on OpenShift.
+// The privileged SCC is only allowed when the Agent runs privileged containers (System Probe or Security Agent).
+func getSecurityContextConstraintsPolicyRule(privileged bool) rbacv1.PolicyRule {
+	sccs := []string{rbac.HostAccessSCC}
+	if privileged {
+		sccs = append(sccs, rbac.PrivilegedSCC)
+	}
+	return rbacv1.PolicyRule{
+		APIGroups:     []string{rbac.OpenShiftSecurityAPIGroup},
+		Resources:     []string{rbac.SecurityContextConstraintsResource},
+		ResourceNames: sccs,
+		Verbs:         []string{rbac.UseVerb},
+	}
+}
+
 func getKubeletPolicyRule() rbacv1.PolicyRule {
 	return rbacv1.PolicyRule{
 		APIGroups: []string{rbac.CoreAPIGroup},
@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package enabledefault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apicommonv1 "github.com/DataDog/datadog-operator/api/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/dependencies"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
+)
+
+func Test_agentDependencies_securityContextConstraints(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	testScheme.AddKnownTypes(v2alpha1.GroupVersion, &v2alpha1.DatadogAgent{})
+	owner := &v2alpha1.DatadogAgent{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}}
+
+	tests := []struct {
+		name         string
+		distribution kubernetes.Distribution
+		containers   []apicommonv1.AgentContainerName
+		wantSCCs     []string
+	}{
+		{
+			name:         "not on OpenShift",
+			distribution: kubernetes.EKSDistribution,
+			containers:   []apicommonv1.AgentContainerName{apicommonv1.CoreAgentContainerName, apicommonv1.SystemProbeContainerName},
+			wantSCCs:     nil,
+		},
+		{
+			name:         "OpenShift, unprivileged Agent",
+			distribution: kubernetes.OpenShiftDistribution,
+			containers:   []apicommonv1.AgentContainerName{apicommonv1.CoreAgentContainerName},
+			wantSCCs:     []string{rbac.HostAccessSCC},
+		},
+		{
+			name:         "OpenShift, Agent running System Probe or Security Agent",
+			distribution: kubernetes.OpenShiftDistribution,
+			containers:   []apicommonv1.AgentContainerName{apicommonv1.CoreAgentContainerName, apicommonv1.SystemProbeContainerName},
+			wantSCCs:     []string{rbac.HostAccessSCC, rbac.PrivilegedSCC},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &defaultFeature{
+				owner:        owner,
+				agent:        agentConfig{serviceAccountName: "foo-agent"},
+				distribution: tt.distribution,
+			}
+			store := dependencies.NewStore(owner, &dependencies.StoreOptions{Scheme: testScheme})
+			require.NoError(t, f.agentDependencies(feature.NewResourceManagers(store), feature.RequiredComponent{Containers: tt.containers}))
+
+			var sccs []string
+			for _, scc := range []string{rbac.HostAccessSCC, rbac.PrivilegedSCC} {
+				obj, found := store.Get(kubernetes.ClusterRoleBindingKind, "", "foo-agent-scc-"+scc)
+				if !found {
+					continue
+				}
+				binding := obj.(*rbacv1.ClusterRoleBinding)
+				assert.Equal(t, "system:openshift:scc:"+scc, binding.RoleRef.Name)
+				assert.Equal(t, []rbacv1.Subject{{Kind: rbac.ServiceAccountKind, Name: "foo-agent", Namespace: "bar"}}, binding.Subjects)
+				sccs = append(sccs, scc)
+			}
+			assert.Equal(t, tt.wantSCCs, sccs)
+
+			obj, found := store.Get(kubernetes.ClusterRolesKind, "", "foo-agent")
+			require.True(t, found)
+			for _, rule := range obj.(*rbacv1.ClusterRole).Rules {
+				assert.NotContains(t, rule.Resources, rbac.SecurityContextConstraintsResource)
+			}
+		})
+	}
+}
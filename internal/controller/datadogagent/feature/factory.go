@@ -30,13 +30,21 @@ func Register(id IDType, buildFunc BuildFunc) error {
 	return nil
 }
 
-// BuildFeatures use to build a list features depending of the v2alpha1.DatadogAgent instance
-func BuildFeatures(dda *v2alpha1.DatadogAgent, options *Options) ([]Feature, RequiredComponents) {
+// DisabledFeature is a feature configured in the DatadogAgent but disabled by the Operator
+type DisabledFeature struct {
+	ID     IDType
+	Reason string
+}
+
+// BuildFeatures use to build a list features depending of the v2alpha1.DatadogAgent instance.
+// It also returns the configured features that were disabled because they are incompatible with the platform.
+func BuildFeatures(dda *v2alpha1.DatadogAgent, options *Options) ([]Feature, RequiredComponents, []DisabledFeature) {
 	builderMutex.RLock()
 	defer builderMutex.RUnlock()
 
 	var output []Feature
 	var requiredComponents RequiredComponents
+	var disabled []DisabledFeature
 
 	// to always return in feature in the same order we need to sort the map keys
 	sortedkeys := make([]IDType, 0, len(featureBuilders))
@@ -52,6 +60,13 @@ func BuildFeatures(dda *v2alpha1.DatadogAgent, options *Options) ([]Feature, Req
 		reqComponents := feat.Configure(dda)
 		// only add feature to the output if one of the components is configured (but not necessarily required)
 		if reqComponents.IsConfigured() {
+			if reason := autopilotIncompatibilityReason(dda, &reqComponents); reason != "" {
+				if options != nil {
+					options.Logger.Info("Disabling feature", "featureID", id, "reason", reason)
+				}
+				disabled = append(disabled, DisabledFeature{ID: id, Reason: reason})
+				continue
+			}
 			output = append(output, feat)
 		}
 		requiredComponents.Merge(&reqComponents)
@@ -66,9 +81,9 @@ func BuildFeatures(dda *v2alpha1.DatadogAgent, options *Options) ([]Feature, Req
 		!requiredComponents.Agent.IsPrivileged() {
 
 		requiredComponents.Agent.Containers = []common.AgentContainerName{common.UnprivilegedSingleAgentContainerName}
-		return output, requiredComponents
+		return output, requiredComponents, disabled
 	}
-	return output, requiredComponents
+	return output, requiredComponents, disabled
 }
 
 var (
@@ -149,7 +149,7 @@ func TestBuilder(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, requiredComponents := feature.BuildFeatures(tt.dda, &feature.Options{})
+			_, requiredComponents, _ := feature.BuildFeatures(tt.dda, &feature.Options{})
 
 			assert.True(t, *requiredComponents.Agent.IsRequired)
 
@@ -160,6 +160,30 @@ func TestBuilder(t *testing.T) {
 	}
 }
 
+func TestBuilderGKEAutopilot(t *testing.T) {
+	dda := v2alpha1test.NewDatadogAgentBuilder().
+		WithGKEAutopilot(true).
+		WithAPMEnabled(true).
+		WithNPMEnabled(true).
+		WithCSPMEnabled(true).
+		BuildWithDefaults()
+
+	features, requiredComponents, disabledFeatures := feature.BuildFeatures(dda, &feature.Options{})
+
+	for _, feat := range features {
+		assert.NotContains(t, []feature.IDType{feature.NPMIDType, feature.CSPMIDType}, feat.ID())
+	}
+	assert.Equal(t, []feature.DisabledFeature{
+		{ID: feature.CSPMIDType, Reason: "requires the security-agent container, which isn't allowed on GKE Autopilot"},
+		{ID: feature.NPMIDType, Reason: "requires the system-probe container, which isn't allowed on GKE Autopilot"},
+	}, disabledFeatures)
+
+	assert.True(t, wantAgentContainer(apicommonv1.CoreAgentContainerName, requiredComponents))
+	assert.True(t, wantAgentContainer(apicommonv1.TraceAgentContainerName, requiredComponents))
+	assert.False(t, wantAgentContainer(apicommonv1.SystemProbeContainerName, requiredComponents))
+	assert.False(t, wantAgentContainer(apicommonv1.SecurityAgentContainerName, requiredComponents))
+}
+
 func wantAgentContainer(wantedContainer apicommonv1.AgentContainerName, requiredComponents feature.RequiredComponents) bool {
 	for _, agentContainerName := range requiredComponents.Agent.Containers {
 		if agentContainerName == wantedContainer {
@@ -107,7 +107,7 @@ func runTest(t *testing.T, tt FeatureTest) {
 	}
 	featureOptions.Logger = logger
 	if tt.DDA != nil {
-		features, gotConfigure = feature.BuildFeatures(tt.DDA, featureOptions)
+		features, gotConfigure, _ = feature.BuildFeatures(tt.DDA, featureOptions)
 		dda = tt.DDA
 	} else {
 		t.Fatal("No DatadogAgent CRD provided")
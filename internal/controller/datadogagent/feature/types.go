@@ -12,6 +12,7 @@ import (
 	apiutils "github.com/DataDog/datadog-operator/api/utils"
 	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/dependencies"
 	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/merger"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -154,6 +155,9 @@ type Options struct {
 
 	ProcessChecksInCoreAgentEnabled bool
 	OtelAgentEnabled                bool
+
+	// Distribution is the detected Kubernetes distribution, used to apply distribution-specific defaults
+	Distribution kubernetes.Distribution
 }
 
 // BuildFunc function type used by each Feature during its factory registration.
@@ -69,6 +69,7 @@ func (r *Reconciler) finalizeDadV2(reqLogger logr.Logger, obj client.Object) err
 	// associated with those defaults.
 	dda := obj.(*datadoghqv2alpha1.DatadogAgent).DeepCopy()
 	datadoghqv2alpha1.DefaultDatadogAgent(dda)
+	defaultDatadogAgentForDistribution(dda, r.platformInfo.GetDistribution())
 
 	if r.options.OperatorMetricsEnabled {
 		r.forwarders.Unregister(dda)
@@ -78,8 +79,8 @@ func (r *Reconciler) finalizeDadV2(reqLogger logr.Logger, obj client.Object) err
 	// delete, we figure out its dependencies, store them in the dependencies
 	// store, and then call the DeleteAll function of the store.
 
-	features, requiredComponents := feature.BuildFeatures(
-		dda, reconcilerOptionsToFeatureOptions(&r.options, reqLogger))
+	features, requiredComponents, _ := feature.BuildFeatures(
+		dda, reconcilerOptionsToFeatureOptions(&r.options, r.platformInfo.GetDistribution(), reqLogger))
 
 	storeOptions := &dependencies.StoreOptions{
 		SupportCilium: r.options.SupportCilium,
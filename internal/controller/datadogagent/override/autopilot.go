@@ -0,0 +1,159 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package override
+
+import (
+	"fmt"
+	"strings"
+
+	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/pkg/defaulting"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// autopilotRuntimeDirVolumePath is the only runtime directory that can be mounted on GKE Autopilot
+	autopilotRuntimeDirVolumePath = "/var/run/containerd"
+)
+
+// autopilotAllowedHostPaths are the host paths that GKE Autopilot admits for the Datadog Agent.
+// Sub-paths of an allowed host path are also allowed.
+var autopilotAllowedHostPaths = []string{
+	apicommon.ProcdirHostPath,
+	apicommon.CgroupsHostPath,
+	apicommon.DogstatsdAPMSocketHostPath,
+	autopilotRuntimeDirVolumePath,
+	apicommon.PodLogVolumePath,
+	apicommon.ContainerLogVolumePath,
+	apicommon.SymlinkContainerVolumePath,
+	apicommon.LogTempStoragePath,
+}
+
+// autopilotAllowedImageRegistries are the registries GKE Autopilot admits Datadog images from
+var autopilotAllowedImageRegistries = []string{
+	string(defaulting.GCRContainerRegistry),
+	"eu.gcr.io/datadoghq",
+	"asia.gcr.io/datadoghq",
+}
+
+// ApplyAutopilotConstraints constrains the PodTemplateSpec rendered by the global settings and the features
+// to what GKE Autopilot admits: the runtime socket directory is narrowed to containerd, other host path volumes
+// that aren't allowlisted are removed with their mounts, and privileged settings are dropped.
+// It must be called before the user overrides are applied, as those are validated by ValidateAutopilotPodTemplate.
+func ApplyAutopilotConstraints(logger logr.Logger, manager feature.PodTemplateManagers) {
+	podTemplate := manager.PodTemplateSpec()
+
+	removedVolumes := map[string]struct{}{}
+	volumes := make([]corev1.Volume, 0, len(podTemplate.Spec.Volumes))
+	for _, vol := range podTemplate.Spec.Volumes {
+		if vol.HostPath == nil {
+			volumes = append(volumes, vol)
+			continue
+		}
+		if vol.Name == apicommon.CriSocketVolumeName && vol.HostPath.Path == apicommon.RuntimeDirVolumePath {
+			vol.HostPath.Path = autopilotRuntimeDirVolumePath
+		}
+		if !isAutopilotAllowedHostPath(vol.HostPath.Path) {
+			logger.V(1).Info("Removing volume not allowed on GKE Autopilot", "volume", vol.Name, "hostPath", vol.HostPath.Path)
+			removedVolumes[vol.Name] = struct{}{}
+			continue
+		}
+		volumes = append(volumes, vol)
+	}
+	podTemplate.Spec.Volumes = volumes
+
+	constrainContainer := func(container *corev1.Container) {
+		mounts := make([]corev1.VolumeMount, 0, len(container.VolumeMounts))
+		for _, mount := range container.VolumeMounts {
+			if _, found := removedVolumes[mount.Name]; found {
+				continue
+			}
+			if mount.Name == apicommon.CriSocketVolumeName && mount.MountPath == apicommon.HostCriSocketPathPrefix+apicommon.RuntimeDirVolumePath {
+				mount.MountPath = apicommon.HostCriSocketPathPrefix + autopilotRuntimeDirVolumePath
+			}
+			mounts = append(mounts, mount)
+		}
+		container.VolumeMounts = mounts
+
+		if container.SecurityContext != nil {
+			container.SecurityContext.Privileged = nil
+			container.SecurityContext.AllowPrivilegeEscalation = nil
+			if container.SecurityContext.Capabilities != nil {
+				container.SecurityContext.Capabilities.Add = nil
+			}
+		}
+	}
+	for i := range podTemplate.Spec.InitContainers {
+		constrainContainer(&podTemplate.Spec.InitContainers[i])
+	}
+	for i := range podTemplate.Spec.Containers {
+		constrainContainer(&podTemplate.Spec.Containers[i])
+	}
+}
+
+// ValidateAutopilotPodTemplate returns an error describing every setting of the PodTemplateSpec that GKE Autopilot
+// would refuse to admit, so that the reconcile fails before creating a DaemonSet whose pods can't be scheduled.
+func ValidateAutopilotPodTemplate(podTemplate *corev1.PodTemplateSpec) error {
+	var errs []error
+
+	if podTemplate.Spec.HostNetwork {
+		errs = append(errs, fmt.Errorf("hostNetwork isn't allowed"))
+	}
+
+	for _, vol := range podTemplate.Spec.Volumes {
+		if vol.HostPath != nil && !isAutopilotAllowedHostPath(vol.HostPath.Path) {
+			errs = append(errs, fmt.Errorf("volume %s: host path %s isn't allowed", vol.Name, vol.HostPath.Path))
+		}
+	}
+
+	validateContainer := func(container *corev1.Container) {
+		if !isAutopilotAllowedImage(container.Image) {
+			errs = append(errs, fmt.Errorf("container %s: image %s isn't pulled from an allowed registry (%s)", container.Name, container.Image, strings.Join(autopilotAllowedImageRegistries, ", ")))
+		}
+		if container.SecurityContext == nil {
+			return
+		}
+		if container.SecurityContext.Privileged != nil && *container.SecurityContext.Privileged {
+			errs = append(errs, fmt.Errorf("container %s: privileged mode isn't allowed", container.Name))
+		}
+		if container.SecurityContext.Capabilities != nil && len(container.SecurityContext.Capabilities.Add) > 0 {
+			errs = append(errs, fmt.Errorf("container %s: added capabilities %v aren't allowed", container.Name, container.SecurityContext.Capabilities.Add))
+		}
+	}
+	for i := range podTemplate.Spec.InitContainers {
+		validateContainer(&podTemplate.Spec.InitContainers[i])
+	}
+	for i := range podTemplate.Spec.Containers {
+		validateContainer(&podTemplate.Spec.Containers[i])
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("the Agent can't be admitted on GKE Autopilot: %w", errors.NewAggregate(errs))
+	}
+	return nil
+}
+
+func isAutopilotAllowedHostPath(path string) bool {
+	for _, allowed := range autopilotAllowedHostPaths {
+		if path == allowed || strings.HasPrefix(path, allowed+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func isAutopilotAllowedImage(image string) bool {
+	for _, registry := range autopilotAllowedImageRegistries {
+		if strings.HasPrefix(image, registry+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package override
+
+import (
+	"testing"
+
+	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
+	apicommonv1 "github.com/DataDog/datadog-operator/api/datadoghq/common/v1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/common"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogagent/feature/fake"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_ApplyAutopilotConstraints(t *testing.T) {
+	logger := logf.Log.WithName("Test_ApplyAutopilotConstraints")
+
+	passwdVolume := corev1.Volume{
+		Name: apicommon.PasswdVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: apicommon.PasswdHostPath},
+		},
+	}
+	passwdVolumeMount := corev1.VolumeMount{Name: apicommon.PasswdVolumeName, MountPath: apicommon.PasswdMountPath}
+
+	manager := fake.NewPodTemplateManagers(t, corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				common.GetVolumeForConfig(),
+				common.GetVolumeForProc(),
+				common.GetVolumeForRuntimeSocket(),
+				passwdVolume,
+			},
+			Containers: []corev1.Container{
+				{
+					Name:  string(apicommonv1.CoreAgentContainerName),
+					Image: "gcr.io/datadoghq/agent:7.56.2",
+					VolumeMounts: []corev1.VolumeMount{
+						common.GetVolumeMountForConfig(),
+						common.GetVolumeMountForProc(),
+						common.GetVolumeMountForRuntimeSocket(true),
+						passwdVolumeMount,
+					},
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: apiutils.NewBoolPointer(true),
+						Capabilities: &corev1.Capabilities{
+							Add:  []corev1.Capability{"SYS_ADMIN"},
+							Drop: []corev1.Capability{"ALL"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	ApplyAutopilotConstraints(logger, manager)
+
+	podSpec := manager.PodTemplateSpec().Spec
+	runtimeVolume := common.GetVolumeForRuntimeSocket()
+	runtimeVolume.HostPath.Path = "/var/run/containerd"
+	assert.Equal(t, []corev1.Volume{common.GetVolumeForConfig(), common.GetVolumeForProc(), runtimeVolume}, podSpec.Volumes)
+
+	runtimeVolumeMount := common.GetVolumeMountForRuntimeSocket(true)
+	runtimeVolumeMount.MountPath = "/host/var/run/containerd"
+	assert.Equal(t, []corev1.VolumeMount{common.GetVolumeMountForConfig(), common.GetVolumeMountForProc(), runtimeVolumeMount}, podSpec.Containers[0].VolumeMounts)
+	assert.Equal(t, &corev1.SecurityContext{Capabilities: &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}}, podSpec.Containers[0].SecurityContext)
+
+	assert.NoError(t, ValidateAutopilotPodTemplate(&corev1.PodTemplateSpec{Spec: podSpec}))
+}
+
+func Test_ValidateAutopilotPodTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		podSpec corev1.PodSpec
+		wantErr string
+	}{
+		{
+			name: "compatible",
+			podSpec: corev1.PodSpec{
+				Volumes:    []corev1.Volume{common.GetVolumeForProc(), common.GetVolumeForDogstatsd()},
+				Containers: []corev1.Container{{Name: "agent", Image: "gcr.io/datadoghq/agent:7.56.2"}},
+			},
+		},
+		{
+			name: "host network",
+			podSpec: corev1.PodSpec{
+				HostNetwork: true,
+				Containers:  []corev1.Container{{Name: "agent", Image: "gcr.io/datadoghq/agent:7.56.2"}},
+			},
+			wantErr: "the Agent can't be admitted on GKE Autopilot: hostNetwork isn't allowed",
+		},
+		{
+			name: "host path and image",
+			podSpec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{
+					Name:         "hostroot",
+					VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+				}},
+				Containers: []corev1.Container{{Name: "agent", Image: "docker.io/datadog/agent:7.56.2"}},
+			},
+			wantErr: "the Agent can't be admitted on GKE Autopilot: [volume hostroot: host path / isn't allowed, container agent: image docker.io/datadog/agent:7.56.2 isn't pulled from an allowed registry (gcr.io/datadoghq, eu.gcr.io/datadoghq, asia.gcr.io/datadoghq)]",
+		},
+		{
+			name: "privileged init container",
+			podSpec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{
+					Name:  "init-volume",
+					Image: "gcr.io/datadoghq/agent:7.56.2",
+					SecurityContext: &corev1.SecurityContext{
+						Privileged:   apiutils.NewBoolPointer(true),
+						Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"SYS_ADMIN"}},
+					},
+				}},
+			},
+			wantErr: "the Agent can't be admitted on GKE Autopilot: [container init-volume: privileged mode isn't allowed, container init-volume: added capabilities [SYS_ADMIN] aren't allowed]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAutopilotPodTemplate(&corev1.PodTemplateSpec{Spec: tt.podSpec})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -102,8 +102,11 @@ type DatadogAgentReconciler struct {
 // +kubebuilder:rbac:groups=cilium.io,resources=ciliumnetworkpolicies,verbs=get;list;watch;create;update;patch;delete
 
 // OpenShift
+// The Agent gets the hostaccess and privileged SCCs through bindings to the ClusterRoles OpenShift provides for them:
+// the operator can bind these ClusterRoles without being allowed to use the SCCs itself.
 // +kubebuilder:rbac:groups=quota.openshift.io,resources=clusterresourcequotas,verbs=get;list
 // +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,resourceNames=restricted,verbs=use
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,resourceNames="system:openshift:scc:hostaccess";"system:openshift:scc:privileged",verbs=bind
 
 // +kubebuilder:rbac:urls=/metrics,verbs=get
 // +kubebuilder:rbac:urls=/metrics/slis,verbs=get
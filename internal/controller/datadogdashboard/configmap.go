@@ -0,0 +1,184 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+const (
+	templateLeftDelim  = "[["
+	templateRightDelim = "]]"
+
+	// ConfigMapRefIndexKey is the field index of the DatadogDashboards by the name of the ConfigMap they reference
+	ConfigMapRefIndexKey = "spec.configMapRef.name"
+)
+
+// ConfigMapRefIndexer indexes a DatadogDashboard by the name of the ConfigMap it references, if any.
+func ConfigMapRefIndexer(obj client.Object) []string {
+	dashboard, ok := obj.(*v1alpha1.DatadogDashboard)
+	if !ok || dashboard.Spec.ConfigMapRef == nil {
+		return nil
+	}
+	return []string{dashboard.Spec.ConfigMapRef.Name}
+}
+
+// templateData is the data available in the dashboards loaded from a ConfigMap.
+type templateData struct {
+	ClusterName string
+	Namespace   string
+	Parameters  map[string]string
+}
+
+// withConfigMapDashboard returns the DatadogDashboard to sync with Datadog. If the DatadogDashboard references a
+// ConfigMap, the fields that aren't set in its spec are taken from the dashboard of the ConfigMap, once rendered.
+func (a *adapter) withConfigMapDashboard(ctx context.Context, instance *v1alpha1.DatadogDashboard) (*v1alpha1.DatadogDashboard, error) {
+	ref := instance.Spec.ConfigMapRef
+	if ref == nil {
+		return instance, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := a.configMapReader.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: ref.Name}, configMap); err != nil {
+		return nil, fmt.Errorf("unable to get ConfigMap %s: %w", ref.Name, err)
+	}
+	dashboardJSON, found := configMap.Data[ref.Key]
+	if !found {
+		return nil, fmt.Errorf("key %s not found in ConfigMap %s", ref.Key, ref.Name)
+	}
+
+	dashboard, err := renderDashboard(dashboardJSON, templateData{
+		ClusterName: a.clusterName,
+		Namespace:   instance.Namespace,
+		Parameters:  instance.Spec.Parameters,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to render the dashboard of ConfigMap %s: %w", ref.Name, err)
+	}
+	templateSpec, err := ExportDashboardSpec(dashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := instance.DeepCopy()
+	mergeDashboardSpec(&resolved.Spec, &templateSpec)
+
+	return resolved, nil
+}
+
+// renderDashboard renders every string of an exported dashboard JSON.
+func renderDashboard(dashboardJSON string, data templateData) (datadogV1.Dashboard, error) {
+	var fields interface{}
+	if err := json.Unmarshal([]byte(dashboardJSON), &fields); err != nil {
+		return datadogV1.Dashboard{}, err
+	}
+
+	rendered, err := renderValue(fields, data)
+	if err != nil {
+		return datadogV1.Dashboard{}, err
+	}
+	raw, err := json.Marshal(rendered)
+	if err != nil {
+		return datadogV1.Dashboard{}, err
+	}
+
+	dashboard := datadogV1.Dashboard{}
+	if err = json.Unmarshal(raw, &dashboard); err != nil {
+		return datadogV1.Dashboard{}, err
+	}
+	if dashboard.UnparsedObject != nil {
+		return datadogV1.Dashboard{}, fmt.Errorf("invalid dashboard")
+	}
+
+	return dashboard, nil
+}
+
+func renderValue(value interface{}, data templateData) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return renderString(v, data)
+	case []interface{}:
+		for i := range v {
+			rendered, err := renderValue(v[i], data)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = rendered
+		}
+	case map[string]interface{}:
+		for key := range v {
+			rendered, err := renderValue(v[key], data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			v[key] = rendered
+		}
+	}
+
+	return value, nil
+}
+
+// renderString renders a string of the dashboard, a parameter missing from the DatadogDashboard is an error.
+func renderString(value string, data templateData) (string, error) {
+	if !strings.Contains(value, templateLeftDelim) {
+		return value, nil
+	}
+
+	tpl, err := template.New("").Delims(templateLeftDelim, templateRightDelim).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// mergeDashboardSpec sets the fields that aren't set in a DatadogDashboard spec from the spec of the ConfigMap dashboard.
+// The typed widgets of the spec are added before the widgets of the ConfigMap dashboard.
+func mergeDashboardSpec(spec, templateSpec *v1alpha1.DatadogDashboardSpec) {
+	if spec.Title == "" {
+		spec.Title = templateSpec.Title
+	}
+	if spec.Description == "" {
+		spec.Description = templateSpec.Description
+	}
+	if spec.LayoutType == "" {
+		spec.LayoutType = templateSpec.LayoutType
+	}
+	if spec.ReflowType == nil {
+		spec.ReflowType = templateSpec.ReflowType
+	}
+	if spec.Tags == nil {
+		spec.Tags = templateSpec.Tags
+	}
+	if spec.NotifyList == nil {
+		spec.NotifyList = templateSpec.NotifyList
+	}
+	if spec.TemplateVariables == nil {
+		spec.TemplateVariables = templateSpec.TemplateVariables
+	}
+	if spec.TemplateVariablePresets == nil {
+		spec.TemplateVariablePresets = templateSpec.TemplateVariablePresets
+	}
+	if spec.Widgets == "" {
+		spec.Widgets = templateSpec.Widgets
+	}
+}
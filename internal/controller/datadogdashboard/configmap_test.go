@@ -0,0 +1,193 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+const testConfigMapDashboard = `{
+  "title": "[[ .Parameters.service ]] on [[ .ClusterName ]]",
+  "description": "Namespace [[ .Namespace ]]",
+  "layout_type": "ordered",
+  "tags": ["team:[[ .Parameters.team ]]"],
+  "widgets": [
+    {
+      "definition": {
+        "type": "note",
+        "content": "Requests of [[ .Parameters.service ]]"
+      }
+    }
+  ]
+}`
+
+func Test_renderDashboard(t *testing.T) {
+	data := templateData{
+		ClusterName: "prod",
+		Namespace:   "checkout",
+		Parameters:  map[string]string{"service": "api", "team": "payments"},
+	}
+
+	tests := []struct {
+		name          string
+		dashboardJSON string
+		data          templateData
+		wantTitle     string
+		wantDesc      string
+		wantTags      []string
+		wantNote      string
+		wantErr       string
+	}{
+		{
+			name:          "all strings rendered",
+			dashboardJSON: testConfigMapDashboard,
+			data:          data,
+			wantTitle:     "api on prod",
+			wantDesc:      "Namespace checkout",
+			wantTags:      []string{"team:payments"},
+			wantNote:      "Requests of api",
+		},
+		{
+			name:          "missing parameter",
+			dashboardJSON: testConfigMapDashboard,
+			data:          templateData{ClusterName: "prod", Namespace: "checkout", Parameters: map[string]string{"service": "api"}},
+			wantErr:       "map has no entry for key \"team\"",
+		},
+		{
+			name:          "invalid JSON",
+			dashboardJSON: `{"title": `,
+			data:          data,
+			wantErr:       "unexpected end of JSON input",
+		},
+		{
+			name:          "invalid template",
+			dashboardJSON: `{"title": "[[ .Parameters.service ", "layout_type": "ordered", "widgets": []}`,
+			data:          data,
+			wantErr:       "unclosed action",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dashboard, err := renderDashboard(test.dashboardJSON, test.data)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantTitle, dashboard.GetTitle())
+			assert.Equal(t, test.wantDesc, dashboard.GetDescription())
+			assert.Equal(t, test.wantTags, dashboard.GetTags())
+			require.Len(t, dashboard.GetWidgets(), 1)
+			definition := dashboard.GetWidgets()[0].Definition
+			require.NotNil(t, definition.NoteWidgetDefinition)
+			assert.Equal(t, test.wantNote, definition.NoteWidgetDefinition.GetContent())
+		})
+	}
+}
+
+func Test_mergeDashboardSpec(t *testing.T) {
+	templateSpec := v1alpha1.DatadogDashboardSpec{
+		Title:       "From ConfigMap",
+		Description: "ConfigMap description",
+		LayoutType:  "ordered",
+		Tags:        []string{"team:payments"},
+		Widgets:     `[{"definition":{"type":"note","content":"note"}}]`,
+	}
+
+	spec := v1alpha1.DatadogDashboardSpec{
+		Title: "From spec",
+		Tags:  []string{"team:checkout"},
+	}
+	mergeDashboardSpec(&spec, &templateSpec)
+
+	assert.Equal(t, "From spec", spec.Title)
+	assert.Equal(t, "ConfigMap description", spec.Description)
+	assert.Equal(t, "ordered", string(spec.LayoutType))
+	assert.Equal(t, []string{"team:checkout"}, spec.Tags)
+	assert.Equal(t, templateSpec.Widgets, spec.Widgets)
+}
+
+func TestAdapter_withConfigMapDashboard(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: "dashboards"},
+		Data:       map[string]string{"checkout.json": testConfigMapDashboard},
+	}
+
+	tests := []struct {
+		name      string
+		ref       *v1alpha1.DashboardConfigMapReference
+		wantTitle string
+		wantErr   string
+	}{
+		{
+			name:      "no reference",
+			wantTitle: "",
+		},
+		{
+			name:      "dashboard loaded from ConfigMap",
+			ref:       &v1alpha1.DashboardConfigMapReference{Name: "dashboards", Key: "checkout.json"},
+			wantTitle: "api on prod",
+		},
+		{
+			name:    "ConfigMap not found",
+			ref:     &v1alpha1.DashboardConfigMapReference{Name: "missing", Key: "checkout.json"},
+			wantErr: "unable to get ConfigMap missing",
+		},
+		{
+			name:    "key not found",
+			ref:     &v1alpha1.DashboardConfigMapReference{Name: "dashboards", Key: "missing.json"},
+			wantErr: "key missing.json not found in ConfigMap dashboards",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := &adapter{
+				configMapReader: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build(),
+				clusterName:     "prod",
+			}
+			instance := &v1alpha1.DatadogDashboard{
+				ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: resourcesName},
+				Spec: v1alpha1.DatadogDashboardSpec{
+					ConfigMapRef: test.ref,
+					Parameters:   map[string]string{"service": "api", "team": "payments"},
+				},
+			}
+
+			resolved, err := a.withConfigMapDashboard(context.TODO(), instance)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantTitle, resolved.Spec.Title)
+			// The DatadogDashboard itself is left untouched
+			assert.Empty(t, instance.Spec.Title)
+		})
+	}
+}
+
+func TestConfigMapRefIndexer(t *testing.T) {
+	withRef := &v1alpha1.DatadogDashboard{
+		Spec: v1alpha1.DatadogDashboardSpec{ConfigMapRef: &v1alpha1.DashboardConfigMapReference{Name: "dashboards", Key: "api.json"}},
+	}
+	assert.Equal(t, []string{"dashboards"}, ConfigMapRefIndexer(withRef))
+	assert.Nil(t, ConfigMapRefIndexer(&v1alpha1.DatadogDashboard{}))
+	assert.Nil(t, ConfigMapRefIndexer(&corev1.ConfigMap{}))
+}
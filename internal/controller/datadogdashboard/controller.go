@@ -2,259 +2,201 @@ package datadogdashboard
 
 import (
 	"context"
-	"strings"
-	"time"
+	"fmt"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
-	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
-	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
-	apiequality "k8s.io/apimachinery/pkg/api/equality"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/tools/record"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/apiresource"
 	"github.com/DataDog/datadog-operator/internal/controller/utils"
-	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 )
 
 const (
-	defaultRequeuePeriod    = 60 * time.Second
-	defaultErrRequeuePeriod = 5 * time.Second
-	defaultForceSyncPeriod  = 60 * time.Minute
-	datadogDashboardKind    = "DatadogDashboard"
+	defaultRequeuePeriod      = apiresource.DefaultRequeuePeriod
+	datadogDashboardKind      = "DatadogDashboard"
+	datadogDashboardFinalizer = "finalizer.dashboard.datadoghq.com"
 )
 
-type Reconciler struct {
-	client        client.Client
-	datadogClient *datadogV1.DashboardsApi
-	datadogAuth   context.Context
-	versionInfo   *version.Info
-	scheme        *runtime.Scheme
-	log           logr.Logger
-	recorder      record.EventRecorder
+// Reconciler reconciles DatadogDashboards.
+type Reconciler = apiresource.Reconciler[*v1alpha1.DatadogDashboard, datadogV1.Dashboard]
+
+func NewReconciler(client client.Client, apiReader client.Reader, ddClient datadogclient.DatadogDashboardClient, versionInfo *version.Info, scheme *runtime.Scheme, log logr.Logger, recorder record.EventRecorder, clusterName string, deletionPolicy v1alpha1.DeletionPolicy, namespaceTags utils.NamespaceTags) *Reconciler {
+	return apiresource.NewReconciler(client, apiReader, &adapter{
+		client:          client,
+		configMapReader: apiReader,
+		datadogClient:   ddClient.Client,
+		datadogAuth:     ddClient.Auth,
+		log:             log,
+		clusterName:     clusterName,
+	}, versionInfo, log, recorder, deletionPolicy, namespaceTags)
 }
 
-func NewReconciler(client client.Client, ddClient datadogclient.DatadogDashboardClient, versionInfo *version.Info, scheme *runtime.Scheme, log logr.Logger, recorder record.EventRecorder) *Reconciler {
-	return &Reconciler{
-		client:        client,
-		datadogClient: ddClient.Client,
-		datadogAuth:   ddClient.Auth,
-		versionInfo:   versionInfo,
-		scheme:        scheme,
-		log:           log,
-		recorder:      recorder,
-	}
+// adapter adapts DatadogDashboards to the apiresource.Reconciler.
+type adapter struct {
+	client client.Client
+	// configMapReader reads the referenced ConfigMaps, only their metadata is cached by the controller
+	configMapReader client.Reader
+	datadogClient   *datadogV1.DashboardsApi
+	datadogAuth     context.Context
+	log             logr.Logger
+	clusterName     string
 }
 
-func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
-	return r.internalReconcile(ctx, request)
-}
+var (
+	_ apiresource.Adapter[*v1alpha1.DatadogDashboard, datadogV1.Dashboard] = (*adapter)(nil)
+	_ apiresource.Credentialer[*v1alpha1.DatadogDashboard]                 = (*adapter)(nil)
+	_ apiresource.Resolver[*v1alpha1.DatadogDashboard]                     = (*adapter)(nil)
+	_ apiresource.Tagger[*v1alpha1.DatadogDashboard]                       = (*adapter)(nil)
+	_ apiresource.TagRestricter                                            = (*adapter)(nil)
+	_ apiresource.Adopter[*v1alpha1.DatadogDashboard]                      = (*adapter)(nil)
+	_ apiresource.Orphaner[*v1alpha1.DatadogDashboard]                     = (*adapter)(nil)
+	_ apiresource.Drifter[*v1alpha1.DatadogDashboard, datadogV1.Dashboard] = (*adapter)(nil)
+)
 
-func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-	logger := r.log.WithValues("datadogdashboard", req.NamespacedName)
-	logger.Info("Reconciling Datadog Dashboard", "version", r.versionInfo.String())
-	now := metav1.NewTime(time.Now())
+func (a *adapter) Kind() string {
+	return datadogDashboardKind
+}
 
-	instance := &v1alpha1.DatadogDashboard{}
-	var result ctrl.Result
-	var err error
+func (a *adapter) Finalizer() string {
+	return datadogDashboardFinalizer
+}
 
-	if err = r.client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: req.Name}, instance); err != nil {
-		if apierrors.IsNotFound(err) {
-			return ctrl.Result{}, nil
-		}
+func (a *adapter) NewObject() *v1alpha1.DatadogDashboard {
+	return &v1alpha1.DatadogDashboard{}
+}
 
-		return ctrl.Result{}, err
+func (a *adapter) Status(instance *v1alpha1.DatadogDashboard) apiresource.Status {
+	return &apiresource.StatusFields[v1alpha1.DatadogDashboardSyncStatus]{
+		Conditions:        &instance.Status.Conditions,
+		ID:                &instance.Status.ID,
+		Creator:           &instance.Status.Creator,
+		Created:           &instance.Status.Created,
+		SyncStatus:        &instance.Status.SyncStatus,
+		LastForceSyncTime: &instance.Status.LastForceSyncTime,
+		CurrentHash:       &instance.Status.CurrentHash,
+		SyncStatusOK:      v1alpha1.DatadogDashboardSyncStatusOK,
+		SyncStatusErrors: map[apiresource.Step]v1alpha1.DatadogDashboardSyncStatus{
+			apiresource.StepValidate:     v1alpha1.DatadogDashboardSyncStatusValidateError,
+			apiresource.StepAuthenticate: v1alpha1.DatadogDashboardSyncStatusCredentialsError,
+			apiresource.StepResolve:      v1alpha1.DatadogDashboardSyncStatusConfigMapError,
+			apiresource.StepCreate:       v1alpha1.DatadogDashboardSyncStatusCreateError,
+			apiresource.StepGet:          v1alpha1.DatadoggDashboardSyncStatusGetError,
+			apiresource.StepUpdate:       v1alpha1.DatadogDashboardSyncStatusUpdateError,
+		},
 	}
+}
 
-	if result, err = r.handleFinalizer(logger, instance); ctrutils.ShouldReturn(result, err) {
-		return result, err
+// Validate validates the spec, unless the dashboard is loaded from a ConfigMap: the spec only completes the dashboard
+// of the ConfigMap then, and the merged dashboard is validated once resolved.
+func (a *adapter) Validate(instance *v1alpha1.DatadogDashboard) error {
+	if instance.Spec.ConfigMapRef != nil {
+		return nil
 	}
+	return v1alpha1.IsValidDatadogDashboard(&instance.Spec)
+}
 
-	status := instance.Status.DeepCopy()
-	statusSpecHash := instance.Status.CurrentHash
+func (a *adapter) HashedSpec(instance *v1alpha1.DatadogDashboard) interface{} {
+	return &instance.Spec
+}
 
-	if err = v1alpha1.IsValidDatadogDashboard(&instance.Spec); err != nil {
-		logger.Error(err, "invalid Dashboard")
+func (a *adapter) DeletionPolicy(instance *v1alpha1.DatadogDashboard) v1alpha1.DeletionPolicy {
+	return instance.Spec.DeletionPolicy
+}
 
-		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusValidateError, "ValidatingDashboard", err)
-		return r.updateStatusIfNeeded(logger, instance, status, result)
-	}
+func (a *adapter) Tags(instance *v1alpha1.DatadogDashboard) *[]string {
+	return &instance.Spec.Tags
+}
 
-	instanceSpecHash, err := comparison.GenerateMD5ForSpec(&instance.Spec)
+// AllowedTagKeys returns the team key, the only one accepted by the Dashboard API. The required tags aren't added to
+// dashboards then, only the team tag mapped from the namespace, if any.
+func (a *adapter) AllowedTagKeys() []string {
+	return []string{"team"}
+}
 
-	if err != nil {
-		logger.Error(err, "error generating hash")
-		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusUpdateError, "GeneratingDashboardSpecHash", err)
-		return r.updateStatusIfNeeded(logger, instance, status, result)
-	}
+func (a *adapter) CredentialsRef(instance *v1alpha1.DatadogDashboard) *v1alpha1.DatadogCredentialsReference {
+	return instance.Spec.CredentialsRef
+}
 
-	shouldCreate := false
-	shouldUpdate := false
-
-	if instance.Status.ID == "" {
-		shouldCreate = true
-	} else {
-		if instanceSpecHash != statusSpecHash {
-			logger.Info("DatadogDashboard manifest has changed")
-			shouldUpdate = true
-		} else if instance.Status.LastForceSyncTime == nil || ((defaultForceSyncPeriod - now.Sub(instance.Status.LastForceSyncTime.Time)) <= 0) {
-			// Periodically force a sync with the API to ensure parity
-			// Get Dashboard to make sure it exists before trying any updates. If it doesn't, set shouldCreate
-			_, err = r.get(instance)
-			if err != nil {
-				logger.Error(err, "error getting Dashboard", "Dashboard ID", instance.Status.ID)
-				updateErrStatus(status, now, v1alpha1.DatadoggDashboardSyncStatusGetError, "GettingDashboard", err)
-				if strings.Contains(err.Error(), ctrutils.NotFoundString) {
-					shouldCreate = true
-				}
-			} else {
-				shouldUpdate = true
-			}
-			status.LastForceSyncTime = &now
-		}
-	}
+// auth returns the authentication context of the Datadog API requests made for the dashboard: the one of its credentials,
+// if any, or else the one of the operator credentials.
+func (a *adapter) auth(ctx context.Context) context.Context {
+	return datadogclient.AuthFromContext(ctx, a.datadogAuth)
+}
 
-	if shouldCreate || shouldUpdate {
-		// Check that required tags are present
-		// tagsUpdated, err := r.checkRequiredTags(logger, instance)
-		// if err != nil {
-		// 	result.RequeueAfter = defaultErrRequeuePeriod
-		// 	return r.updateStatusIfNeeded(logger, instance, status, result)
-		// } else if tagsUpdated {
-		// 	// A reconcile is triggered by the update
-		// 	return r.updateStatusIfNeeded(logger, instance, status, result)
-		// }
-
-		if shouldCreate {
-			err = r.create(logger, instance, status, now, instanceSpecHash)
-		} else if shouldUpdate {
-			err = r.update(logger, instance, status, now, instanceSpecHash)
-		}
-
-		if err != nil {
-			result.RequeueAfter = defaultErrRequeuePeriod
-		}
+// Resolve returns the dashboard to sync with Datadog, which is the dashboard of the referenced ConfigMap, if any,
+// completed by the spec.
+func (a *adapter) Resolve(ctx context.Context, instance *v1alpha1.DatadogDashboard) (*v1alpha1.DatadogDashboard, error) {
+	if instance.Spec.ConfigMapRef == nil {
+		return instance, nil
 	}
 
-	// If reconcile was successful and uneventful, requeue with period defaultRequeuePeriod
-	if !result.Requeue && result.RequeueAfter == 0 {
-		result.RequeueAfter = defaultRequeuePeriod
+	resolved, err := a.withConfigMapDashboard(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	if err = v1alpha1.IsValidDatadogDashboard(&resolved.Spec); err != nil {
+		return nil, fmt.Errorf("invalid dashboard in ConfigMap %s: %w", instance.Spec.ConfigMapRef.Name, err)
 	}
 
-	return r.updateStatusIfNeeded(logger, instance, status, result)
+	return resolved, nil
 }
 
-func (r *Reconciler) get(instance *v1alpha1.DatadogDashboard) (datadogV1.Dashboard, error) {
-	return getDashboard(r.datadogAuth, r.datadogClient, instance.Status.ID)
-}
+// SetResolved is a no-op, the dashboard of the ConfigMap isn't stored in the status.
+func (a *adapter) SetResolved(instance, desired *v1alpha1.DatadogDashboard) {}
 
-func (r *Reconciler) update(logger logr.Logger, instance *v1alpha1.DatadogDashboard, status *v1alpha1.DatadogDashboardStatus, now metav1.Time, hash string) error {
-	if _, err := updateDashboard(r.datadogAuth, logger, r.datadogClient, instance); err != nil {
-		logger.Error(err, "error updating Dashboard", "Dashboard ID", instance.Status.ID)
-		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusUpdateError, "UpdatingDasboard", err)
-		return err
+// AdoptID returns the ID of the Dashboard the DatadogDashboard should adopt, if it has the adoption annotation and
+// hasn't adopted it yet. Once adopted, a Dashboard deleted in Datadog is recreated like any other.
+func (a *adapter) AdoptID(instance *v1alpha1.DatadogDashboard) string {
+	adoptID := instance.Annotations[v1alpha1.DatadogDashboardAdoptIDAnnotationKey]
+	if adoptID == instance.Status.ID {
+		return ""
 	}
 
-	event := buildEventInfo(instance.Name, instance.Namespace, datadog.UpdateEvent)
-	r.recordEvent(instance, event)
+	return adoptID
+}
 
-	// Set condition and status
-	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeUpdated, metav1.ConditionTrue, "UpdatingDashboard", "DatadogDashboard Update")
-	status.SyncStatus = v1alpha1.DatadogDashboardSyncStatusOK
-	status.CurrentHash = hash
-	status.LastForceSyncTime = &now
+func (a *adapter) DriftPolicy(instance *v1alpha1.DatadogDashboard) v1alpha1.DriftPolicy {
+	if instance.Spec.ControllerOptions == nil {
+		return ""
+	}
+	return instance.Spec.ControllerOptions.DriftPolicy
+}
 
-	logger.Info("Updated DatadogDashboard", "Dashboard ID", instance.Status.ID)
-	return nil
+func (a *adapter) Drift(instance *v1alpha1.DatadogDashboard, dashboard datadogV1.Dashboard) ([]string, error) {
+	return dashboardDrift(a.log, instance, dashboard)
 }
 
-func (r *Reconciler) create(logger logr.Logger, instance *v1alpha1.DatadogDashboard, status *v1alpha1.DatadogDashboardStatus, now metav1.Time, hash string) error {
-	logger.V(1).Info("Dashboard ID is not set; creating Dashboard in Datadog")
+func (a *adapter) Create(ctx context.Context, instance *v1alpha1.DatadogDashboard) (datadogV1.Dashboard, error) {
+	return createDashboard(a.auth(ctx), a.log, a.datadogClient, instance)
+}
 
-	// Create Dashboard in Datadog
-	createdDashboard, err := createDashboard(r.datadogAuth, logger, r.datadogClient, instance)
-	if err != nil {
-		logger.Error(err, "error creating Dashboard")
-		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusCreateError, "CreatingDashboard", err)
-		return err
-	}
-	event := buildEventInfo(instance.Name, instance.Namespace, datadog.CreationEvent)
-	r.recordEvent(instance, event)
-
-	// Add static information to status
-	status.ID = createdDashboard.GetId()
-	createdTime := metav1.NewTime(createdDashboard.GetCreatedAt())
-	status.Creator = createdDashboard.GetAuthorHandle()
-	status.Created = &createdTime
-	status.SyncStatus = v1alpha1.DatadogDashboardSyncStatusOK
-	status.LastForceSyncTime = &createdTime
-	status.CurrentHash = hash
-
-	// Set condition and status
-	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeCreated, metav1.ConditionTrue, "CreatingDashboard", "DatadogDashboard Created")
-	logger.Info("created a new DatadogDashboard", "dashboard ID", status.ID)
-
-	return nil
+func (a *adapter) Get(ctx context.Context, id string) (datadogV1.Dashboard, error) {
+	return getDashboard(a.auth(ctx), a.datadogClient, id)
 }
 
-// NOTE: commented out for now since 'generated:kubernetes' is not allowed as a tag in the dashboards API
-// func (r *Reconciler) checkRequiredTags(logger logr.Logger, instance *v1alpha1.DatadogDashboard) (bool, error) {
-// 	tags := instance.Spec.Tags
-// 	// TagsToAdd is an empty string for now because "generated" tag keys are not allowed in the Dashboards API
-// 	tagsToAdd := []string{}
-// 	if len(tagsToAdd) > 0 {
-// 		tags = append(tags, tagsToAdd...)
-// 		instance.Spec.Tags = tags
-// 		err := r.client.Update(context.TODO(), instance)
-// 		if err != nil {
-// 			logger.Error(err, "failed to update DatadogDashboard with required tags")
-// 			return false, err
-// 		}
-// 		logger.Info("Added required tags", "Dashboard ID", instance.Status.ID)
-// 		return true, nil
-// 	}
-
-// 	return false, nil
-// }
-
-func updateErrStatus(status *v1alpha1.DatadogDashboardStatus, now metav1.Time, syncStatus v1alpha1.DatadogDashboardSyncStatus, reason string, err error) {
-	condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, reason, err)
-	status.SyncStatus = syncStatus
+func (a *adapter) Update(ctx context.Context, instance *v1alpha1.DatadogDashboard) (datadogV1.Dashboard, error) {
+	return updateDashboard(a.auth(ctx), a.log, a.datadogClient, instance)
 }
 
-func (r *Reconciler) updateStatusIfNeeded(logger logr.Logger, instance *v1alpha1.DatadogDashboard, status *v1alpha1.DatadogDashboardStatus, result ctrl.Result) (ctrl.Result, error) {
-	if !apiequality.Semantic.DeepEqual(&instance.Status, status) {
-		instance.Status = *status
-		if err := r.client.Status().Update(context.TODO(), instance); err != nil {
-			if apierrors.IsConflict(err) {
-				logger.Error(err, "unable to update DatadogDashboard status due to update conflict")
-				return ctrl.Result{Requeue: true, RequeueAfter: defaultErrRequeuePeriod}, nil
-			}
-			logger.Error(err, "unable to update DatadogDashboard status")
-			return ctrl.Result{Requeue: true, RequeueAfter: defaultRequeuePeriod}, err
-		}
-	}
-	return result, nil
+func (a *adapter) Delete(ctx context.Context, instance *v1alpha1.DatadogDashboard) error {
+	return deleteDashboard(a.auth(ctx), a.datadogClient, instance.Status.ID)
 }
 
-// buildEventInfo creates a new EventInfo instance.
-func buildEventInfo(name, ns string, eventType datadog.EventType) utils.EventInfo {
-	return utils.BuildEventInfo(name, ns, datadogDashboardKind, eventType)
+func (a *adapter) Orphan(ctx context.Context, instance *v1alpha1.DatadogDashboard) error {
+	return orphanDashboard(a.auth(ctx), a.datadogClient, instance.Status.ID)
 }
 
-// recordEvent wraps the manager event recorder
-func (r *Reconciler) recordEvent(dashboard runtime.Object, info utils.EventInfo) {
-	r.recorder.Event(dashboard, corev1.EventTypeNormal, info.GetReason(), info.GetMessage())
+func (a *adapter) Info(dashboard datadogV1.Dashboard) apiresource.Info {
+	return apiresource.Info{
+		ID:       dashboard.GetId(),
+		Creator:  dashboard.GetAuthorHandle(),
+		Created:  dashboard.GetCreatedAt(),
+		Modified: dashboard.GetModifiedAt(),
+	}
 }
@@ -23,7 +23,9 @@ import (
 	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,7 +73,7 @@ func TestReconcileDatadogDashboard_Reconcile(t *testing.T) {
 					_ = c.Create(context.TODO(), genericDatadogDashboard())
 				},
 			},
-			wantResult: reconcile.Result{Requeue: true},
+			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
 			wantFunc: func(c client.Client) error {
 				db := &datadoghqv1alpha1.DatadogDashboard{}
 				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, db); err != nil {
@@ -140,6 +142,29 @@ func TestReconcileDatadogDashboard_Reconcile(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "DatadogDashboard adopts an existing dashboard",
+			args: args{
+				request: newRequest(resourcesNamespace, resourcesName),
+				firstAction: func(c client.Client) {
+					db := genericDatadogDashboard()
+					db.Annotations = map[string]string{datadoghqv1alpha1.DatadogDashboardAdoptIDAnnotationKey: "abc-def-ghi"}
+					err := c.Create(context.TODO(), db)
+					assert.NoError(t, err)
+				},
+				firstReconcileCount: 2,
+			},
+			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
+			wantFunc: func(c client.Client) error {
+				db := &datadoghqv1alpha1.DatadogDashboard{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, db); err != nil {
+					return err
+				}
+				assert.Equal(t, "abc-def-ghi", db.Status.ID)
+				assert.Equal(t, datadoghqv1alpha1.DatadogDashboardSyncStatusOK, db.Status.SyncStatus)
+				return nil
+			},
+		},
 		{
 			name: "DatadogDashboard exists, needs delete",
 			args: args{
@@ -181,19 +206,13 @@ func TestReconcileDatadogDashboard_Reconcile(t *testing.T) {
 			testAuth := setupTestAuth(httpServer.URL)
 
 			// Set up
-			r := &Reconciler{
-				client:        fake.NewClientBuilder().WithScheme(s).WithStatusSubresource(&datadoghqv1alpha1.DatadogDashboard{}).Build(),
-				datadogClient: client,
-				datadogAuth:   testAuth,
-				scheme:        s,
-				recorder:      recorder,
-				log:           logf.Log.WithName(tt.name),
-				versionInfo:   &version.Info{},
-			}
+			k8sClient := fake.NewClientBuilder().WithScheme(s).WithStatusSubresource(&datadoghqv1alpha1.DatadogDashboard{}).Build()
+			ddClient := datadogclient.DatadogDashboardClient{Client: client, Auth: testAuth}
+			r := NewReconciler(k8sClient, k8sClient, ddClient, &version.Info{}, s, logf.Log.WithName(tt.name), recorder, "", "", utils.NamespaceTags{})
 
 			// First dashboard action
 			if tt.args.firstAction != nil {
-				tt.args.firstAction(r.client)
+				tt.args.firstAction(k8sClient)
 				// Make sure there's minimum 1 reconcile loop
 				if tt.args.firstReconcileCount == 0 {
 					tt.args.firstReconcileCount = 1
@@ -210,7 +229,7 @@ func TestReconcileDatadogDashboard_Reconcile(t *testing.T) {
 
 			// Second dashboard action
 			if tt.args.secondAction != nil {
-				tt.args.secondAction(r.client)
+				tt.args.secondAction(k8sClient)
 				// Make sure there's minimum 1 reconcile loop
 				if tt.args.secondReconcileCount == 0 {
 					tt.args.secondReconcileCount = 1
@@ -222,7 +241,7 @@ func TestReconcileDatadogDashboard_Reconcile(t *testing.T) {
 			}
 
 			if tt.wantFunc != nil {
-				err := tt.wantFunc(r.client)
+				err := tt.wantFunc(k8sClient)
 				if tt.wantErr {
 					assert.Error(t, err, "ReconcileDatadogDashboard.Reconcile() expected an error")
 				} else {
@@ -3,13 +3,11 @@ package datadogdashboard
 import (
 	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
-	"net/url"
 	"sort"
 
-	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/go-logr/logr"
@@ -18,10 +16,11 @@ import (
 // Transform v1alpha1 dashboard into a datadogV1 Dashboard
 func buildDashboard(logger logr.Logger, ddb *v1alpha1.DatadogDashboard) *datadogV1.Dashboard {
 	layoutType := ddb.Spec.LayoutType
-	widgetList := &[]datadogV1.Widget{}
-	json.Unmarshal([]byte(ddb.Spec.Widgets), widgetList)
+	rawWidgetList := &[]datadogV1.Widget{}
+	json.Unmarshal([]byte(ddb.Spec.Widgets), rawWidgetList)
+	widgetList := append(buildTypedWidgets(ddb.Spec.TypedWidgets), *rawWidgetList...)
 
-	dashboard := datadogV1.NewDashboard(layoutType, ddb.Spec.Title, *widgetList)
+	dashboard := datadogV1.NewDashboard(layoutType, ddb.Spec.Title, widgetList)
 
 	if ddb.Spec.Description != "" {
 		dashboard.SetDescription(ddb.Spec.Description)
@@ -36,7 +35,7 @@ func buildDashboard(logger logr.Logger, ddb *v1alpha1.DatadogDashboard) *datadog
 		dashboard.SetTemplateVariablePresets(dbTemplateVariablePresets)
 	}
 
-	dashboard.SetWidgets(*widgetList)
+	dashboard.SetWidgets(widgetList)
 
 	tags := ddb.Spec.Tags
 	sort.Strings(tags)
@@ -57,7 +56,7 @@ func buildDashboard(logger logr.Logger, ddb *v1alpha1.DatadogDashboard) *datadog
 func getDashboard(auth context.Context, client *datadogV1.DashboardsApi, dashboardID string) (datadogV1.Dashboard, error) {
 	dashboard, _, err := client.GetDashboard(auth, dashboardID)
 	if err != nil {
-		return datadogV1.Dashboard{}, translateClientError(err, "error creating Dashboard")
+		return datadogV1.Dashboard{}, datadogclient.TranslateClientError(err, "error creating Dashboard")
 	}
 	return dashboard, nil
 }
@@ -66,7 +65,7 @@ func createDashboard(auth context.Context, logger logr.Logger, client *datadogV1
 	db := buildDashboard(logger, ddb)
 	dbCreated, _, err := client.CreateDashboard(auth, *db)
 	if err != nil {
-		return datadogV1.Dashboard{}, translateClientError(err, "error creating dashboard")
+		return datadogV1.Dashboard{}, datadogclient.TranslateClientError(err, "error creating dashboard")
 	}
 
 	return dbCreated, nil
@@ -76,36 +75,38 @@ func updateDashboard(auth context.Context, logger logr.Logger, client *datadogV1
 	dashboard := buildDashboard(logger, ddb)
 	dbUpdated, _, err := client.UpdateDashboard(auth, ddb.Status.ID, *dashboard)
 	if err != nil {
-		return datadogV1.Dashboard{}, translateClientError(err, "error updating dashboard")
+		return datadogV1.Dashboard{}, datadogclient.TranslateClientError(err, "error updating dashboard")
 	}
 
 	return dbUpdated, nil
 }
 
-func deleteDashboard(auth context.Context, client *datadogV1.DashboardsApi, dashboardID string) error {
-	if _, _, err := client.DeleteDashboard(auth, dashboardID); err != nil {
-		return translateClientError(err, "error deleting Dashboard")
+// orphanDashboard marks the dashboard as no longer managed by the operator at the end of its description, as the
+// Dashboard API only accepts team tags. The dashboard is updated from its current state in Datadog, so that it's left
+// as it is otherwise.
+func orphanDashboard(auth context.Context, client *datadogV1.DashboardsApi, dashboardID string) error {
+	dashboard, err := getDashboard(auth, client, dashboardID)
+	if err != nil {
+		return err
 	}
-
-	return nil
-}
-
-func translateClientError(err error, msg string) error {
-	if msg == "" {
-		msg = "an error occurred"
+	description, missing := utils.AddOrphanedMarker(dashboard.GetDescription())
+	if !missing {
+		return nil
 	}
 
-	var apiErr datadogapi.GenericOpenAPIError
-	var errURL *url.Error
-	if errors.As(err, &apiErr) {
-		return fmt.Errorf(msg+": %w: %s", err, apiErr.Body())
+	dashboard.SetDescription(description)
+	if _, _, err = client.UpdateDashboard(auth, dashboardID, dashboard); err != nil {
+		return datadogclient.TranslateClientError(err, "error orphaning dashboard")
 	}
+	return nil
+}
 
-	if errors.As(err, &errURL) {
-		return fmt.Errorf(msg+" (url.Error): %s", errURL)
+func deleteDashboard(auth context.Context, client *datadogV1.DashboardsApi, dashboardID string) error {
+	if _, _, err := client.DeleteDashboard(auth, dashboardID); err != nil {
+		return datadogclient.TranslateClientError(err, "error deleting Dashboard")
 	}
 
-	return fmt.Errorf(msg+": %w", err)
+	return nil
 }
 
 func convertTempVarPresets(tempVarPresets []v1alpha1.DashboardTemplateVariablePreset) []datadogV1.DashboardTemplateVariablePreset {
@@ -3,7 +3,6 @@ package datadogdashboard
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -18,11 +17,17 @@ import (
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	v1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
 const dateFormat = "2006-01-02 15:04:05.999999999 -0700 MST"
 
+var (
+	testLogger logr.Logger = zap.New(zap.UseDevMode(true))
+)
+
 func TestBuildDashboard(t *testing.T) {
 	templateVariables := []v1alpha1.DashboardTemplateVariable{
 		{
@@ -191,6 +196,65 @@ func Test_updateDashboard(t *testing.T) {
 	assert.Equal(t, db.Spec.Tags, dashboard.GetTags(), "discrepancy found in parameter: Tags")
 }
 
+func Test_orphanDashboard(t *testing.T) {
+	dbID := "test_id"
+
+	tests := []struct {
+		name            string
+		description     string
+		wantDescription string
+	}{
+		{
+			name:            "orphaned marker added",
+			description:     "test description",
+			wantDescription: "test description\n\norphaned:kubernetes",
+		},
+		{
+			name:        "dashboard already orphaned",
+			description: "test description\n\norphaned:kubernetes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dashboard := genericDashboard(dbID)
+			dashboard.SetDescription(tt.description)
+			jsonDashboard, _ := dashboard.MarshalJSON()
+
+			var gotUpdate *datadogV1.Dashboard
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut {
+					gotUpdate = &datadogV1.Dashboard{}
+					_ = json.NewDecoder(r.Body).Decode(gotUpdate)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonDashboard)
+			}))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV1.NewDashboardsApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			err := orphanDashboard(testAuth, client, dbID)
+			assert.Nil(t, err)
+
+			if tt.wantDescription == "" {
+				assert.Nil(t, gotUpdate)
+				return
+			}
+			if assert.NotNil(t, gotUpdate) {
+				assert.Equal(t, tt.wantDescription, gotUpdate.GetDescription())
+				// The rest of the dashboard is left as it is
+				assert.Equal(t, dashboard.GetTitle(), gotUpdate.GetTitle())
+				assert.Equal(t, dashboard.GetTags(), gotUpdate.GetTags())
+			}
+		})
+	}
+}
+
 func genericDashboard(dbID string) datadogV1.Dashboard {
 	fakeRawNow := time.Unix(1612244495, 0)
 	fakeNow, _ := time.Parse(dateFormat, strings.Split(fakeRawNow.String(), " db=")[0])
@@ -273,58 +337,3 @@ func setupTestAuth(apiURL string) context.Context {
 
 	return testAuth
 }
-
-func Test_translateClientError(t *testing.T) {
-	var ErrGeneric = errors.New("generic error")
-
-	testCases := []struct {
-		name                   string
-		error                  error
-		message                string
-		expectedErrorType      error
-		expectedError          error
-		expectedErrorInterface interface{}
-	}{
-		{
-			name:              "no message, generic error",
-			error:             ErrGeneric,
-			message:           "",
-			expectedErrorType: ErrGeneric,
-		},
-		{
-			name:              "generic message, generic error",
-			error:             ErrGeneric,
-			message:           "generic message",
-			expectedErrorType: ErrGeneric,
-		},
-		{
-			name:                   "generic message, error type datadogV1.GenericOpenAPIError",
-			error:                  datadogapi.GenericOpenAPIError{},
-			message:                "generic message",
-			expectedErrorInterface: &datadogapi.GenericOpenAPIError{},
-		},
-		{
-			name:          "generic message, error type *url.Error",
-			error:         &url.Error{Err: fmt.Errorf("generic url error")},
-			message:       "generic message",
-			expectedError: fmt.Errorf("generic message (url.Error):  \"\": generic url error"),
-		},
-	}
-	for _, test := range testCases {
-		t.Run(test.name, func(t *testing.T) {
-			result := translateClientError(test.error, test.message)
-
-			if test.expectedErrorType != nil {
-				assert.True(t, errors.Is(result, test.expectedErrorType))
-			}
-
-			if test.expectedErrorInterface != nil {
-				assert.True(t, errors.As(result, test.expectedErrorInterface))
-			}
-
-			if test.expectedError != nil {
-				assert.Equal(t, test.expectedError, result)
-			}
-		})
-	}
-}
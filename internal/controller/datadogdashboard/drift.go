@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+)
+
+// dashboardDrift returns the JSON paths of the DatadogDashboard spec fields that differ in the dashboard in Datadog.
+func dashboardDrift(logger logr.Logger, instance *v1alpha1.DatadogDashboard, dashboard datadogV1.Dashboard) ([]string, error) {
+	// Build the desired dashboard as it is sent to the API, so that both sides are formatted the same way
+	desired, err := ExportDashboardSpec(*buildDashboard(logger, instance))
+	if err != nil {
+		return nil, err
+	}
+	actual, err := ExportDashboardSpec(dashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, values := range []*[]string{&desired.Tags, &actual.Tags, &desired.NotifyList, &actual.NotifyList} {
+		*values = append([]string(nil), *values...)
+		sort.Strings(*values)
+	}
+	// The API adds IDs and default values to the widgets, only the widget fields set in the spec are compared
+	if widgetsMatch(desired.Widgets, actual.Widgets) {
+		actual.Widgets = desired.Widgets
+	}
+
+	return comparison.SpecDrift(desired, actual), nil
+}
+
+// widgetsMatch returns true if all the values of the desired widgets are set to the same value in the actual widgets.
+func widgetsMatch(desired, actual string) bool {
+	var desiredWidgets, actualWidgets interface{}
+	if json.Unmarshal([]byte(desired), &desiredWidgets) != nil || json.Unmarshal([]byte(actual), &actualWidgets) != nil {
+		return desired == actual
+	}
+
+	return isJSONSubset(desiredWidgets, actualWidgets)
+}
+
+func isJSONSubset(desired, actual interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range d {
+			if !isJSONSubset(value, a[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !isJSONSubset(d[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return apiequality.Semantic.DeepEqual(desired, actual)
+	}
+}
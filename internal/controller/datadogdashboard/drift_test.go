@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_dashboardDrift(t *testing.T) {
+	instance := &v1alpha1.DatadogDashboard{
+		Spec: v1alpha1.DatadogDashboardSpec{
+			LayoutType: datadogV1.DASHBOARDLAYOUTTYPE_ORDERED,
+			Tags:       []string{"team:checkout", "env:prod"},
+			Title:      "Checkout",
+			Widgets:    `[{"definition":{"type":"note","content":"Checkout service"}}]`,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		widgets string
+		remote  func(d *datadogV1.Dashboard)
+		want    []string
+	}{
+		{
+			name:    "no drift, with the IDs and defaults added by the API",
+			widgets: `[{"id":1234,"definition":{"type":"note","content":"Checkout service","has_padding":true}}]`,
+			remote: func(d *datadogV1.Dashboard) {
+				d.SetTags([]string{"env:prod", "team:checkout"})
+				d.SetDescription("Added in the UI")
+			},
+			want: []string{},
+		},
+		{
+			name:    "changed in the UI",
+			widgets: `[{"id":1234,"definition":{"type":"note","content":"Edited in the UI"}},{"id":5678,"definition":{"type":"free_text","text":"Added in the UI"}}]`,
+			remote: func(d *datadogV1.Dashboard) {
+				d.SetTitle("Checkout (edited)")
+			},
+			want: []string{"title", "widgets"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dashboard := buildDashboard(testLogger, instance)
+			require.NoError(t, json.Unmarshal([]byte(tt.widgets), &dashboard.Widgets))
+			tt.remote(dashboard)
+
+			drift, err := dashboardDrift(testLogger, instance, *dashboard)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, drift)
+		})
+	}
+}
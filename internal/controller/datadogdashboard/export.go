@@ -0,0 +1,66 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"encoding/json"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	v1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// ExportDashboardSpec returns the DatadogDashboardSpec of an existing Datadog dashboard, reversing the mapping of buildDashboard.
+func ExportDashboardSpec(dashboard datadogV1.Dashboard) (v1alpha1.DatadogDashboardSpec, error) {
+	spec := v1alpha1.DatadogDashboardSpec{
+		Description: dashboard.GetDescription(),
+		LayoutType:  dashboard.GetLayoutType(),
+		NotifyList:  dashboard.GetNotifyList(),
+		Tags:        dashboard.GetTags(),
+		Title:       dashboard.GetTitle(),
+	}
+
+	if reflowType, ok := dashboard.GetReflowTypeOk(); ok {
+		spec.ReflowType = reflowType
+	}
+
+	for _, preset := range dashboard.GetTemplateVariablePresets() {
+		specPreset := v1alpha1.DashboardTemplateVariablePreset{
+			Name: preset.Name,
+		}
+		for _, presetValue := range preset.GetTemplateVariables() {
+			specPreset.TemplateVariables = append(specPreset.TemplateVariables, v1alpha1.DashboardTemplateVariablePresetValue{
+				Name:   presetValue.Name,
+				Values: presetValue.GetValues(),
+			})
+		}
+		spec.TemplateVariablePresets = append(spec.TemplateVariablePresets, specPreset)
+	}
+
+	for _, variable := range dashboard.GetTemplateVariables() {
+		specVariable := v1alpha1.DashboardTemplateVariable{
+			Name:     variable.GetName(),
+			Defaults: variable.GetDefaults(),
+		}
+		if availableValues, ok := variable.GetAvailableValuesOk(); ok {
+			specVariable.AvailableValues = availableValues
+		}
+		if prefix, ok := variable.GetPrefixOk(); ok {
+			specVariable.Prefix = prefix
+		}
+		spec.TemplateVariables = append(spec.TemplateVariables, specVariable)
+	}
+
+	if widgets := dashboard.GetWidgets(); len(widgets) > 0 {
+		rawWidgets, err := json.Marshal(widgets)
+		if err != nil {
+			return spec, err
+		}
+		spec.Widgets = string(rawWidgets)
+	}
+
+	return spec, nil
+}
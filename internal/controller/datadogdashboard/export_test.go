@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/stretchr/testify/assert"
+
+	v1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+)
+
+func Test_ExportDashboardSpec(t *testing.T) {
+	spec := v1alpha1.DatadogDashboardSpec{
+		Description: "Dashboard of the checkout service",
+		LayoutType:  datadogV1.DASHBOARDLAYOUTTYPE_ORDERED,
+		NotifyList:  []string{"foo@example.com"},
+		ReflowType:  datadogV1.DASHBOARDREFLOWTYPE_AUTO.Ptr(),
+		Tags:        []string{"team:checkout"},
+		TemplateVariablePresets: []v1alpha1.DashboardTemplateVariablePreset{
+			{
+				Name: apiutils.NewStringPointer("production"),
+				TemplateVariables: []v1alpha1.DashboardTemplateVariablePresetValue{
+					{
+						Name:   apiutils.NewStringPointer("env"),
+						Values: []string{"prod"},
+					},
+				},
+			},
+		},
+		TemplateVariables: []v1alpha1.DashboardTemplateVariable{
+			{
+				AvailableValues: &[]string{"prod", "staging"},
+				Defaults:        []string{"*"},
+				Name:            "env",
+				Prefix:          apiutils.NewStringPointer("env"),
+			},
+		},
+		Title:   "Checkout",
+		Widgets: `[{"definition":{"type":"note","content":"Checkout service"}}]`,
+	}
+
+	dashboard := buildDashboard(testLogger, &v1alpha1.DatadogDashboard{Spec: *spec.DeepCopy()})
+	exported, err := ExportDashboardSpec(*dashboard)
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, spec.Widgets, exported.Widgets)
+	exported.Widgets = spec.Widgets
+	assert.Equal(t, spec, exported)
+}
@@ -0,0 +1,184 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// The SLO widget only supports the detail view
+const sloWidgetViewType = "detail"
+
+// buildTypedWidgets transforms the typed widgets of a DatadogDashboard into datadogV1 Widgets
+func buildTypedWidgets(widgets []v1alpha1.DashboardWidget) []datadogV1.Widget {
+	ddWidgets := make([]datadogV1.Widget, 0, len(widgets))
+	for _, widget := range widgets {
+		var definition datadogV1.WidgetDefinition
+		if widget.Group != nil {
+			definition = datadogV1.GroupWidgetDefinitionAsWidgetDefinition(buildGroupWidgetDefinition(widget.Group))
+		} else {
+			definition = buildWidgetDefinition(&widget.DashboardWidgetDefinition)
+		}
+		ddWidgets = append(ddWidgets, buildWidget(definition, widget.Layout))
+	}
+	return ddWidgets
+}
+
+func buildWidget(definition datadogV1.WidgetDefinition, layout *v1alpha1.DashboardWidgetLayout) datadogV1.Widget {
+	widget := datadogV1.NewWidget(definition)
+	if layout != nil {
+		widget.SetLayout(*datadogV1.NewWidgetLayout(layout.Height, layout.Width, layout.X, layout.Y))
+	}
+	return *widget
+}
+
+func buildGroupWidgetDefinition(group *v1alpha1.DashboardGroupWidgetDefinition) *datadogV1.GroupWidgetDefinition {
+	widgets := make([]datadogV1.Widget, 0, len(group.Widgets))
+	for _, widget := range group.Widgets {
+		widgets = append(widgets, buildWidget(buildWidgetDefinition(&widget.DashboardWidgetDefinition), widget.Layout))
+	}
+
+	definition := datadogV1.NewGroupWidgetDefinition(datadogV1.WIDGETLAYOUTTYPE_ORDERED, datadogV1.GROUPWIDGETDEFINITIONTYPE_GROUP, widgets)
+	if group.Title != "" {
+		definition.SetTitle(group.Title)
+	}
+	if group.BackgroundColor != "" {
+		definition.SetBackgroundColor(group.BackgroundColor)
+	}
+	definition.ShowTitle = group.ShowTitle
+	return definition
+}
+
+// buildWidgetDefinition transforms a widget definition, it expects exactly one definition to be set as checked by
+// IsValidDatadogDashboard.
+func buildWidgetDefinition(definition *v1alpha1.DashboardWidgetDefinition) datadogV1.WidgetDefinition {
+	switch {
+	case definition.Timeseries != nil:
+		return datadogV1.TimeseriesWidgetDefinitionAsWidgetDefinition(buildTimeseriesWidgetDefinition(definition.Timeseries))
+	case definition.QueryValue != nil:
+		return datadogV1.QueryValueWidgetDefinitionAsWidgetDefinition(buildQueryValueWidgetDefinition(definition.QueryValue))
+	case definition.Toplist != nil:
+		return datadogV1.ToplistWidgetDefinitionAsWidgetDefinition(buildToplistWidgetDefinition(definition.Toplist))
+	case definition.Note != nil:
+		return datadogV1.NoteWidgetDefinitionAsWidgetDefinition(buildNoteWidgetDefinition(definition.Note))
+	case definition.SLO != nil:
+		return datadogV1.SLOWidgetDefinitionAsWidgetDefinition(buildSLOWidgetDefinition(definition.SLO))
+	case definition.MonitorSummary != nil:
+		return datadogV1.MonitorSummaryWidgetDefinitionAsWidgetDefinition(buildMonitorSummaryWidgetDefinition(definition.MonitorSummary))
+	default:
+		return datadogV1.WidgetDefinition{}
+	}
+}
+
+func buildTimeseriesWidgetDefinition(timeseries *v1alpha1.DashboardTimeseriesWidgetDefinition) *datadogV1.TimeseriesWidgetDefinition {
+	requests := make([]datadogV1.TimeseriesWidgetRequest, 0, len(timeseries.Requests))
+	for _, request := range timeseries.Requests {
+		ddRequest := datadogV1.NewTimeseriesWidgetRequest()
+		ddRequest.SetQ(request.Query)
+		if request.DisplayType != "" {
+			ddRequest.SetDisplayType(request.DisplayType)
+		}
+		requests = append(requests, *ddRequest)
+	}
+
+	definition := datadogV1.NewTimeseriesWidgetDefinition(requests, datadogV1.TIMESERIESWIDGETDEFINITIONTYPE_TIMESERIES)
+	if timeseries.Title != "" {
+		definition.SetTitle(timeseries.Title)
+	}
+	definition.ShowLegend = timeseries.ShowLegend
+	return definition
+}
+
+func buildQueryValueWidgetDefinition(queryValue *v1alpha1.DashboardQueryValueWidgetDefinition) *datadogV1.QueryValueWidgetDefinition {
+	requests := make([]datadogV1.QueryValueWidgetRequest, 0, len(queryValue.Requests))
+	for _, request := range queryValue.Requests {
+		ddRequest := datadogV1.NewQueryValueWidgetRequest()
+		ddRequest.SetQ(request.Query)
+		if request.Aggregator != "" {
+			ddRequest.SetAggregator(request.Aggregator)
+		}
+		requests = append(requests, *ddRequest)
+	}
+
+	definition := datadogV1.NewQueryValueWidgetDefinition(requests, datadogV1.QUERYVALUEWIDGETDEFINITIONTYPE_QUERY_VALUE)
+	if queryValue.Title != "" {
+		definition.SetTitle(queryValue.Title)
+	}
+	definition.Precision = queryValue.Precision
+	definition.Autoscale = queryValue.Autoscale
+	if queryValue.CustomUnit != "" {
+		definition.SetCustomUnit(queryValue.CustomUnit)
+	}
+	if queryValue.TextAlign != "" {
+		definition.SetTextAlign(queryValue.TextAlign)
+	}
+	return definition
+}
+
+func buildToplistWidgetDefinition(toplist *v1alpha1.DashboardToplistWidgetDefinition) *datadogV1.ToplistWidgetDefinition {
+	requests := make([]datadogV1.ToplistWidgetRequest, 0, len(toplist.Requests))
+	for _, request := range toplist.Requests {
+		ddRequest := datadogV1.NewToplistWidgetRequest()
+		ddRequest.SetQ(request.Query)
+		requests = append(requests, *ddRequest)
+	}
+
+	definition := datadogV1.NewToplistWidgetDefinition(requests, datadogV1.TOPLISTWIDGETDEFINITIONTYPE_TOPLIST)
+	if toplist.Title != "" {
+		definition.SetTitle(toplist.Title)
+	}
+	return definition
+}
+
+func buildNoteWidgetDefinition(note *v1alpha1.DashboardNoteWidgetDefinition) *datadogV1.NoteWidgetDefinition {
+	definition := datadogV1.NewNoteWidgetDefinition(note.Content, datadogV1.NOTEWIDGETDEFINITIONTYPE_NOTE)
+	if note.BackgroundColor != "" {
+		definition.SetBackgroundColor(note.BackgroundColor)
+	}
+	if note.FontSize != "" {
+		definition.SetFontSize(note.FontSize)
+	}
+	if note.TextAlign != "" {
+		definition.SetTextAlign(note.TextAlign)
+	}
+	if note.ShowTick != nil {
+		definition.SetShowTick(*note.ShowTick)
+	}
+	return definition
+}
+
+func buildSLOWidgetDefinition(slo *v1alpha1.DashboardSLOWidgetDefinition) *datadogV1.SLOWidgetDefinition {
+	definition := datadogV1.NewSLOWidgetDefinition(datadogV1.SLOWIDGETDEFINITIONTYPE_SLO, sloWidgetViewType)
+	definition.SetSloId(slo.SLOID)
+	if slo.Title != "" {
+		definition.SetTitle(slo.Title)
+	}
+	for _, timeWindow := range slo.TimeWindows {
+		definition.TimeWindows = append(definition.TimeWindows, datadogV1.WidgetTimeWindows(timeWindow))
+	}
+	if slo.ViewMode != "" {
+		definition.SetViewMode(slo.ViewMode)
+	}
+	definition.ShowErrorBudget = slo.ShowErrorBudget
+	return definition
+}
+
+func buildMonitorSummaryWidgetDefinition(monitorSummary *v1alpha1.DashboardMonitorSummaryWidgetDefinition) *datadogV1.MonitorSummaryWidgetDefinition {
+	definition := datadogV1.NewMonitorSummaryWidgetDefinition(monitorSummary.Query, datadogV1.MONITORSUMMARYWIDGETDEFINITIONTYPE_MANAGE_STATUS)
+	if monitorSummary.Title != "" {
+		definition.SetTitle(monitorSummary.Title)
+	}
+	if monitorSummary.SummaryType != "" {
+		definition.SetSummaryType(monitorSummary.SummaryType)
+	}
+	if monitorSummary.DisplayFormat != "" {
+		definition.SetDisplayFormat(monitorSummary.DisplayFormat)
+	}
+	definition.HideZeroCounts = monitorSummary.HideZeroCounts
+	return definition
+}
@@ -0,0 +1,112 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+)
+
+func Test_buildTypedWidgets(t *testing.T) {
+	tests := []struct {
+		name    string
+		widgets []v1alpha1.DashboardWidget
+		want    string
+	}{
+		{
+			name: "timeseries widget with layout",
+			widgets: []v1alpha1.DashboardWidget{{
+				DashboardWidgetDefinition: v1alpha1.DashboardWidgetDefinition{
+					Timeseries: &v1alpha1.DashboardTimeseriesWidgetDefinition{
+						Title:      "CPU",
+						ShowLegend: apiutils.NewBoolPointer(true),
+						Requests: []v1alpha1.DashboardTimeseriesWidgetRequest{
+							{Query: "avg:system.cpu.user{*} by {host}", DisplayType: datadogV1.WIDGETDISPLAYTYPE_BARS},
+						},
+					},
+				},
+				Layout: &v1alpha1.DashboardWidgetLayout{X: 0, Y: 2, Width: 4, Height: 3},
+			}},
+			want: `[{"definition":{"requests":[{"display_type":"bars","q":"avg:system.cpu.user{*} by {host}"}],"show_legend":true,"title":"CPU","type":"timeseries"},"layout":{"height":3,"width":4,"x":0,"y":2}}]`,
+		},
+		{
+			name: "query value and toplist widgets",
+			widgets: []v1alpha1.DashboardWidget{
+				{DashboardWidgetDefinition: v1alpha1.DashboardWidgetDefinition{
+					QueryValue: &v1alpha1.DashboardQueryValueWidgetDefinition{
+						Requests:   []v1alpha1.DashboardQueryValueWidgetRequest{{Query: "sum:requests{*}", Aggregator: datadogV1.WIDGETAGGREGATOR_SUM}},
+						Precision:  apiutils.NewInt64Pointer(2),
+						CustomUnit: "req",
+					},
+				}},
+				{DashboardWidgetDefinition: v1alpha1.DashboardWidgetDefinition{
+					Toplist: &v1alpha1.DashboardToplistWidgetDefinition{
+						Requests: []v1alpha1.DashboardToplistWidgetRequest{{Query: "top(avg:system.load.1{*} by {host}, 10, 'mean', 'desc')"}},
+					},
+				}},
+			},
+			want: `[{"definition":{"custom_unit":"req","precision":2,"requests":[{"aggregator":"sum","q":"sum:requests{*}"}],"type":"query_value"}},` +
+				`{"definition":{"requests":[{"q":"top(avg:system.load.1{*} by {host}, 10, 'mean', 'desc')"}],"type":"toplist"}}]`,
+		},
+		{
+			name: "group widget with note, SLO and monitor summary widgets",
+			widgets: []v1alpha1.DashboardWidget{{
+				Group: &v1alpha1.DashboardGroupWidgetDefinition{
+					Title: "Overview",
+					Widgets: []v1alpha1.DashboardGroupWidget{
+						{DashboardWidgetDefinition: v1alpha1.DashboardWidgetDefinition{
+							Note: &v1alpha1.DashboardNoteWidgetDefinition{Content: "# Checkout", TextAlign: datadogV1.WIDGETTEXTALIGN_LEFT},
+						}},
+						{DashboardWidgetDefinition: v1alpha1.DashboardWidgetDefinition{
+							SLO: &v1alpha1.DashboardSLOWidgetDefinition{SLOID: "abc", TimeWindows: []v1alpha1.DashboardWidgetTimeWindow{"7d", "30d"}},
+						}},
+						{DashboardWidgetDefinition: v1alpha1.DashboardWidgetDefinition{
+							MonitorSummary: &v1alpha1.DashboardMonitorSummaryWidgetDefinition{Query: "tag:team:checkout", SummaryType: datadogV1.WIDGETSUMMARYTYPE_GROUPS},
+						}},
+					},
+				},
+			}},
+			want: `[{"definition":{"layout_type":"ordered","title":"Overview","type":"group","widgets":[` +
+				`{"definition":{"content":"# Checkout","has_padding":true,"text_align":"left","type":"note"}},` +
+				`{"definition":{"slo_id":"abc","time_windows":["7d","30d"],"type":"slo","view_type":"detail"}},` +
+				`{"definition":{"query":"tag:team:checkout","show_priority":false,"summary_type":"groups","type":"manage_status"}}]}}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widgets, err := json.Marshal(buildTypedWidgets(tt.widgets))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(widgets))
+		})
+	}
+}
+
+func TestBuildDashboard_typedAndRawWidgets(t *testing.T) {
+	db := &v1alpha1.DatadogDashboard{
+		Spec: v1alpha1.DatadogDashboardSpec{
+			Title:      "test",
+			LayoutType: datadogV1.DASHBOARDLAYOUTTYPE_ORDERED,
+			TypedWidgets: []v1alpha1.DashboardWidget{{
+				DashboardWidgetDefinition: v1alpha1.DashboardWidgetDefinition{Note: &v1alpha1.DashboardNoteWidgetDefinition{Content: "typed"}},
+			}},
+			Widgets: `[{"definition":{"type":"note","content":"raw"}}]`,
+		},
+	}
+
+	widgets := buildDashboard(logr.Discard(), db).GetWidgets()
+	require.Len(t, widgets, 2)
+	assert.Equal(t, "typed", widgets[0].Definition.NoteWidgetDefinition.Content)
+	assert.Equal(t, "raw", widgets[1].Definition.NoteWidgetDefinition.Content)
+}
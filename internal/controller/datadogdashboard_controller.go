@@ -8,33 +8,45 @@ package controller
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	"github.com/DataDog/datadog-operator/internal/controller/datadogdashboard"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
 	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 	"github.com/go-logr/logr"
 )
 
 // DatadogDashboardReconciler reconciles a DatadogDashboard object
 type DatadogDashboardReconciler struct {
-	Client      client.Client
-	DDClient    datadogclient.DatadogDashboardClient
-	VersionInfo *version.Info
-	Log         logr.Logger
-	Scheme      *runtime.Scheme
-	Recorder    record.EventRecorder
-	internal    *datadogdashboard.Reconciler
+	Client         client.Client
+	APIReader      client.Reader
+	DDClient       datadogclient.DatadogDashboardClient
+	VersionInfo    *version.Info
+	Log            logr.Logger
+	Scheme         *runtime.Scheme
+	Recorder       record.EventRecorder
+	ClusterName    string
+	DeletionPolicy v1alpha1.DeletionPolicy
+	NamespaceTags  utils.NamespaceTags
+	internal       *datadogdashboard.Reconciler
 }
 
 //+kubebuilder:rbac:groups=datadoghq.com,resources=datadogdashboards,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=datadoghq.com,resources=datadogdashboards/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=datadoghq.com,resources=datadogdashboards/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets;namespaces,verbs=get
 
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.14.4/pkg/reconcile
 func (r *DatadogDashboardReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -43,16 +55,37 @@ func (r *DatadogDashboardReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DatadogDashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.internal = datadogdashboard.NewReconciler(r.Client, r.DDClient, r.VersionInfo, r.Scheme, r.Log, r.Recorder)
+	r.internal = datadogdashboard.NewReconciler(r.Client, r.APIReader, r.DDClient, r.VersionInfo, r.Scheme, r.Log, r.Recorder, r.ClusterName, r.DeletionPolicy, r.NamespaceTags)
 
-	builder := ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.DatadogDashboard{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{})
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.DatadogDashboard{}, datadogdashboard.ConfigMapRefIndexKey, datadogdashboard.ConfigMapRefIndexer); err != nil {
+		return err
+	}
 
-	err := builder.Complete(r)
+	// The dashboards loaded from a ConfigMap are reconciled when the ConfigMap changes. Only the metadata of the
+	// ConfigMaps is cached, their data is read from the API server when the dashboard is reconciled.
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DatadogDashboard{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		WatchesMetadata(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.enqueueConfigMapDashboards))
+
+	err := r.internal.WatchNamespaces(controllerBuilder).Complete(r)
 
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// enqueueConfigMapDashboards enqueues the DatadogDashboards loaded from a ConfigMap.
+func (r *DatadogDashboardReconciler) enqueueConfigMapDashboards(ctx context.Context, obj client.Object) []reconcile.Request {
+	dashboardList := &v1alpha1.DatadogDashboardList{}
+	if err := r.Client.List(ctx, dashboardList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{datadogdashboard.ConfigMapRefIndexKey: obj.GetName()}); err != nil {
+		r.Log.Error(err, "unable to list DatadogDashboards", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(dashboardList.Items))
+	for _, dashboard := range dashboardList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: dashboard.Namespace, Name: dashboard.Name}})
+	}
+	return requests
+}
@@ -0,0 +1,242 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/apiresource"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	defaultRequeuePeriod     = apiresource.DefaultRequeuePeriod
+	defaultErrRequeuePeriod  = apiresource.DefaultErrRequeuePeriod
+	defaultForceSyncPeriod   = apiresource.DefaultForceSyncPeriod
+	datadogDowntimeKind      = "DatadogDowntime"
+	datadogDowntimeFinalizer = "finalizer.downtime.datadoghq.com"
+)
+
+// Reconciler reconciles DatadogDowntimes.
+type Reconciler = apiresource.Reconciler[*v1alpha1.DatadogDowntime, []datadogV2.DowntimeResponseData]
+
+func NewReconciler(client client.Client, ddClient datadogclient.DatadogDowntimeClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder, deletionPolicy v1alpha1.DeletionPolicy) *Reconciler {
+	// The adapter isn't a Credentialer, no credentials are read with the API reader
+	return apiresource.NewReconciler(client, client, &adapter{client: client, datadogClient: ddClient.Client, datadogAuth: ddClient.Auth}, versionInfo, log, recorder, deletionPolicy, utils.NamespaceTags{})
+}
+
+// adapter adapts DatadogDowntimes to the apiresource.Reconciler.
+type adapter struct {
+	client        client.Client
+	datadogClient *datadogV2.DowntimesApi
+	datadogAuth   context.Context
+}
+
+var (
+	_ apiresource.Adapter[*v1alpha1.DatadogDowntime, []datadogV2.DowntimeResponseData]  = (*adapter)(nil)
+	_ apiresource.Resolver[*v1alpha1.DatadogDowntime]                                   = (*adapter)(nil)
+	_ apiresource.Replacer[*v1alpha1.DatadogDowntime]                                   = (*adapter)(nil)
+	_ apiresource.Observer[*v1alpha1.DatadogDowntime, []datadogV2.DowntimeResponseData] = (*adapter)(nil)
+	_ apiresource.Ender[[]datadogV2.DowntimeResponseData]                               = (*adapter)(nil)
+)
+
+func (a *adapter) Kind() string {
+	return datadogDowntimeKind
+}
+
+func (a *adapter) Finalizer() string {
+	return datadogDowntimeFinalizer
+}
+
+func (a *adapter) NewObject() *v1alpha1.DatadogDowntime {
+	return &v1alpha1.DatadogDowntime{}
+}
+
+func (a *adapter) Status(instance *v1alpha1.DatadogDowntime) apiresource.Status {
+	return &apiresource.StatusFields[v1alpha1.DatadogDowntimeSyncStatus]{
+		Conditions:        &instance.Status.Conditions,
+		ID:                &instance.Status.ID,
+		Created:           &instance.Status.Created,
+		SyncStatus:        &instance.Status.SyncStatus,
+		LastForceSyncTime: &instance.Status.LastForceSyncTime,
+		CurrentHash:       &instance.Status.CurrentHash,
+		SyncStatusOK:      v1alpha1.DatadogDowntimeSyncStatusOK,
+		SyncStatusErrors: map[apiresource.Step]v1alpha1.DatadogDowntimeSyncStatus{
+			apiresource.StepValidate: v1alpha1.DatadogDowntimeSyncStatusValidateError,
+			apiresource.StepResolve:  v1alpha1.DatadogDowntimeSyncStatusMonitorRefError,
+			apiresource.StepCreate:   v1alpha1.DatadogDowntimeSyncStatusCreateError,
+			apiresource.StepGet:      v1alpha1.DatadogDowntimeSyncStatusGetError,
+			apiresource.StepUpdate:   v1alpha1.DatadogDowntimeSyncStatusUpdateError,
+		},
+	}
+}
+
+func (a *adapter) Validate(instance *v1alpha1.DatadogDowntime) error {
+	return v1alpha1.IsValidDatadogDowntime(&instance.Spec)
+}
+
+// HashedSpec returns the spec and the IDs of the muted monitors, if any.
+func (a *adapter) HashedSpec(instance *v1alpha1.DatadogDowntime) interface{} {
+	return struct {
+		*v1alpha1.DatadogDowntimeSpec
+		MonitorIDs []int64 `json:"monitorIDs,omitempty"`
+	}{&instance.Spec, instance.Status.MonitorIDs}
+}
+
+func (a *adapter) DeletionPolicy(instance *v1alpha1.DatadogDowntime) v1alpha1.DeletionPolicy {
+	return instance.Spec.DeletionPolicy
+}
+
+// Resolve sets the sorted Datadog IDs of the DatadogMonitors referenced or selected by the downtime, if any, in the
+// status of a copy.
+func (a *adapter) Resolve(ctx context.Context, instance *v1alpha1.DatadogDowntime) (*v1alpha1.DatadogDowntime, error) {
+	resolved := instance.DeepCopy()
+	resolved.Status.MonitorIDs = nil
+	if len(instance.Spec.MonitorRefs) == 0 && instance.Spec.MonitorSelector == nil {
+		return resolved, nil
+	}
+
+	monitors := make([]v1alpha1.DatadogMonitor, 0, len(instance.Spec.MonitorRefs))
+	for _, ref := range instance.Spec.MonitorRefs {
+		monitor := v1alpha1.DatadogMonitor{}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: ref.Name}, &monitor); err != nil {
+			return nil, fmt.Errorf("unable to get DatadogMonitor %s: %w", ref.Name, err)
+		}
+		monitors = append(monitors, monitor)
+	}
+	if instance.Spec.MonitorSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(instance.Spec.MonitorSelector)
+		if err != nil {
+			return nil, err
+		}
+		monitorList := &v1alpha1.DatadogMonitorList{}
+		if err = a.client.List(ctx, monitorList, client.InNamespace(instance.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("unable to list DatadogMonitors: %w", err)
+		}
+		// A selector matching no monitors must not mute all of them
+		if len(monitorList.Items) == 0 {
+			return nil, fmt.Errorf("no DatadogMonitor matches the monitor selector")
+		}
+		monitors = append(monitors, monitorList.Items...)
+	}
+
+	for _, monitor := range monitors {
+		if monitor.Status.ID == 0 {
+			return nil, fmt.Errorf("DatadogMonitor %s isn't created in Datadog yet", monitor.Name)
+		}
+		if monitorID := int64(monitor.Status.ID); !slices.Contains(resolved.Status.MonitorIDs, monitorID) {
+			resolved.Status.MonitorIDs = append(resolved.Status.MonitorIDs, monitorID)
+		}
+	}
+	slices.Sort(resolved.Status.MonitorIDs)
+	return resolved, nil
+}
+
+func (a *adapter) SetResolved(instance, desired *v1alpha1.DatadogDowntime) {
+	instance.Status.MonitorIDs = desired.Status.MonitorIDs
+}
+
+// Create creates a downtime for each muted monitor. The downtimes of the previous sync that are still running, when some
+// of them were canceled outside Kubernetes or are missing, are canceled first.
+func (a *adapter) Create(ctx context.Context, instance *v1alpha1.DatadogDowntime) ([]datadogV2.DowntimeResponseData, error) {
+	if err := cancelDowntimes(a.datadogAuth, a.datadogClient, instance.Status.ID); err != nil {
+		return nil, err
+	}
+	return createDowntimes(a.datadogAuth, a.datadogClient, instance)
+}
+
+func (a *adapter) Get(ctx context.Context, id string) ([]datadogV2.DowntimeResponseData, error) {
+	return getDowntimes(a.datadogAuth, a.datadogClient, id)
+}
+
+func (a *adapter) Update(ctx context.Context, instance *v1alpha1.DatadogDowntime) ([]datadogV2.DowntimeResponseData, error) {
+	return updateDowntimes(a.datadogAuth, a.datadogClient, instance)
+}
+
+// Replace returns true for the expired and canceled downtimes, Datadog rejects their updates, and when the number of
+// muted monitors changes.
+func (a *adapter) Replace(instance, desired *v1alpha1.DatadogDowntime) bool {
+	return isEnded(instance.Status.DowntimeState) ||
+		len(downtimeIDs(instance.Status.ID)) != len(buildMonitorIdentifiers(&desired.Spec, desired.Status.MonitorIDs))
+}
+
+// Delete cancels the downtimes that haven't ended.
+func (a *adapter) Delete(ctx context.Context, instance *v1alpha1.DatadogDowntime) error {
+	return cancelDowntimes(a.datadogAuth, a.datadogClient, instance.Status.ID)
+}
+
+// Info returns the IDs of the downtimes joined in a single ID, the creation time of the first one and the latest
+// modification time.
+func (a *adapter) Info(downtimes []datadogV2.DowntimeResponseData) apiresource.Info {
+	info := apiresource.Info{}
+	ids := make([]string, 0, len(downtimes))
+	for i, downtime := range downtimes {
+		ids = append(ids, downtime.GetId())
+		attributes := downtime.Attributes
+		if attributes == nil {
+			continue
+		}
+		if i == 0 {
+			info.Created = attributes.GetCreated()
+		}
+		if modified := attributes.GetModified(); modified.After(info.Modified) {
+			info.Modified = modified
+		}
+	}
+	info.ID = strings.Join(ids, downtimeIDSeparator)
+	return info
+}
+
+// Observe sets the state of the downtime and the bounds of its current occurrence in the status.
+func (a *adapter) Observe(instance *v1alpha1.DatadogDowntime, downtimes []datadogV2.DowntimeResponseData, now metav1.Time) {
+	instance.Status.DowntimeState, instance.Status.CurrentStart, instance.Status.CurrentEnd = getDowntimesState(downtimes)
+}
+
+// Ended returns true for the expired and canceled downtimes, they can't be updated anymore. A downtime canceled
+// outside Kubernetes is created again.
+func (a *adapter) Ended(downtimes []datadogV2.DowntimeResponseData) (bool, bool) {
+	switch state, _, _ := getDowntimesState(downtimes); state {
+	case v1alpha1.DatadogDowntimeStateCanceled:
+		return true, true
+	case v1alpha1.DatadogDowntimeStateExpired:
+		return true, false
+	default:
+		return false, false
+	}
+}
+
+// MutesMonitor returns true if the DatadogDowntime references the DatadogMonitor in its MonitorRefs, or selects it with
+// its MonitorSelector.
+func MutesMonitor(instance *v1alpha1.DatadogDowntime, monitor client.Object) bool {
+	if instance.Namespace != monitor.GetNamespace() {
+		return false
+	}
+	for _, ref := range instance.Spec.MonitorRefs {
+		if ref.Name == monitor.GetName() {
+			return true
+		}
+	}
+	if instance.Spec.MonitorSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(instance.Spec.MonitorSelector)
+	return err == nil && selector.Matches(labels.Set(monitor.GetLabels()))
+}
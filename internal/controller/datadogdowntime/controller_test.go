@@ -0,0 +1,449 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	resourceNamespace = "default"
+	resourceName      = "downtime"
+	monitorName       = "monitor"
+)
+
+// TestReconciler_Reconcile tests the Reconcile method of the Reconciler
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	testLogger := zap.New(zap.UseDevMode(true))
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogDowntime{}, &v1alpha1.DatadogMonitor{}, &v1alpha1.DatadogMonitorList{})
+
+	request := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+	}
+
+	type mockedFields struct {
+		k8sClient client.Client
+	}
+	tests := []struct {
+		name                 string
+		mockOn               func(t *testing.T, m *mockedFields)
+		datadogClientHandler func(t *testing.T) http.HandlerFunc
+		expectedResult       ctrl.Result
+		checkStatus          func(t *testing.T, status v1alpha1.DatadogDowntimeStatus)
+	}{
+		{
+			name: "Create downtime when not exists",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultDowntime())
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodPost, r.Method)
+					writeDowntimeResponse(w, "downtime-1", datadogV2.DOWNTIMESTATUS_SCHEDULED)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, "downtime-1", status.ID)
+				assert.Equal(t, v1alpha1.DatadogDowntimeStateScheduled, status.DowntimeState)
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusOK, status.SyncStatus)
+				assert.NotEmpty(t, status.CurrentHash)
+			},
+		},
+		{
+			name: "Return empty result when downtime is not found",
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {}
+			},
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name: "Return Error and Requeue result when creating downtime is failed",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultDowntime())
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					http.Error(w, "invalid data", http.StatusBadRequest)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusCreateError, status.SyncStatus)
+			},
+		},
+		{
+			name: "Requeue when the referenced monitor isn't created in Datadog",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				downtime := defaultDowntime()
+				downtime.Spec.MonitorTags = nil
+				downtime.Spec.MonitorRefs = []v1alpha1.DatadogDowntimeMonitorReference{{Name: monitorName}}
+				_ = m.k8sClient.Create(context.TODO(), downtime)
+				_ = m.k8sClient.Create(context.TODO(), &v1alpha1.DatadogMonitor{
+					ObjectMeta: metav1.ObjectMeta{Namespace: resourceNamespace, Name: monitorName},
+				})
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected call to the Datadog API: %s %s", r.Method, r.URL.Path)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusMonitorRefError, status.SyncStatus)
+			},
+		},
+		{
+			name: "Create downtime for the referenced monitor",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				downtime := defaultDowntime()
+				downtime.Spec.MonitorTags = nil
+				downtime.Spec.MonitorRefs = []v1alpha1.DatadogDowntimeMonitorReference{{Name: monitorName}}
+				_ = m.k8sClient.Create(context.TODO(), downtime)
+				monitor := &v1alpha1.DatadogMonitor{
+					ObjectMeta: metav1.ObjectMeta{Namespace: resourceNamespace, Name: monitorName},
+				}
+				_ = m.k8sClient.Create(context.TODO(), monitor)
+				monitor.Status.ID = 12345
+				_ = m.k8sClient.Status().Update(context.TODO(), monitor)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					body, _ := io.ReadAll(r.Body)
+					req := datadogV2.DowntimeCreateRequest{}
+					require.NoError(t, json.Unmarshal(body, &req))
+					identifier := req.Data.Attributes.MonitorIdentifier.DowntimeMonitorIdentifierId
+					require.NotNil(t, identifier)
+					assert.Equal(t, int64(12345), identifier.MonitorId)
+					writeDowntimeResponse(w, "downtime-1", datadogV2.DOWNTIMESTATUS_ACTIVE)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, []int64{12345}, status.MonitorIDs)
+				assert.Equal(t, v1alpha1.DatadogDowntimeStateActive, status.DowntimeState)
+			},
+		},
+		{
+			name: "Refresh the state of an unchanged downtime",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedDowntime(t, time.Now()))
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodGet, r.Method)
+					writeDowntimeResponse(w, "downtime-1", datadogV2.DOWNTIMESTATUS_ENDED)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, "downtime-1", status.ID)
+				assert.Equal(t, v1alpha1.DatadogDowntimeStateExpired, status.DowntimeState)
+			},
+		},
+		{
+			name: "Create the downtime again when it's canceled outside Kubernetes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedDowntime(t, time.Now().Add(-2*defaultForceSyncPeriod)))
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodGet {
+						writeDowntimeResponse(w, "downtime-1", datadogV2.DOWNTIMESTATUS_CANCELED)
+						return
+					}
+					assert.Equal(t, http.MethodPost, r.Method)
+					writeDowntimeResponse(w, "downtime-2", datadogV2.DOWNTIMESTATUS_ACTIVE)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, "downtime-2", status.ID)
+				assert.Equal(t, v1alpha1.DatadogDowntimeStateActive, status.DowntimeState)
+			},
+		},
+		{
+			name: "Create the downtime again when the spec of an expired downtime changes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				downtime := syncedDowntime(t, time.Now())
+				downtime.Status.DowntimeState = v1alpha1.DatadogDowntimeStateExpired
+				downtime.Spec.MonitorTags = []string{"team:bar"}
+				_ = m.k8sClient.Create(context.TODO(), downtime)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					// The expired downtime is neither updated nor canceled
+					if r.Method == http.MethodGet {
+						writeDowntimeResponse(w, "downtime-1", datadogV2.DOWNTIMESTATUS_ENDED)
+						return
+					}
+					assert.Equal(t, http.MethodPost, r.Method)
+					writeDowntimeResponse(w, "downtime-2", datadogV2.DOWNTIMESTATUS_ACTIVE)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, "downtime-2", status.ID)
+				assert.Equal(t, v1alpha1.DatadogDowntimeStateActive, status.DowntimeState)
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusOK, status.SyncStatus)
+			},
+		},
+		{
+			name: "Create a downtime for each referenced or selected monitor",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				downtime := defaultDowntime()
+				downtime.Spec.MonitorTags = nil
+				downtime.Spec.MonitorRefs = []v1alpha1.DatadogDowntimeMonitorReference{{Name: monitorName}}
+				downtime.Spec.MonitorSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}}
+				_ = m.k8sClient.Create(context.TODO(), downtime)
+				createMonitor(t, m.k8sClient, monitorName, 67890, map[string]string{"team": "foo"})
+				createMonitor(t, m.k8sClient, "other-monitor", 12345, map[string]string{"team": "foo"})
+				createMonitor(t, m.k8sClient, "unselected-monitor", 11111, nil)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodPost, r.Method)
+					body, _ := io.ReadAll(r.Body)
+					req := datadogV2.DowntimeCreateRequest{}
+					require.NoError(t, json.Unmarshal(body, &req))
+					identifier := req.Data.Attributes.MonitorIdentifier.DowntimeMonitorIdentifierId
+					require.NotNil(t, identifier)
+					writeDowntimeResponse(w, fmt.Sprintf("downtime-%d", identifier.MonitorId), datadogV2.DOWNTIMESTATUS_ACTIVE)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, []int64{12345, 67890}, status.MonitorIDs)
+				assert.Equal(t, "downtime-12345,downtime-67890", status.ID)
+				assert.Equal(t, v1alpha1.DatadogDowntimeStateActive, status.DowntimeState)
+			},
+		},
+		{
+			name: "Requeue when the monitor selector matches no monitor",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				downtime := defaultDowntime()
+				downtime.Spec.MonitorTags = nil
+				downtime.Spec.MonitorSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}}
+				_ = m.k8sClient.Create(context.TODO(), downtime)
+				createMonitor(t, m.k8sClient, monitorName, 12345, nil)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected call to the Datadog API: %s %s", r.Method, r.URL.Path)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusMonitorRefError, status.SyncStatus)
+			},
+		},
+		{
+			name: "Create the downtimes again when the number of muted monitors changes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				downtime := syncedDowntime(t, time.Now())
+				downtime.Spec.MonitorTags = nil
+				downtime.Spec.MonitorRefs = []v1alpha1.DatadogDowntimeMonitorReference{{Name: monitorName}, {Name: "other-monitor"}}
+				_ = m.k8sClient.Create(context.TODO(), downtime)
+				createMonitor(t, m.k8sClient, monitorName, 12345, nil)
+				createMonitor(t, m.k8sClient, "other-monitor", 67890, nil)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				canceled := false
+				return func(w http.ResponseWriter, r *http.Request) {
+					switch r.Method {
+					case http.MethodGet:
+						assert.Equal(t, "/api/v2/downtime/downtime-1", r.URL.Path)
+						if canceled {
+							writeDowntimeResponse(w, "downtime-1", datadogV2.DOWNTIMESTATUS_CANCELED)
+						} else {
+							writeDowntimeResponse(w, "downtime-1", datadogV2.DOWNTIMESTATUS_ACTIVE)
+						}
+					case http.MethodDelete:
+						assert.Equal(t, "/api/v2/downtime/downtime-1", r.URL.Path)
+						canceled = true
+						w.WriteHeader(http.StatusNoContent)
+					default:
+						assert.Equal(t, http.MethodPost, r.Method)
+						body, _ := io.ReadAll(r.Body)
+						req := datadogV2.DowntimeCreateRequest{}
+						require.NoError(t, json.Unmarshal(body, &req))
+						writeDowntimeResponse(w, fmt.Sprintf("downtime-%d", req.Data.Attributes.MonitorIdentifier.DowntimeMonitorIdentifierId.MonitorId), datadogV2.DOWNTIMESTATUS_ACTIVE)
+					}
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, "downtime-12345,downtime-67890", status.ID)
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusOK, status.SyncStatus)
+			},
+		},
+	}
+
+	// Iterate through test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(tt.datadogClientHandler(t))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV2.NewDowntimesApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			m := mockedFields{
+				k8sClient: fake.NewClientBuilder().WithStatusSubresource(&v1alpha1.DatadogDowntime{}, &v1alpha1.DatadogMonitor{}).Build(),
+			}
+			if tt.mockOn != nil {
+				tt.mockOn(t, &m)
+			}
+			recorder := record.NewFakeRecorder(5)
+			ddClient := datadogclient.DatadogDowntimeClient{Client: client, Auth: testAuth}
+			r := NewReconciler(m.k8sClient, ddClient, &version.Info{}, testLogger, recorder, "")
+
+			res, _ := r.Reconcile(ctx, request)
+			assert.Equal(t, tt.expectedResult, res)
+
+			if tt.checkStatus != nil {
+				downtime := &v1alpha1.DatadogDowntime{}
+				require.NoError(t, m.k8sClient.Get(ctx, request.NamespacedName, downtime))
+				tt.checkStatus(t, downtime.Status)
+			}
+		})
+	}
+}
+
+// createMonitor creates a DatadogMonitor created in Datadog with the given ID
+func createMonitor(t *testing.T, k8sClient client.Client, name string, id int, labels map[string]string) {
+	monitor := &v1alpha1.DatadogMonitor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: resourceNamespace, Name: name, Labels: labels},
+	}
+	require.NoError(t, k8sClient.Create(context.TODO(), monitor))
+	monitor.Status.ID = id
+	require.NoError(t, k8sClient.Status().Update(context.TODO(), monitor))
+}
+
+func defaultDowntime() *v1alpha1.DatadogDowntime {
+	return &v1alpha1.DatadogDowntime{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogDowntime",
+			APIVersion: fmt.Sprintf("%s/%s", v1alpha1.GroupVersion.Group, v1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+		Spec: v1alpha1.DatadogDowntimeSpec{
+			KubernetesScope: &v1alpha1.DatadogDowntimeKubernetesScope{
+				Namespaces: []string{resourceNamespace},
+			},
+			MonitorTags: []string{"team:foo"},
+		},
+	}
+}
+
+// syncedDowntime returns a DatadogDowntime whose spec was synced with Datadog at the given time, with a successful
+// last sync
+func syncedDowntime(t *testing.T, lastSync time.Time) *v1alpha1.DatadogDowntime {
+	downtime := defaultDowntime()
+	hash, err := comparison.GenerateMD5ForSpec(&downtime.Spec)
+	require.NoError(t, err)
+	lastSyncTime := metav1.NewTime(lastSync)
+	downtime.Status = v1alpha1.DatadogDowntimeStatus{
+		ID:                "downtime-1",
+		CurrentHash:       hash,
+		LastForceSyncTime: &lastSyncTime,
+		DowntimeState:     v1alpha1.DatadogDowntimeStateActive,
+		SyncStatus:        v1alpha1.DatadogDowntimeSyncStatusOK,
+	}
+	return downtime
+}
+
+func writeDowntimeResponse(w http.ResponseWriter, id string, status datadogV2.DowntimeStatus) {
+	created := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(datadogV2.DowntimeResponse{
+		Data: &datadogV2.DowntimeResponseData{
+			Id:   &id,
+			Type: datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME.Ptr(),
+			Attributes: &datadogV2.DowntimeResponseAttributes{
+				Created: &created,
+				Status:  status.Ptr(),
+			},
+		},
+	})
+}
+
+func setupTestAuth(apiURL string) context.Context {
+	testAuth := context.WithValue(
+		context.Background(),
+		datadogapi.ContextAPIKeys,
+		map[string]datadogapi.APIKey{
+			"apiKeyAuth": {
+				Key: "DUMMY_API_KEY",
+			},
+			"appKeyAuth": {
+				Key: "DUMMY_APP_KEY",
+			},
+		},
+	)
+	parsedAPIURL, _ := url.Parse(apiURL)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerIndex, 1)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerVariables, map[string]string{
+		"name":     parsedAPIURL.Host,
+		"protocol": parsedAPIURL.Scheme,
+	})
+
+	return testAuth
+}
+
+func TestMutesMonitor(t *testing.T) {
+	downtime := defaultDowntime()
+	downtime.Spec.MonitorTags = nil
+	downtime.Spec.MonitorRefs = []v1alpha1.DatadogDowntimeMonitorReference{{Name: monitorName}}
+	downtime.Spec.MonitorSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}}
+
+	newMonitor := func(namespace, name string, labels map[string]string) *v1alpha1.DatadogMonitor {
+		return &v1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+	}
+	assert.True(t, MutesMonitor(downtime, newMonitor(resourceNamespace, monitorName, nil)))
+	assert.True(t, MutesMonitor(downtime, newMonitor(resourceNamespace, "other-monitor", map[string]string{"team": "foo"})))
+	assert.False(t, MutesMonitor(downtime, newMonitor(resourceNamespace, "other-monitor", map[string]string{"team": "bar"})))
+	assert.False(t, MutesMonitor(downtime, newMonitor("other-namespace", monitorName, map[string]string{"team": "foo"})))
+}
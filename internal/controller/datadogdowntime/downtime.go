@@ -0,0 +1,371 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	// Tags set by the Datadog Agent on Kubernetes resources
+	kubeClusterNameTag = "kube_cluster_name"
+	kubeNamespaceTag   = "kube_namespace"
+	kubeDeploymentTag  = "kube_deployment"
+	kubeStatefulSetTag = "kube_stateful_set"
+	kubeDaemonSetTag   = "kube_daemon_set"
+
+	allScope = "*"
+
+	// downtimeIDSeparator separates the IDs of the downtimes of each monitor muted by a DatadogDowntime
+	downtimeIDSeparator = ","
+)
+
+// buildScope combines the Datadog scope and the Kubernetes scope of the spec in a single Datadog scope query
+func buildScope(spec *v1alpha1.DatadogDowntimeSpec) string {
+	var parts []string
+	if spec.Scope != "" {
+		parts = append(parts, spec.Scope)
+	}
+
+	if k8sScope := spec.KubernetesScope; k8sScope != nil {
+		if k8sScope.ClusterName != "" {
+			parts = append(parts, buildTagQuery(kubeClusterNameTag, []string{k8sScope.ClusterName}))
+		}
+		for _, tag := range []struct {
+			name   string
+			values []string
+		}{
+			{name: kubeNamespaceTag, values: k8sScope.Namespaces},
+			{name: kubeDeploymentTag, values: k8sScope.Deployments},
+			{name: kubeStatefulSetTag, values: k8sScope.StatefulSets},
+			{name: kubeDaemonSetTag, values: k8sScope.DaemonSets},
+		} {
+			if len(tag.values) > 0 {
+				parts = append(parts, buildTagQuery(tag.name, tag.values))
+			}
+		}
+	}
+
+	if len(parts) == 0 {
+		return allScope
+	}
+	// The user scope may contain boolean operators, it must be evaluated before being combined
+	if len(parts) > 1 && spec.Scope != "" && strings.Contains(spec.Scope, " ") {
+		parts[0] = "(" + spec.Scope + ")"
+	}
+	return strings.Join(parts, " AND ")
+}
+
+func buildTagQuery(name string, values []string) string {
+	if len(values) == 1 {
+		return fmt.Sprintf("%s:%s", name, values[0])
+	}
+	return fmt.Sprintf("%s:(%s)", name, strings.Join(values, " OR "))
+}
+
+// buildMonitorIdentifiers returns the monitors muted by each downtime. A Datadog downtime mutes a single monitor ID, so
+// there is a downtime for each resolved DatadogMonitor, and a single one for the monitor tags otherwise. All monitors
+// are muted when there are neither monitor tags nor monitors.
+func buildMonitorIdentifiers(spec *v1alpha1.DatadogDowntimeSpec, monitorIDs []int64) []datadogV2.DowntimeMonitorIdentifier {
+	if len(monitorIDs) > 0 {
+		identifiers := make([]datadogV2.DowntimeMonitorIdentifier, 0, len(monitorIDs))
+		for _, monitorID := range monitorIDs {
+			identifiers = append(identifiers, datadogV2.DowntimeMonitorIdentifierIdAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierId(monitorID)))
+		}
+		return identifiers
+	}
+	tags := spec.MonitorTags
+	if len(tags) == 0 {
+		tags = []string{allScope}
+	}
+	return []datadogV2.DowntimeMonitorIdentifier{datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierTags(tags))}
+}
+
+// downtimeIDs returns the IDs of the downtimes of a DatadogDowntime, from the ID in its status.
+func downtimeIDs(id string) []string {
+	if id == "" {
+		return nil
+	}
+	return strings.Split(id, downtimeIDSeparator)
+}
+
+func buildRecurrences(schedule *v1alpha1.DatadogDowntimeSchedule) []datadogV2.DowntimeScheduleRecurrenceCreateUpdateRequest {
+	recurrences := make([]datadogV2.DowntimeScheduleRecurrenceCreateUpdateRequest, 0, len(schedule.Recurrences))
+	for _, recurrence := range schedule.Recurrences {
+		r := datadogV2.NewDowntimeScheduleRecurrenceCreateUpdateRequest(recurrence.Duration, recurrence.Rrule)
+		if recurrence.Start != "" {
+			r.SetStart(recurrence.Start)
+		}
+		recurrences = append(recurrences, *r)
+	}
+	return recurrences
+}
+
+// buildNotifyEnd returns the notify end states and types of a downtime, with the documented defaults when they
+// aren't set in the spec, so that removing them from the spec resets them in Datadog.
+func buildNotifyEnd(spec *v1alpha1.DatadogDowntimeSpec) ([]datadogV2.DowntimeNotifyEndStateTypes, []datadogV2.DowntimeNotifyEndStateActions) {
+	states := []datadogV2.DowntimeNotifyEndStateTypes{
+		datadogV2.DOWNTIMENOTIFYENDSTATETYPES_ALERT,
+		datadogV2.DOWNTIMENOTIFYENDSTATETYPES_NO_DATA,
+		datadogV2.DOWNTIMENOTIFYENDSTATETYPES_WARN,
+	}
+	if len(spec.NotifyEndStates) > 0 {
+		states = make([]datadogV2.DowntimeNotifyEndStateTypes, 0, len(spec.NotifyEndStates))
+		for _, state := range spec.NotifyEndStates {
+			states = append(states, datadogV2.DowntimeNotifyEndStateTypes(state))
+		}
+	}
+	types := []datadogV2.DowntimeNotifyEndStateActions{datadogV2.DOWNTIMENOTIFYENDSTATEACTIONS_EXPIRED}
+	if len(spec.NotifyEndTypes) > 0 {
+		types = make([]datadogV2.DowntimeNotifyEndStateActions, 0, len(spec.NotifyEndTypes))
+		for _, endType := range spec.NotifyEndTypes {
+			types = append(types, datadogV2.DowntimeNotifyEndStateActions(endType))
+		}
+	}
+	return states, types
+}
+
+func buildDowntimeCreateRequest(crdDowntime *v1alpha1.DatadogDowntime, monitorIdentifier datadogV2.DowntimeMonitorIdentifier) *datadogV2.DowntimeCreateRequest {
+	spec := &crdDowntime.Spec
+	attributes := datadogV2.NewDowntimeCreateRequestAttributes(monitorIdentifier, buildScope(spec))
+	if spec.Message != nil {
+		attributes.SetMessage(*spec.Message)
+	}
+	if spec.DisplayTimezone != "" {
+		attributes.SetDisplayTimezone(spec.DisplayTimezone)
+	}
+	if spec.MuteFirstRecoveryNotification != nil {
+		attributes.SetMuteFirstRecoveryNotification(*spec.MuteFirstRecoveryNotification)
+	}
+	states, types := buildNotifyEnd(spec)
+	attributes.SetNotifyEndStates(states)
+	attributes.SetNotifyEndTypes(types)
+
+	if schedule := spec.Schedule; schedule != nil {
+		if len(schedule.Recurrences) > 0 {
+			recurrences := datadogV2.NewDowntimeScheduleRecurrencesCreateRequest(buildRecurrences(schedule))
+			if schedule.Timezone != "" {
+				recurrences.SetTimezone(schedule.Timezone)
+			}
+			attributes.SetSchedule(datadogV2.DowntimeScheduleRecurrencesCreateRequestAsDowntimeScheduleCreateRequest(recurrences))
+		} else {
+			attributes.SetSchedule(datadogV2.DowntimeScheduleOneTimeCreateUpdateRequestAsDowntimeScheduleCreateRequest(buildOneTimeSchedule(schedule)))
+		}
+	}
+
+	return datadogV2.NewDowntimeCreateRequest(*datadogV2.NewDowntimeCreateRequestData(*attributes, datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME))
+}
+
+func buildDowntimeUpdateRequest(crdDowntime *v1alpha1.DatadogDowntime, downtimeID string, monitorIdentifier datadogV2.DowntimeMonitorIdentifier) *datadogV2.DowntimeUpdateRequest {
+	spec := &crdDowntime.Spec
+	attributes := datadogV2.NewDowntimeUpdateRequestAttributes()
+	attributes.SetMonitorIdentifier(monitorIdentifier)
+	attributes.SetScope(buildScope(spec))
+	// Optional fields are explicitly unset so that removing them from the spec removes them from the downtime
+	if spec.Message != nil {
+		attributes.SetMessage(*spec.Message)
+	} else {
+		attributes.SetMessageNil()
+	}
+	if spec.DisplayTimezone != "" {
+		attributes.SetDisplayTimezone(spec.DisplayTimezone)
+	} else {
+		attributes.SetDisplayTimezoneNil()
+	}
+	attributes.SetMuteFirstRecoveryNotification(spec.MuteFirstRecoveryNotification != nil && *spec.MuteFirstRecoveryNotification)
+	states, types := buildNotifyEnd(spec)
+	attributes.SetNotifyEndStates(states)
+	attributes.SetNotifyEndTypes(types)
+
+	if schedule := spec.Schedule; schedule != nil {
+		if len(schedule.Recurrences) > 0 {
+			recurrences := datadogV2.NewDowntimeScheduleRecurrencesUpdateRequest()
+			recurrences.SetRecurrences(buildRecurrences(schedule))
+			if schedule.Timezone != "" {
+				recurrences.SetTimezone(schedule.Timezone)
+			}
+			attributes.SetSchedule(datadogV2.DowntimeScheduleRecurrencesUpdateRequestAsDowntimeScheduleUpdateRequest(recurrences))
+		} else {
+			attributes.SetSchedule(datadogV2.DowntimeScheduleOneTimeCreateUpdateRequestAsDowntimeScheduleUpdateRequest(buildOneTimeSchedule(schedule)))
+		}
+	}
+
+	return datadogV2.NewDowntimeUpdateRequest(*datadogV2.NewDowntimeUpdateRequestData(*attributes, downtimeID, datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME))
+}
+
+func buildOneTimeSchedule(schedule *v1alpha1.DatadogDowntimeSchedule) *datadogV2.DowntimeScheduleOneTimeCreateUpdateRequest {
+	oneTime := datadogV2.NewDowntimeScheduleOneTimeCreateUpdateRequest()
+	if schedule.Start != nil {
+		oneTime.SetStart(schedule.Start.Time)
+	}
+	// A null end means the downtime never ends
+	if schedule.End != nil {
+		oneTime.SetEnd(schedule.End.Time)
+	} else {
+		oneTime.SetEndNil()
+	}
+	return oneTime
+}
+
+// getDowntimeState returns the state of the downtime and the bounds of its current (or next) occurrence
+func getDowntimeState(downtime *datadogV2.DowntimeResponseData) (v1alpha1.DatadogDowntimeState, *metav1.Time, *metav1.Time) {
+	if downtime == nil || downtime.Attributes == nil {
+		return "", nil, nil
+	}
+	attributes := downtime.Attributes
+
+	var state v1alpha1.DatadogDowntimeState
+	switch attributes.GetStatus() {
+	case datadogV2.DOWNTIMESTATUS_ACTIVE:
+		state = v1alpha1.DatadogDowntimeStateActive
+	case datadogV2.DOWNTIMESTATUS_SCHEDULED:
+		state = v1alpha1.DatadogDowntimeStateScheduled
+	case datadogV2.DOWNTIMESTATUS_ENDED:
+		state = v1alpha1.DatadogDowntimeStateExpired
+	case datadogV2.DOWNTIMESTATUS_CANCELED:
+		state = v1alpha1.DatadogDowntimeStateCanceled
+	}
+
+	var start, end *time.Time
+	if schedule := attributes.Schedule; schedule != nil {
+		if oneTime := schedule.DowntimeScheduleOneTimeResponse; oneTime != nil {
+			start = &oneTime.Start
+			end = oneTime.End.Get()
+		} else if recurrences := schedule.DowntimeScheduleRecurrencesResponse; recurrences != nil && recurrences.CurrentDowntime != nil {
+			start = recurrences.CurrentDowntime.Start
+			end = recurrences.CurrentDowntime.End.Get()
+		}
+	}
+
+	return state, toMetaTime(start), toMetaTime(end)
+}
+
+// getDowntimesState returns the state of the downtimes of a DatadogDowntime, which share their schedule: they're
+// canceled as soon as one of them is canceled.
+func getDowntimesState(downtimes []datadogV2.DowntimeResponseData) (v1alpha1.DatadogDowntimeState, *metav1.Time, *metav1.Time) {
+	for i := range downtimes {
+		if state, start, end := getDowntimeState(&downtimes[i]); state == v1alpha1.DatadogDowntimeStateCanceled {
+			return state, start, end
+		}
+	}
+	if len(downtimes) == 0 {
+		return "", nil, nil
+	}
+	return getDowntimeState(&downtimes[0])
+}
+
+func isEnded(state v1alpha1.DatadogDowntimeState) bool {
+	return state == v1alpha1.DatadogDowntimeStateExpired || state == v1alpha1.DatadogDowntimeStateCanceled
+}
+
+func toMetaTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	metaTime := metav1.NewTime(*t)
+	return &metaTime
+}
+
+// createDowntimes creates the downtimes of a DatadogDowntime. The downtimes already created are canceled when one of
+// them can't be created, so that they're all created again on the next sync.
+func createDowntimes(auth context.Context, client *datadogV2.DowntimesApi, crdDowntime *v1alpha1.DatadogDowntime) ([]datadogV2.DowntimeResponseData, error) {
+	identifiers := buildMonitorIdentifiers(&crdDowntime.Spec, crdDowntime.Status.MonitorIDs)
+	downtimes := make([]datadogV2.DowntimeResponseData, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		downtime, _, err := client.CreateDowntime(auth, *buildDowntimeCreateRequest(crdDowntime, identifier))
+		if err != nil {
+			for _, created := range downtimes {
+				_ = cancelDowntime(auth, client, created.GetId())
+			}
+			return nil, datadogclient.TranslateClientError(err, "error creating downtime")
+		}
+		downtimes = append(downtimes, downtime.GetData())
+	}
+
+	return downtimes, nil
+}
+
+func getDowntime(auth context.Context, client *datadogV2.DowntimesApi, downtimeID string) (datadogV2.DowntimeResponseData, error) {
+	downtime, _, err := client.GetDowntime(auth, downtimeID)
+	if err != nil {
+		return datadogV2.DowntimeResponseData{}, datadogclient.TranslateClientError(err, "error getting downtime")
+	}
+
+	return downtime.GetData(), nil
+}
+
+// getDowntimes gets the downtimes of a DatadogDowntime, id is the ID in its status.
+func getDowntimes(auth context.Context, client *datadogV2.DowntimesApi, id string) ([]datadogV2.DowntimeResponseData, error) {
+	ids := downtimeIDs(id)
+	downtimes := make([]datadogV2.DowntimeResponseData, 0, len(ids))
+	for _, downtimeID := range ids {
+		downtime, err := getDowntime(auth, client, downtimeID)
+		if err != nil {
+			return nil, err
+		}
+		downtimes = append(downtimes, downtime)
+	}
+
+	return downtimes, nil
+}
+
+// updateDowntimes updates the downtimes of a DatadogDowntime, which must mute as many monitors as when they were
+// created.
+func updateDowntimes(auth context.Context, client *datadogV2.DowntimesApi, crdDowntime *v1alpha1.DatadogDowntime) ([]datadogV2.DowntimeResponseData, error) {
+	ids := downtimeIDs(crdDowntime.Status.ID)
+	identifiers := buildMonitorIdentifiers(&crdDowntime.Spec, crdDowntime.Status.MonitorIDs)
+	if len(ids) != len(identifiers) {
+		return nil, fmt.Errorf("error updating downtime: %d downtimes can't mute %d monitors", len(ids), len(identifiers))
+	}
+
+	downtimes := make([]datadogV2.DowntimeResponseData, 0, len(ids))
+	for i, downtimeID := range ids {
+		downtimeReq := buildDowntimeUpdateRequest(crdDowntime, downtimeID, identifiers[i])
+		downtime, _, err := client.UpdateDowntime(auth, downtimeID, *downtimeReq)
+		if err != nil {
+			return nil, datadogclient.TranslateClientError(err, "error updating downtime")
+		}
+		downtimes = append(downtimes, downtime.GetData())
+	}
+
+	return downtimes, nil
+}
+
+func cancelDowntime(auth context.Context, client *datadogV2.DowntimesApi, downtimeID string) error {
+	if _, err := client.CancelDowntime(auth, downtimeID); err != nil {
+		return datadogclient.TranslateClientError(err, "error canceling downtime")
+	}
+	return nil
+}
+
+// cancelDowntimes cancels the downtimes of a DatadogDowntime that haven't ended, id is the ID in its status. Expired and
+// canceled downtimes can't be canceled again, and missing ones are ignored.
+func cancelDowntimes(auth context.Context, client *datadogV2.DowntimesApi, id string) error {
+	for _, downtimeID := range downtimeIDs(id) {
+		downtime, err := getDowntime(auth, client, downtimeID)
+		if datadogclient.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if state, _, _ := getDowntimeState(&downtime); isEnded(state) {
+			continue
+		}
+		if err = cancelDowntime(auth, client, downtimeID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
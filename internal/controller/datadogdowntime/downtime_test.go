@@ -0,0 +1,285 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"testing"
+	"time"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_buildScope(t *testing.T) {
+	tests := []struct {
+		name string
+		spec v1alpha1.DatadogDowntimeSpec
+		want string
+	}{
+		{
+			name: "empty spec",
+			spec: v1alpha1.DatadogDowntimeSpec{},
+			want: "*",
+		},
+		{
+			name: "datadog scope only",
+			spec: v1alpha1.DatadogDowntimeSpec{Scope: "env:prod AND service:foo"},
+			want: "env:prod AND service:foo",
+		},
+		{
+			name: "kubernetes scope only",
+			spec: v1alpha1.DatadogDowntimeSpec{
+				KubernetesScope: &v1alpha1.DatadogDowntimeKubernetesScope{
+					ClusterName: "my-cluster",
+					Namespaces:  []string{"foo", "bar"},
+					Deployments: []string{"app"},
+				},
+			},
+			want: "kube_cluster_name:my-cluster AND kube_namespace:(foo OR bar) AND kube_deployment:app",
+		},
+		{
+			name: "datadog and kubernetes scopes",
+			spec: v1alpha1.DatadogDowntimeSpec{
+				Scope: "env:prod OR env:staging",
+				KubernetesScope: &v1alpha1.DatadogDowntimeKubernetesScope{
+					StatefulSets: []string{"db"},
+					DaemonSets:   []string{"agent"},
+				},
+			},
+			want: "(env:prod OR env:staging) AND kube_stateful_set:db AND kube_daemon_set:agent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildScope(&tt.spec))
+		})
+	}
+}
+
+func Test_buildMonitorIdentifiers(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       v1alpha1.DatadogDowntimeSpec
+		monitorIDs []int64
+		want       []datadogV2.DowntimeMonitorIdentifier
+	}{
+		{
+			name: "all monitors",
+			spec: v1alpha1.DatadogDowntimeSpec{},
+			want: []datadogV2.DowntimeMonitorIdentifier{
+				datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierTags([]string{"*"})),
+			},
+		},
+		{
+			name: "monitor tags",
+			spec: v1alpha1.DatadogDowntimeSpec{MonitorTags: []string{"team:foo", "env:prod"}},
+			want: []datadogV2.DowntimeMonitorIdentifier{
+				datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierTags([]string{"team:foo", "env:prod"})),
+			},
+		},
+		{
+			name:       "monitors",
+			spec:       v1alpha1.DatadogDowntimeSpec{MonitorRefs: []v1alpha1.DatadogDowntimeMonitorReference{{Name: "foo"}, {Name: "bar"}}},
+			monitorIDs: []int64{12345, 67890},
+			want: []datadogV2.DowntimeMonitorIdentifier{
+				datadogV2.DowntimeMonitorIdentifierIdAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierId(12345)),
+				datadogV2.DowntimeMonitorIdentifierIdAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierId(67890)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildMonitorIdentifiers(&tt.spec, tt.monitorIDs))
+		})
+	}
+}
+
+func Test_buildDowntimeCreateRequest(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	message := "maintenance"
+
+	t.Run("one-time downtime", func(t *testing.T) {
+		downtime := &v1alpha1.DatadogDowntime{
+			Spec: v1alpha1.DatadogDowntimeSpec{
+				Message:         &message,
+				Schedule:        &v1alpha1.DatadogDowntimeSchedule{Start: &start},
+				NotifyEndStates: []v1alpha1.DatadogDowntimeNotifyEndState{v1alpha1.DatadogDowntimeNotifyEndStateAlert},
+			},
+		}
+
+		attributes := buildDowntimeCreateRequest(downtime, datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierTags([]string{"*"}))).Data.Attributes
+		assert.Equal(t, "*", attributes.Scope)
+		assert.Equal(t, message, attributes.GetMessage())
+		assert.Equal(t, []datadogV2.DowntimeNotifyEndStateTypes{datadogV2.DOWNTIMENOTIFYENDSTATETYPES_ALERT}, attributes.NotifyEndStates)
+		assert.Equal(t, []datadogV2.DowntimeNotifyEndStateActions{datadogV2.DOWNTIMENOTIFYENDSTATEACTIONS_EXPIRED}, attributes.NotifyEndTypes)
+
+		oneTime := attributes.Schedule.DowntimeScheduleOneTimeCreateUpdateRequest
+		assert.NotNil(t, oneTime)
+		assert.Equal(t, start.Time, *oneTime.Start.Get())
+		assert.True(t, oneTime.End.IsSet())
+		assert.Nil(t, oneTime.End.Get())
+	})
+
+	t.Run("recurring downtime", func(t *testing.T) {
+		downtime := &v1alpha1.DatadogDowntime{
+			Spec: v1alpha1.DatadogDowntimeSpec{
+				Schedule: &v1alpha1.DatadogDowntimeSchedule{
+					Recurrences: []v1alpha1.DatadogDowntimeRecurrence{
+						{Duration: "1h", Rrule: "FREQ=DAILY", Start: "2024-05-01T10:00"},
+					},
+					Timezone: "Europe/Paris",
+				},
+			},
+		}
+
+		attributes := buildDowntimeCreateRequest(downtime, datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierTags([]string{"*"}))).Data.Attributes
+		assert.False(t, attributes.Message.IsSet())
+
+		recurrences := attributes.Schedule.DowntimeScheduleRecurrencesCreateRequest
+		assert.NotNil(t, recurrences)
+		assert.Equal(t, "Europe/Paris", recurrences.GetTimezone())
+		assert.Len(t, recurrences.Recurrences, 1)
+		assert.Equal(t, "1h", recurrences.Recurrences[0].Duration)
+		assert.Equal(t, "FREQ=DAILY", recurrences.Recurrences[0].Rrule)
+		assert.Equal(t, "2024-05-01T10:00", recurrences.Recurrences[0].GetStart())
+	})
+}
+
+func Test_buildDowntimeUpdateRequest(t *testing.T) {
+	message := "maintenance"
+	muteFirstRecoveryNotification := true
+
+	t.Run("optional fields set", func(t *testing.T) {
+		downtime := &v1alpha1.DatadogDowntime{
+			Spec: v1alpha1.DatadogDowntimeSpec{
+				Message:                       &message,
+				DisplayTimezone:               "Europe/Paris",
+				MuteFirstRecoveryNotification: &muteFirstRecoveryNotification,
+				NotifyEndStates:               []v1alpha1.DatadogDowntimeNotifyEndState{v1alpha1.DatadogDowntimeNotifyEndStateAlert},
+				NotifyEndTypes:                []v1alpha1.DatadogDowntimeNotifyEndType{v1alpha1.DatadogDowntimeNotifyEndTypeCanceled},
+			},
+			Status: v1alpha1.DatadogDowntimeStatus{ID: "00000000-0000-0000-0000-000000000001"},
+		}
+
+		request := buildDowntimeUpdateRequest(downtime, downtime.Status.ID, datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierTags([]string{"*"})))
+		assert.Equal(t, "00000000-0000-0000-0000-000000000001", request.Data.Id)
+		attributes := request.Data.Attributes
+		assert.Equal(t, message, attributes.GetMessage())
+		assert.Equal(t, "Europe/Paris", attributes.GetDisplayTimezone())
+		assert.True(t, attributes.GetMuteFirstRecoveryNotification())
+		assert.Equal(t, []datadogV2.DowntimeNotifyEndStateTypes{datadogV2.DOWNTIMENOTIFYENDSTATETYPES_ALERT}, attributes.NotifyEndStates)
+		assert.Equal(t, []datadogV2.DowntimeNotifyEndStateActions{datadogV2.DOWNTIMENOTIFYENDSTATEACTIONS_CANCELED}, attributes.NotifyEndTypes)
+	})
+
+	t.Run("optional fields removed from the spec", func(t *testing.T) {
+		downtime := &v1alpha1.DatadogDowntime{
+			Status: v1alpha1.DatadogDowntimeStatus{ID: "00000000-0000-0000-0000-000000000001"},
+		}
+
+		attributes := buildDowntimeUpdateRequest(downtime, downtime.Status.ID, datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierTags([]string{"*"}))).Data.Attributes
+		assert.True(t, attributes.Message.IsSet())
+		assert.Nil(t, attributes.Message.Get())
+		assert.True(t, attributes.DisplayTimezone.IsSet())
+		assert.Nil(t, attributes.DisplayTimezone.Get())
+		assert.NotNil(t, attributes.MuteFirstRecoveryNotification)
+		assert.False(t, *attributes.MuteFirstRecoveryNotification)
+		assert.Equal(t, []datadogV2.DowntimeNotifyEndStateTypes{
+			datadogV2.DOWNTIMENOTIFYENDSTATETYPES_ALERT,
+			datadogV2.DOWNTIMENOTIFYENDSTATETYPES_NO_DATA,
+			datadogV2.DOWNTIMENOTIFYENDSTATETYPES_WARN,
+		}, attributes.NotifyEndStates)
+		assert.Equal(t, []datadogV2.DowntimeNotifyEndStateActions{datadogV2.DOWNTIMENOTIFYENDSTATEACTIONS_EXPIRED}, attributes.NotifyEndTypes)
+	})
+}
+
+func Test_getDowntimeState(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	metaStart := metav1.NewTime(start)
+	metaEnd := metav1.NewTime(end)
+
+	tests := []struct {
+		name      string
+		downtime  *datadogV2.DowntimeResponseData
+		wantState v1alpha1.DatadogDowntimeState
+		wantStart *metav1.Time
+		wantEnd   *metav1.Time
+	}{
+		{
+			name:     "no attributes",
+			downtime: &datadogV2.DowntimeResponseData{},
+		},
+		{
+			name: "active one-time downtime",
+			downtime: &datadogV2.DowntimeResponseData{
+				Attributes: &datadogV2.DowntimeResponseAttributes{
+					Status: datadogV2.DOWNTIMESTATUS_ACTIVE.Ptr(),
+					Schedule: &datadogV2.DowntimeScheduleResponse{
+						DowntimeScheduleOneTimeResponse: &datadogV2.DowntimeScheduleOneTimeResponse{
+							Start: start,
+							End:   *datadogapi.NewNullableTime(&end),
+						},
+					},
+				},
+			},
+			wantState: v1alpha1.DatadogDowntimeStateActive,
+			wantStart: &metaStart,
+			wantEnd:   &metaEnd,
+		},
+		{
+			name: "scheduled recurring downtime",
+			downtime: &datadogV2.DowntimeResponseData{
+				Attributes: &datadogV2.DowntimeResponseAttributes{
+					Status: datadogV2.DOWNTIMESTATUS_SCHEDULED.Ptr(),
+					Schedule: &datadogV2.DowntimeScheduleResponse{
+						DowntimeScheduleRecurrencesResponse: &datadogV2.DowntimeScheduleRecurrencesResponse{
+							CurrentDowntime: &datadogV2.DowntimeScheduleCurrentDowntimeResponse{
+								Start: &start,
+								End:   *datadogapi.NewNullableTime(&end),
+							},
+						},
+					},
+				},
+			},
+			wantState: v1alpha1.DatadogDowntimeStateScheduled,
+			wantStart: &metaStart,
+			wantEnd:   &metaEnd,
+		},
+		{
+			name: "ended downtime",
+			downtime: &datadogV2.DowntimeResponseData{
+				Attributes: &datadogV2.DowntimeResponseAttributes{
+					Status: datadogV2.DOWNTIMESTATUS_ENDED.Ptr(),
+				},
+			},
+			wantState: v1alpha1.DatadogDowntimeStateExpired,
+		},
+		{
+			name: "canceled downtime",
+			downtime: &datadogV2.DowntimeResponseData{
+				Attributes: &datadogV2.DowntimeResponseAttributes{
+					Status: datadogV2.DOWNTIMESTATUS_CANCELED.Ptr(),
+				},
+			},
+			wantState: v1alpha1.DatadogDowntimeStateCanceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, start, end := getDowntimeState(tt.downtime)
+			assert.Equal(t, tt.wantState, state)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+
+	"github.com/DataDog/datadog-operator/internal/controller/datadogdowntime"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+type DatadogDowntimeReconciler struct {
+	Client         client.Client
+	DDClient       datadogclient.DatadogDowntimeClient
+	VersionInfo    *version.Info
+	Log            logr.Logger
+	Scheme         *runtime.Scheme
+	Recorder       record.EventRecorder
+	DeletionPolicy v1alpha1.DeletionPolicy
+	internal       *datadogdowntime.Reconciler
+}
+
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogdowntimes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogdowntimes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogdowntimes/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitors,verbs=get;list;watch
+
+// Reconcile loop for Datadog Downtime
+func (r *DatadogDowntimeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internal.Reconcile(ctx, req)
+}
+
+func (r *DatadogDowntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.internal = datadogdowntime.NewReconciler(r.Client, r.DDClient, r.VersionInfo, r.Log, r.Recorder, r.DeletionPolicy)
+
+	// The downtimes muting a DatadogMonitor are reconciled when the monitor ID changes, once created in Datadog, and when
+	// its labels change, to follow the monitor selectors
+	monitorPredicate := predicate.Or(predicate.LabelChangedPredicate{}, predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldMonitor, oldOK := e.ObjectOld.(*v1alpha1.DatadogMonitor)
+			newMonitor, newOK := e.ObjectNew.(*v1alpha1.DatadogMonitor)
+			return !oldOK || !newOK || oldMonitor.Status.ID != newMonitor.Status.ID
+		},
+	})
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DatadogDowntime{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(&v1alpha1.DatadogMonitor{}, handler.EnqueueRequestsFromMapFunc(r.enqueueMutingDowntimes), builder.WithPredicates(monitorPredicate)).
+		Complete(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// enqueueMutingDowntimes enqueues the DatadogDowntimes referencing or selecting a DatadogMonitor.
+func (r *DatadogDowntimeReconciler) enqueueMutingDowntimes(ctx context.Context, obj client.Object) []reconcile.Request {
+	downtimeList := &v1alpha1.DatadogDowntimeList{}
+	if err := r.Client.List(ctx, downtimeList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "unable to list DatadogDowntimes")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range downtimeList.Items {
+		if datadogdowntime.MutesMonitor(&downtimeList.Items[i], obj) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: downtimeList.Items[i].Namespace, Name: downtimeList.Items[i].Name},
+			})
+		}
+	}
+	return requests
+}
+
+var _ reconcile.Reconciler = (*DatadogDowntimeReconciler)(nil)
@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/types"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// compositeOperandRegexp matches the operands of a composite monitor query, which are either
+// Datadog monitor IDs or DatadogMonitor names.
+var compositeOperandRegexp = regexp.MustCompile(`[a-z0-9]([-a-z0-9.]*[a-z0-9])?`)
+
+// resolveCompositeQuery returns the query of a composite monitor where the referenced DatadogMonitors
+// are replaced by their monitor IDs. It returns an error if a referenced DatadogMonitor doesn't exist
+// or isn't created in Datadog yet, so that a composite monitor is only created after its references.
+// The query of other monitor types is returned as is.
+func (a *adapter) resolveCompositeQuery(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) (string, error) {
+	if dm.Spec.Type != datadoghqv1alpha1.DatadogMonitorTypeComposite {
+		return dm.Spec.Query, nil
+	}
+
+	var errs []error
+	query := compositeOperandRegexp.ReplaceAllStringFunc(dm.Spec.Query, func(operand string) string {
+		if _, err := strconv.ParseInt(operand, 10, 64); err == nil {
+			// Already a monitor ID
+			return operand
+		}
+		if operand == dm.Name {
+			errs = append(errs, fmt.Errorf("composite monitor %s can't reference itself", dm.Name))
+			return operand
+		}
+
+		ref := &datadoghqv1alpha1.DatadogMonitor{}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: dm.Namespace, Name: operand}, ref); err != nil {
+			errs = append(errs, fmt.Errorf("unable to get referenced DatadogMonitor %s: %w", operand, err))
+			return operand
+		}
+		if ref.Status.ID == 0 {
+			errs = append(errs, fmt.Errorf("referenced DatadogMonitor %s is not created yet", operand))
+			return operand
+		}
+
+		return strconv.Itoa(ref.Status.ID)
+	})
+
+	return query, utilserrors.NewAggregate(errs)
+}
+
+// withQuery returns the DatadogMonitor to sync with Datadog, using the given query.
+func withQuery(dm *datadoghqv1alpha1.DatadogMonitor, query string) *datadoghqv1alpha1.DatadogMonitor {
+	if dm.Spec.Query == query {
+		return dm
+	}
+	resolved := dm.DeepCopy()
+	resolved.Spec.Query = query
+
+	return resolved
+}
@@ -0,0 +1,101 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_resolveCompositeQuery(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(datadoghqv1alpha1.GroupVersion, &datadoghqv1alpha1.DatadogMonitor{})
+
+	existingMonitors := []*datadoghqv1alpha1.DatadogMonitor{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: "monitor-a"},
+			Status:     datadoghqv1alpha1.DatadogMonitorStatus{ID: 123},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: "monitor-b.v2"},
+			Status:     datadoghqv1alpha1.DatadogMonitorStatus{ID: 456},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: "monitor-pending"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "monitor-other"},
+			Status:     datadoghqv1alpha1.DatadogMonitorStatus{ID: 789},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		spec      datadoghqv1alpha1.DatadogMonitorSpec
+		wantQuery string
+		wantErr   string
+	}{
+		{
+			name:      "not a composite monitor",
+			spec:      datadoghqv1alpha1.DatadogMonitorSpec{Type: datadoghqv1alpha1.DatadogMonitorTypeMetric, Query: "avg(last_5m):avg:foo{a} > 1"},
+			wantQuery: "avg(last_5m):avg:foo{a} > 1",
+		},
+		{
+			name:      "monitor IDs only",
+			spec:      datadoghqv1alpha1.DatadogMonitorSpec{Type: datadoghqv1alpha1.DatadogMonitorTypeComposite, Query: "111 && !222"},
+			wantQuery: "111 && !222",
+		},
+		{
+			name:      "monitor names and IDs",
+			spec:      datadoghqv1alpha1.DatadogMonitorSpec{Type: datadoghqv1alpha1.DatadogMonitorTypeComposite, Query: "(monitor-a || monitor-b.v2) && !111"},
+			wantQuery: "(123 || 456) && !111",
+		},
+		{
+			name:    "referenced monitor not created yet",
+			spec:    datadoghqv1alpha1.DatadogMonitorSpec{Type: datadoghqv1alpha1.DatadogMonitorTypeComposite, Query: "monitor-a && monitor-pending"},
+			wantErr: "referenced DatadogMonitor monitor-pending is not created yet",
+		},
+		{
+			name:    "referenced monitor in another namespace",
+			spec:    datadoghqv1alpha1.DatadogMonitorSpec{Type: datadoghqv1alpha1.DatadogMonitorTypeComposite, Query: "monitor-a && monitor-other"},
+			wantErr: "unable to get referenced DatadogMonitor monitor-other",
+		},
+		{
+			name:    "self reference",
+			spec:    datadoghqv1alpha1.DatadogMonitorSpec{Type: datadoghqv1alpha1.DatadogMonitorTypeComposite, Query: "monitor-a && " + resourcesName},
+			wantErr: "composite monitor foo can't reference itself",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(s)
+			for _, m := range existingMonitors {
+				builder = builder.WithObjects(m.DeepCopy())
+			}
+			a := &adapter{client: builder.Build()}
+
+			dm := &datadoghqv1alpha1.DatadogMonitor{
+				ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: resourcesName},
+				Spec:       tt.spec,
+			}
+			query, err := a.resolveCompositeQuery(context.TODO(), dm)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+		})
+	}
+}
@@ -9,343 +9,349 @@ import (
 	"context"
 	"fmt"
 	"sort"
-	"strings"
-	"time"
+	"strconv"
+	"sync"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
-	apiequality "k8s.io/apimachinery/pkg/api/equality"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/tools/record"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadogV2 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	apiutils "github.com/DataDog/datadog-operator/api/utils"
-	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/internal/controller/apiresource"
+	ctrlutils "github.com/DataDog/datadog-operator/internal/controller/utils"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
 	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 	"github.com/DataDog/datadog-operator/pkg/utils"
 )
 
 const (
-	defaultRequeuePeriod    = 60 * time.Second
-	defaultErrRequeuePeriod = 5 * time.Second
-	defaultForceSyncPeriod  = 60 * time.Minute
+	defaultRequeuePeriod    = apiresource.DefaultRequeuePeriod
+	defaultErrRequeuePeriod = apiresource.DefaultErrRequeuePeriod
 	maxTriggeredStateGroups = 10
+	datadogMonitorKind      = "DatadogMonitor"
+	datadogMonitorFinalizer = "finalizer.monitor.datadoghq.com"
 )
 
 var supportedMonitorTypes = map[string]bool{
-	string(datadogV1.MONITORTYPE_METRIC_ALERT):          true,
-	string(datadogV1.MONITORTYPE_QUERY_ALERT):           true,
-	string(datadogV1.MONITORTYPE_SERVICE_CHECK):         true,
-	string(datadogV1.MONITORTYPE_EVENT_ALERT):           true,
-	string(datadogV1.MONITORTYPE_LOG_ALERT):             true,
-	string(datadogV1.MONITORTYPE_PROCESS_ALERT):         true,
-	string(datadogV1.MONITORTYPE_RUM_ALERT):             true,
-	string(datadogV1.MONITORTYPE_TRACE_ANALYTICS_ALERT): true,
-	string(datadogV1.MONITORTYPE_SLO_ALERT):             true,
-	string(datadogV1.MONITORTYPE_EVENT_V2_ALERT):        true,
-	string(datadogV1.MONITORTYPE_AUDIT_ALERT):           true,
-}
-
-const requiredTag = "generated:kubernetes"
-
-// Reconciler reconciles a DatadogMonitor object
-type Reconciler struct {
-	client        client.Client
-	datadogClient *datadogV1.MonitorsApi
-	datadogAuth   context.Context
-	versionInfo   *version.Info
-	log           logr.Logger
-	scheme        *runtime.Scheme
-	recorder      record.EventRecorder
+	string(datadogV1.MONITORTYPE_METRIC_ALERT):              true,
+	string(datadogV1.MONITORTYPE_QUERY_ALERT):               true,
+	string(datadogV1.MONITORTYPE_SERVICE_CHECK):             true,
+	string(datadogV1.MONITORTYPE_EVENT_ALERT):               true,
+	string(datadogV1.MONITORTYPE_LOG_ALERT):                 true,
+	string(datadogV1.MONITORTYPE_PROCESS_ALERT):             true,
+	string(datadogV1.MONITORTYPE_RUM_ALERT):                 true,
+	string(datadogV1.MONITORTYPE_TRACE_ANALYTICS_ALERT):     true,
+	string(datadogV1.MONITORTYPE_SLO_ALERT):                 true,
+	string(datadogV1.MONITORTYPE_EVENT_V2_ALERT):            true,
+	string(datadogV1.MONITORTYPE_AUDIT_ALERT):               true,
+	string(datadogV1.MONITORTYPE_COMPOSITE):                 true,
+	string(datadogV1.MONITORTYPE_SYNTHETICS_ALERT):          true,
+	string(datadogV1.MONITORTYPE_CI_PIPELINES_ALERT):        true,
+	string(datadogV1.MONITORTYPE_CI_TESTS_ALERT):            true,
+	string(datadogV1.MONITORTYPE_DATABASE_MONITORING_ALERT): true,
+	string(datadogV1.MONITORTYPE_ERROR_TRACKING_ALERT):      true,
+	// Cost monitors are not part of the MonitorType enum of the API client yet
+	string(datadoghqv1alpha1.DatadogMonitorTypeCost): true,
 }
 
+// Reconciler reconciles DatadogMonitors.
+type Reconciler = apiresource.Reconciler[*datadoghqv1alpha1.DatadogMonitor, datadogV1.Monitor]
+
 // NewReconciler returns a new Reconciler object
-func NewReconciler(client client.Client, ddClient datadogclient.DatadogMonitorClient, versionInfo *version.Info, scheme *runtime.Scheme, log logr.Logger, recorder record.EventRecorder) (*Reconciler, error) {
-	return &Reconciler{
-		client:        client,
-		datadogClient: ddClient.Client,
-		datadogAuth:   ddClient.Auth,
-		versionInfo:   versionInfo,
-		scheme:        scheme,
-		log:           log,
-		recorder:      recorder,
-	}, nil
-}
-
-// Reconcile is similar to reconciler.Reconcile interface, but taking a context
-func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
-	return r.internalReconcile(ctx, request)
-}
-
-// Reconcile loop for DatadogMonitor
-func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-	logger := r.log.WithValues("datadogmonitor", req.NamespacedName)
-	logger.Info("Reconciling DatadogMonitor")
-	now := metav1.NewTime(time.Now())
-
-	// Get instance
-	instance := &datadoghqv1alpha1.DatadogMonitor{}
-	var result ctrl.Result
-	err := r.client.Get(ctx, req.NamespacedName, instance)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			// Request object not found, could have been deleted after reconcile request.
-			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
-			// Return and don't requeue
-			return ctrl.Result{}, nil
+func NewReconciler(client client.Client, apiReader client.Reader, ddClient datadogclient.DatadogMonitorClient, versionInfo *version.Info, scheme *runtime.Scheme, log logr.Logger, recorder record.EventRecorder, deletionPolicy datadoghqv1alpha1.DeletionPolicy, namespaceTags ctrlutils.NamespaceTags) (*Reconciler, error) {
+	return apiresource.NewReconciler(client, apiReader, &adapter{
+		client:         client,
+		apiReader:      apiReader,
+		datadogClient:  ddClient.Client,
+		downtimeClient: ddClient.DowntimeClient,
+		datadogAuth:    ddClient.Auth,
+		scheme:         scheme,
+		log:            log,
+	}, versionInfo, log, recorder, deletionPolicy, namespaceTags), nil
+}
 
-		}
-		// Error reading the object - return error so it gets requeued
-		return ctrl.Result{}, err
-	}
+// adapter adapts DatadogMonitors to the apiresource.Reconciler.
+type adapter struct {
+	client         client.Client
+	apiReader      client.Reader
+	datadogClient  *datadogV1.MonitorsApi
+	downtimeClient *datadogV2.DowntimesApi
+	datadogAuth    context.Context
+	scheme         *runtime.Scheme
+	log            logr.Logger
+	// observedGroups holds the DatadogMonitorGroups built by Observe until Refresh stores them, by DatadogMonitor.
+	observedGroups sync.Map
+}
 
-	newStatus := instance.Status.DeepCopy()
+var (
+	_ apiresource.Adapter[*datadoghqv1alpha1.DatadogMonitor, datadogV1.Monitor]  = (*adapter)(nil)
+	_ apiresource.Orphaner[*datadoghqv1alpha1.DatadogMonitor]                    = (*adapter)(nil)
+	_ apiresource.Credentialer[*datadoghqv1alpha1.DatadogMonitor]                = (*adapter)(nil)
+	_ apiresource.Resolver[*datadoghqv1alpha1.DatadogMonitor]                    = (*adapter)(nil)
+	_ apiresource.Tagger[*datadoghqv1alpha1.DatadogMonitor]                      = (*adapter)(nil)
+	_ apiresource.Adopter[*datadoghqv1alpha1.DatadogMonitor]                     = (*adapter)(nil)
+	_ apiresource.Drifter[*datadoghqv1alpha1.DatadogMonitor, datadogV1.Monitor]  = (*adapter)(nil)
+	_ apiresource.Observer[*datadoghqv1alpha1.DatadogMonitor, datadogV1.Monitor] = (*adapter)(nil)
+	_ apiresource.Refresher[*datadoghqv1alpha1.DatadogMonitor]                   = (*adapter)(nil)
+)
 
-	if result, err = r.handleFinalizer(logger, instance); ctrutils.ShouldReturn(result, err) {
-		return result, err
-	}
+func (a *adapter) Kind() string {
+	return datadogMonitorKind
+}
+
+func (a *adapter) Finalizer() string {
+	return datadogMonitorFinalizer
+}
 
-	// Validate the DatadogMonitor spec
-	if err = datadoghqv1alpha1.IsValidDatadogMonitor(&instance.Spec); err != nil {
-		logger.Error(err, "invalid DatadogMonitor spec")
+func (a *adapter) NewObject() *datadoghqv1alpha1.DatadogMonitor {
+	return &datadoghqv1alpha1.DatadogMonitor{}
+}
 
-		return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
+func (a *adapter) Status(dm *datadoghqv1alpha1.DatadogMonitor) apiresource.Status {
+	return &monitorStatus{Status: &dm.Status}
+}
+
+func (a *adapter) Validate(dm *datadoghqv1alpha1.DatadogMonitor) error {
+	if err := datadoghqv1alpha1.IsValidDatadogMonitor(&dm.Spec); err != nil {
+		return err
+	}
+	if !isSupportedMonitorType(dm.Spec.Type) {
+		return fmt.Errorf("monitor type %v not supported", dm.Spec.Type)
 	}
+	return nil
+}
+
+// HashedSpec returns the spec. It's called with the copy returned by Resolve, whose query contains the IDs of the
+// monitors referenced by a composite monitor, so that the monitor is updated when a referenced monitor is recreated.
+func (a *adapter) HashedSpec(dm *datadoghqv1alpha1.DatadogMonitor) interface{} {
+	return &dm.Spec
+}
+
+func (a *adapter) DeletionPolicy(dm *datadoghqv1alpha1.DatadogMonitor) datadoghqv1alpha1.DeletionPolicy {
+	return dm.Spec.DeletionPolicy
+}
 
-	instanceSpecHash, err := comparison.GenerateMD5ForSpec(&instance.Spec)
+func (a *adapter) CredentialsRef(dm *datadoghqv1alpha1.DatadogMonitor) *datadoghqv1alpha1.DatadogCredentialsReference {
+	return dm.Spec.CredentialsRef
+}
+
+// auth returns the authentication context of the Datadog API requests made for the monitor: the one of its credentials,
+// if any, or else the one of the operator credentials.
+func (a *adapter) auth(ctx context.Context) context.Context {
+	return datadogclient.AuthFromContext(ctx, a.datadogAuth)
+}
+
+// Resolve replaces the DatadogMonitors referenced by a composite monitor by their monitor IDs, in a copy.
+func (a *adapter) Resolve(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) (*datadoghqv1alpha1.DatadogMonitor, error) {
+	query, err := a.resolveCompositeQuery(ctx, dm)
 	if err != nil {
-		logger.Error(err, "error generating hash")
+		return nil, err
+	}
+	return withQuery(dm, query), nil
+}
 
-		return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
+// SetResolved is a no-op, the resolved query isn't stored in the status.
+func (a *adapter) SetResolved(dm, desired *datadoghqv1alpha1.DatadogMonitor) {}
+
+func (a *adapter) Tags(dm *datadoghqv1alpha1.DatadogMonitor) *[]string {
+	if apiutils.BoolValue(dm.Spec.ControllerOptions.DisableRequiredTags) {
+		return nil
 	}
+	return &dm.Spec.Tags
+}
 
-	statusSpecHash := instance.Status.CurrentHash
-
-	shouldCreate := false
-	shouldUpdate := false
-
-	// Check if we need to create the monitor, update the monitor definition, or update monitor state
-	if instance.Status.ID == 0 {
-		shouldCreate = true
-	} else {
-		var m datadogV1.Monitor
-		if instanceSpecHash != statusSpecHash {
-			// Custom resource manifest has changed, need to update the API
-			logger.V(1).Info("DatadogMonitor manifest has changed")
-			shouldUpdate = true
-		} else if instance.Status.MonitorLastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(instance.Status.MonitorLastForceSyncTime.Time)) <= 0 {
-			// Periodically force a sync with the API monitor to ensure parity
-			// Get monitor to make sure it exists before trying any updates. If it doesn't, set shouldCreate
-			m, err = r.get(instance, newStatus)
-			if err != nil {
-				logger.Error(err, "error getting monitor", "Monitor ID", instance.Status.ID)
-				if strings.Contains(err.Error(), ctrutils.NotFoundString) {
-					shouldCreate = true
-				}
-			} else {
-				shouldUpdate = true
-			}
-		} else if instance.Status.MonitorStateLastUpdateTime == nil || (defaultRequeuePeriod-now.Sub(instance.Status.MonitorStateLastUpdateTime.Time)) <= 0 {
-			// If other conditions aren't met, and we have passed the defaultRequeuePeriod, then update monitor state
-			// Get monitor to make sure it exists before trying any updates. If it doesn't, set shouldCreate
-			m, err = r.get(instance, newStatus)
-			if err != nil {
-				logger.Error(err, "error getting monitor", "Monitor ID", instance.Status.ID)
-				if strings.Contains(err.Error(), ctrutils.NotFoundString) {
-					shouldCreate = true
-				}
-			}
-			updateMonitorState(m, now, newStatus)
-		}
+// AdoptID returns the ID of the monitor the DatadogMonitor should adopt, if it has the adoption annotation and hasn't
+// adopted it yet. Once adopted, a monitor deleted in Datadog is recreated like any other.
+func (a *adapter) AdoptID(dm *datadoghqv1alpha1.DatadogMonitor) string {
+	adoptID := dm.Annotations[datadoghqv1alpha1.DatadogMonitorAdoptIDAnnotationKey]
+	if adoptID == "" || adoptID == strconv.Itoa(dm.Status.ID) {
+		return ""
 	}
 
-	// Create and update actions
-	if shouldCreate {
-		if isSupportedMonitorType(instance.Spec.Type) {
-			logger.V(1).Info("Creating monitor in Datadog")
-			// Make sure required tags are present
-			if !apiutils.BoolValue(instance.Spec.ControllerOptions.DisableRequiredTags) {
-				if result, err = r.checkRequiredTags(logger, instance); err != nil || result.Requeue {
-					return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
-				}
-			}
-			if err = r.create(logger, instance, newStatus, now, instanceSpecHash); err != nil {
-				logger.Error(err, "error creating monitor")
-			}
-		} else {
-			err = fmt.Errorf("monitor type %v not supported", instance.Spec.Type)
-			logger.Error(err, "error creating monitor")
-		}
-	} else if shouldUpdate {
-		logger.V(1).Info("Updating monitor in Datadog")
-		// Make sure required tags are present
-		if !apiutils.BoolValue(instance.Spec.ControllerOptions.DisableRequiredTags) {
-			if result, err = r.checkRequiredTags(logger, instance); err != nil || result.Requeue {
-				return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
-			}
-		}
-		if err = r.update(logger, instance, newStatus, now, instanceSpecHash); err != nil {
-			logger.Error(err, "error updating monitor", "Monitor ID", instance.Status.ID)
-		}
+	return adoptID
+}
+
+func (a *adapter) DriftPolicy(dm *datadoghqv1alpha1.DatadogMonitor) datadoghqv1alpha1.DriftPolicy {
+	return dm.Spec.ControllerOptions.DriftPolicy
+}
+
+func (a *adapter) Drift(dm *datadoghqv1alpha1.DatadogMonitor, m datadogV1.Monitor) ([]string, error) {
+	return monitorDrift(a.log, dm, m), nil
+}
+
+func (a *adapter) Create(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) (datadogV1.Monitor, error) {
+	if err := validateMonitor(a.auth(ctx), a.log, a.datadogClient, dm); err != nil {
+		return datadogV1.Monitor{}, err
 	}
+	return createMonitor(a.auth(ctx), a.log, a.datadogClient, dm)
+}
 
-	// If reconcile was successful, requeue with period defaultRequeuePeriod
-	if !result.Requeue && result.RequeueAfter == 0 {
-		result.RequeueAfter = defaultRequeuePeriod
+func (a *adapter) Get(ctx context.Context, id string) (datadogV1.Monitor, error) {
+	monitorID, err := strconv.Atoi(id)
+	if err != nil {
+		return datadogV1.Monitor{}, fmt.Errorf("invalid monitor ID %q: %w", id, err)
 	}
+	return getMonitor(a.auth(ctx), a.datadogClient, monitorID)
+}
 
-	// Update the status
-	return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
+func (a *adapter) Update(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) (datadogV1.Monitor, error) {
+	if err := validateMonitor(a.auth(ctx), a.log, a.datadogClient, dm); err != nil {
+		return datadogV1.Monitor{}, err
+	}
+	return updateMonitor(a.auth(ctx), a.log, a.datadogClient, dm)
 }
 
-func (r *Reconciler) create(logger logr.Logger, datadogMonitor *datadoghqv1alpha1.DatadogMonitor, status *datadoghqv1alpha1.DatadogMonitorStatus, now metav1.Time, instanceSpecHash string) error {
-	// Validate monitor in Datadog
-	if err := validateMonitor(r.datadogAuth, logger, r.datadogClient, datadogMonitor); err != nil {
+// Delete deletes the monitor in Datadog, unless the DatadogMonitor didn't create or adopt it. The rollout downtime is
+// canceled in any case.
+func (a *adapter) Delete(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) error {
+	if err := a.cancelRolloutDowntime(ctx, dm); err != nil {
 		return err
 	}
+	if !dm.Status.Primary {
+		return nil
+	}
+	return deleteMonitor(a.auth(ctx), a.datadogClient, dm.Status.ID)
+}
 
-	// Create monitor in Datadog
-	m, err := createMonitor(r.datadogAuth, logger, r.datadogClient, datadogMonitor)
-	if err != nil {
+// Orphan tags the monitor as no longer managed by the operator, unless the DatadogMonitor didn't create or adopt it.
+// The rollout downtime is canceled in any case.
+func (a *adapter) Orphan(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) error {
+	if err := a.cancelRolloutDowntime(ctx, dm); err != nil {
 		return err
 	}
-	event := buildEventInfo(datadogMonitor.Name, datadogMonitor.Namespace, datadog.CreationEvent)
-	r.recordEvent(datadogMonitor, event)
+	if !dm.Status.Primary {
+		return nil
+	}
+	return orphanMonitor(a.auth(ctx), a.datadogClient, dm.Status.ID)
+}
 
-	// As this is a new monitor, add static information to status
-	status.ID = int(m.GetId())
+func (a *adapter) Info(m datadogV1.Monitor) apiresource.Info {
 	creator := m.GetCreator()
-	status.Creator = creator.GetEmail()
-	createdTime := metav1.NewTime(m.GetCreated())
-	status.Created = &createdTime
-	status.Primary = true
-	status.MonitorStateSyncStatus = ""
-	status.CurrentHash = instanceSpecHash
-
-	// Set Created Condition
-	condition.UpdateDatadogMonitorConditions(status, now, datadoghqv1alpha1.DatadogMonitorConditionTypeCreated, corev1.ConditionTrue, "DatadogMonitor Created")
-	logger.Info("Created a new DatadogMonitor", "Monitor Namespace", datadogMonitor.Namespace, "Monitor Name", datadogMonitor.Name, "Monitor ID", m.GetId())
+	return apiresource.Info{
+		ID:       strconv.FormatInt(m.GetId(), 10),
+		Creator:  creator.GetEmail(),
+		Created:  m.GetCreated(),
+		Modified: m.GetModified(),
+	}
+}
 
-	return nil
+// Observe updates the state of the monitor in the status, and builds the state of all its groups, stored by Refresh.
+func (a *adapter) Observe(dm *datadoghqv1alpha1.DatadogMonitor, m datadogV1.Monitor, now metav1.Time) {
+	updateMonitorState(m, now, &dm.Status)
+	a.observedGroups.Store(types.NamespacedName{Namespace: dm.Namespace, Name: dm.Name}, buildMonitorGroups(dm, m))
 }
 
-func (r *Reconciler) update(logger logr.Logger, datadogMonitor *datadoghqv1alpha1.DatadogMonitor, status *datadoghqv1alpha1.DatadogMonitorStatus, now metav1.Time, instanceSpecHash string) error {
-	// Validate monitor in Datadog
-	if err := validateMonitor(r.datadogAuth, logger, r.datadogClient, datadogMonitor); err != nil {
-		status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusValidateError
-		return err
+// Refresh mutes the monitor while the workloads of its rollout mute roll out, and stores the state of its groups
+// observed during the sync. The errors are retried on the next sync.
+func (a *adapter) Refresh(ctx context.Context, logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor, now metav1.Time) {
+	if err := a.muteDuringRollouts(ctx, logger, dm); err != nil {
+		logger.Error(err, "error muting the monitor during rollouts", "ID", dm.Status.ID)
 	}
-
-	// Update monitor in Datadog
-	if _, err := updateMonitor(r.datadogAuth, logger, r.datadogClient, datadogMonitor); err != nil {
-		status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusUpdateError
-		return err
+	if groups, ok := a.observedGroups.LoadAndDelete(types.NamespacedName{Namespace: dm.Namespace, Name: dm.Name}); ok {
+		if err := a.updateMonitorGroups(ctx, logger, dm, groups.(*datadoghqv1alpha1.DatadogMonitorGroups)); err != nil {
+			logger.Error(err, "error storing the state of the monitor groups", "ID", dm.Status.ID)
+		}
 	}
+}
 
-	event := buildEventInfo(datadogMonitor.Name, datadogMonitor.Namespace, datadog.UpdateEvent)
-	r.recordEvent(datadogMonitor, event)
+// monitorStatus implements apiresource.Status for the DatadogMonitor status, which predates the common status fields:
+// the monitor ID is an integer, and the conditions have their own type.
+type monitorStatus struct {
+	Status *datadoghqv1alpha1.DatadogMonitorStatus
+}
 
-	// Set Updated Condition
-	condition.UpdateDatadogMonitorConditions(status, now, datadoghqv1alpha1.DatadogMonitorConditionTypeUpdated, corev1.ConditionTrue, "DatadogMonitor Updated")
-	status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusOK
-	status.MonitorLastForceSyncTime = &now
-	status.CurrentHash = instanceSpecHash
-	logger.V(1).Info("Updated DatadogMonitor", "Monitor Namespace", datadogMonitor.Namespace, "Monitor Name", datadogMonitor.Name, "Monitor ID", datadogMonitor.Status.ID)
+var _ apiresource.Status = (*monitorStatus)(nil)
 
-	return nil
+// monitorSyncStatusErrors are the sync statuses of a DatadogMonitor whose sync failed, by step.
+var monitorSyncStatusErrors = map[apiresource.Step]datadoghqv1alpha1.MonitorStateSyncStatusMessage{
+	apiresource.StepValidate:     datadoghqv1alpha1.MonitorStateSyncStatusValidateError,
+	apiresource.StepAuthenticate: datadoghqv1alpha1.MonitorStateSyncStatusCredentialsError,
+	apiresource.StepResolve:      datadoghqv1alpha1.MonitorStateSyncStatusCompositeRefError,
+	apiresource.StepGet:          datadoghqv1alpha1.MonitorStateSyncStatusGetError,
+	apiresource.StepUpdate:       datadoghqv1alpha1.MonitorStateSyncStatusUpdateError,
 }
 
-func (r *Reconciler) get(datadogMonitor *datadoghqv1alpha1.DatadogMonitor, status *datadoghqv1alpha1.DatadogMonitorStatus) (datadogV1.Monitor, error) {
-	// Get monitor from Datadog and update resource status if needed
-	m, err := getMonitor(r.datadogAuth, r.datadogClient, datadogMonitor.Status.ID)
-	if err != nil {
-		status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusGetError
-		return m, err
+func (s *monitorStatus) GetID() string {
+	if s.Status.ID == 0 {
+		return ""
 	}
-	return m, nil
+	return strconv.Itoa(s.Status.ID)
 }
 
-func updateMonitorState(m datadogV1.Monitor, now metav1.Time, status *datadoghqv1alpha1.DatadogMonitorStatus) {
-	convertStateToStatus(m, status, now)
-	status.MonitorStateLastUpdateTime = &now
-	status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusOK
+func (s *monitorStatus) SetID(id string) {
+	// The ID comes from the API, or from the adoption annotation once the monitor was found
+	s.Status.ID, _ = strconv.Atoi(id)
 }
 
-func (r *Reconciler) updateStatusIfNeeded(logger logr.Logger, datadogMonitor *datadoghqv1alpha1.DatadogMonitor, now metav1.Time, status *datadoghqv1alpha1.DatadogMonitorStatus, currentErr error, result ctrl.Result) (ctrl.Result, error) {
-	// Update Error and Active conditions
-	condition.SetErrorActiveConditions(status, now, currentErr)
+func (s *monitorStatus) GetCurrentHash() string {
+	return s.Status.CurrentHash
+}
 
-	if !apiequality.Semantic.DeepEqual(&datadogMonitor.Status, status) {
-		datadogMonitor.Status = *status
-		if err := r.client.Status().Update(context.TODO(), datadogMonitor); err != nil {
-			if apierrors.IsConflict(err) {
-				logger.Error(err, "unable to update DatadogMonitor status due to update conflict")
-				return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, nil
-			}
-			logger.Error(err, "unable to update DatadogMonitor status")
+func (s *monitorStatus) SetCurrentHash(hash string) {
+	s.Status.CurrentHash = hash
+}
 
-			return ctrl.Result{}, err
-		}
-		// This is brittle; typically if a Spec or Status is updated in the API, the result gets requeued without additional action.
-		// However, sometimes apiequality.Semantic.DeepEqual() is false even when the API thinks they are equal (and no update is made).
-		// Thus, the result does not get requeued after entering this `if` block. To safeguard this, we will always requeue the result
-		// here. The danger of this is potentially requeueing twice for every status update. In most circumstances this is
-		// not an issue, but if a monitor has many groups and is "flapping", then it can cause a flood of updates to
-		// the Status.TriggeredState and put pressure on the controller. As a safeguard against this, the maximum number
-		// of groups stored in Status.TriggeredState should be conservative.
-		return ctrl.Result{RequeueAfter: defaultRequeuePeriod}, nil
-	}
+func (s *monitorStatus) GetLastForceSyncTime() *metav1.Time {
+	return s.Status.MonitorLastForceSyncTime
+}
 
-	return result, nil
+func (s *monitorStatus) SetLastForceSyncTime(syncTime *metav1.Time) {
+	s.Status.MonitorLastForceSyncTime = syncTime
 }
 
-func (r *Reconciler) checkRequiredTags(logger logr.Logger, datadogMonitor *datadoghqv1alpha1.DatadogMonitor) (ctrl.Result, error) {
-	tagsToAdd := []string{}
-	var found bool
-	tags := datadogMonitor.Spec.Tags
-	for _, rT := range getRequiredTags() {
-		found = false
-		for _, t := range tags {
-			if t == rT {
-				found = true
-				break
-			}
-		}
-		if !found {
-			tagsToAdd = append(tagsToAdd, rT)
-		}
-	}
+// SetCreated also marks the DatadogMonitor as the primary owner of the monitor, which is deleted with it.
+func (s *monitorStatus) SetCreated(creator string, created *metav1.Time) {
+	s.Status.Creator = creator
+	s.Status.Created = created
+	s.Status.Primary = true
+}
+
+// SetSynced sets the sync status to OK, the Error condition to false and the Active condition to true.
+func (s *monitorStatus) SetSynced(now metav1.Time) {
+	s.Status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusOK
+	condition.SetErrorActiveConditions(s.Status, now, nil)
+}
 
-	if len(tagsToAdd) > 0 {
-		tags = append(tags, tagsToAdd...)
-		datadogMonitor.Spec.Tags = tags
-		err := r.client.Update(context.TODO(), datadogMonitor)
-		if err != nil {
-			logger.Error(err, "failed to update DatadogMonitor with required tags")
+// SetSyncError sets the sync status of the failed step, the Error condition to true and the Active condition to false.
+func (s *monitorStatus) SetSyncError(step apiresource.Step, reason string, err error, now metav1.Time) {
+	if syncStatus, found := monitorSyncStatusErrors[step]; found {
+		s.Status.MonitorStateSyncStatus = syncStatus
+	}
+	condition.SetErrorActiveConditions(s.Status, now, err)
+}
 
-			return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, err
+// SetCondition sets a DatadogMonitor condition, adding it if needed. The DatadogMonitor conditions don't have a reason.
+func (s *monitorStatus) SetCondition(conditionType condition.Type, conditionStatus metav1.ConditionStatus, reason, message string, now metav1.Time) {
+	for i := range s.Status.Conditions {
+		if s.Status.Conditions[i].Type == datadoghqv1alpha1.DatadogMonitorConditionType(conditionType) {
+			condition.SetDatadogMonitorCondition(&s.Status.Conditions[i], now, corev1.ConditionStatus(conditionStatus), message)
+			return
 		}
-		logger.Info("Added required tags", "Monitor Namespace", datadogMonitor.Namespace, "Monitor Name", datadogMonitor.Name, "Monitor ID", datadogMonitor.Status.ID)
-
-		return ctrl.Result{RequeueAfter: defaultRequeuePeriod}, nil
 	}
+	s.Status.Conditions = append(s.Status.Conditions, condition.NewDatadogMonitorCondition(datadoghqv1alpha1.DatadogMonitorConditionType(conditionType), corev1.ConditionStatus(conditionStatus), now, "", message))
+}
 
-	// Proceed in reconcile loop without modifying result.
-	return ctrl.Result{}, nil
+func (s *monitorStatus) GetCondition(conditionType condition.Type) (metav1.ConditionStatus, string, bool) {
+	for _, c := range s.Status.Conditions {
+		if c.Type == datadoghqv1alpha1.DatadogMonitorConditionType(conditionType) {
+			return metav1.ConditionStatus(c.Status), c.Message, true
+		}
+	}
+	return "", "", false
 }
 
-func getRequiredTags() []string {
-	return []string{requiredTag}
+func updateMonitorState(m datadogV1.Monitor, now metav1.Time, status *datadoghqv1alpha1.DatadogMonitorStatus) {
+	convertStateToStatus(m, status, now)
+	status.MonitorStateLastUpdateTime = &now
+	status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusOK
 }
 
 // convertStateToStatus updates status.MonitorState, status.TriggeredState, and status.DowntimeStatus according to the current state of the monitor
@@ -382,8 +388,14 @@ func convertStateToStatus(monitor datadogV1.Monitor, newStatus *datadoghqv1alpha
 	if newStatus.MonitorState != oldMonitorState {
 		newStatus.MonitorStateLastTransitionTime = &now
 	}
-	// TODO Updating this requires having the API client also return any matching downtime objects
-	newStatus.DowntimeStatus = datadoghqv1alpha1.DatadogMonitorDowntimeStatus{}
+	// TODO Updating this requires having the API client also return any matching downtime objects. Only the downtime
+	// muting the monitor during rollouts is known, see muteDuringRollouts.
+	newStatus.DowntimeStatus = datadoghqv1alpha1.DatadogMonitorDowntimeStatus{
+		IsDowntimed:        newStatus.DowntimeStatus.RolloutDowntimeID != "",
+		RolloutDowntimeID:  newStatus.DowntimeStatus.RolloutDowntimeID,
+		RolloutWorkloads:   newStatus.DowntimeStatus.RolloutWorkloads,
+		RolloutDowntimeEnd: newStatus.DowntimeStatus.RolloutDowntimeEnd,
+	}
 }
 
 func isSupportedMonitorType(monitorType datadoghqv1alpha1.DatadogMonitorType) bool {
@@ -17,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -29,7 +30,9 @@ import (
 	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 )
 
 const (
@@ -76,7 +79,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 					_ = c.Create(context.TODO(), genericDatadogMonitor())
 				},
 			},
-			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
+			wantResult: reconcile.Result{},
 			wantFunc: func(c client.Client) error {
 				dm := &datadoghqv1alpha1.DatadogMonitor{}
 				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, dm); err != nil {
@@ -399,7 +402,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 			},
 		},
 		{
-			name: "DatadogMonitor of unsupported type (composite)",
+			name: "DatadogMonitor of unsupported type",
 			args: args{
 				request: newRequest(resourcesNamespace, resourcesName),
 				firstAction: func(c client.Client) {
@@ -414,7 +417,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 						},
 						Spec: datadoghqv1alpha1.DatadogMonitorSpec{
 							Query:   "avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.1",
-							Type:    datadoghqv1alpha1.DatadogMonitorTypeComposite,
+							Type:    "unsupported alert",
 							Name:    "test monitor",
 							Message: "something is wrong",
 						},
@@ -422,7 +425,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 				},
 				firstReconcileCount: 2,
 			},
-			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
+			wantResult: reconcile.Result{},
 			wantErr:    false,
 			wantFunc: func(c client.Client) error {
 				dm := &datadoghqv1alpha1.DatadogMonitor{}
@@ -433,6 +436,75 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "DatadogMonitor adopts an existing monitor",
+			args: args{
+				request: newRequest(resourcesNamespace, resourcesName),
+				firstAction: func(c client.Client) {
+					dm := genericDatadogMonitor()
+					dm.Annotations = map[string]string{datadoghqv1alpha1.DatadogMonitorAdoptIDAnnotationKey: "12345"}
+					_ = c.Create(context.TODO(), dm)
+				},
+				firstReconcileCount: 3,
+			},
+			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
+			wantFunc: func(c client.Client) error {
+				dm := &datadoghqv1alpha1.DatadogMonitor{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, dm); err != nil {
+					return err
+				}
+				assert.Equal(t, 12345, dm.Status.ID)
+				assert.True(t, dm.Status.Primary)
+				assert.Equal(t, datadoghqv1alpha1.MonitorStateSyncStatusOK, dm.Status.MonitorStateSyncStatus)
+				assert.NotEmpty(t, dm.Status.CurrentHash)
+				return nil
+			},
+		},
+		{
+			name: "DatadogMonitor with an invalid adoption annotation",
+			args: args{
+				request: newRequest(resourcesNamespace, resourcesName),
+				firstAction: func(c client.Client) {
+					dm := genericDatadogMonitor()
+					dm.Annotations = map[string]string{datadoghqv1alpha1.DatadogMonitorAdoptIDAnnotationKey: "not-an-id"}
+					_ = c.Create(context.TODO(), dm)
+				},
+				firstReconcileCount: 3,
+			},
+			wantResult: reconcile.Result{RequeueAfter: defaultErrRequeuePeriod},
+			wantFunc: func(c client.Client) error {
+				dm := &datadoghqv1alpha1.DatadogMonitor{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, dm); err != nil {
+					return err
+				}
+				assert.Equal(t, 0, dm.Status.ID)
+				assert.Equal(t, datadoghqv1alpha1.DatadogMonitorConditionTypeError, dm.Status.Conditions[0].Type)
+				return nil
+			},
+		},
+		{
+			name: "DatadogMonitor, composite monitor waiting for referenced monitor",
+			args: args{
+				request: newRequest(resourcesNamespace, resourcesName),
+				firstAction: func(c client.Client) {
+					ref := genericDatadogMonitor()
+					ref.Name = "referenced-monitor"
+					_ = c.Create(context.TODO(), ref)
+					_ = c.Create(context.TODO(), testCompositeMonitor())
+				},
+				firstReconcileCount: 2,
+			},
+			wantResult: reconcile.Result{RequeueAfter: defaultErrRequeuePeriod},
+			wantFunc: func(c client.Client) error {
+				dm := &datadoghqv1alpha1.DatadogMonitor{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, dm); err != nil {
+					return err
+				}
+				assert.Equal(t, 0, dm.Status.ID)
+				assert.Equal(t, datadoghqv1alpha1.MonitorStateSyncStatusCompositeRefError, dm.Status.MonitorStateSyncStatus)
+				return nil
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -450,18 +522,13 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 			testAuth := setupTestAuth(httpServer.URL)
 
 			// Set up
-			r := &Reconciler{
-				client:        fake.NewClientBuilder().WithStatusSubresource(&datadoghqv1alpha1.DatadogMonitor{}).Build(),
-				datadogClient: client,
-				datadogAuth:   testAuth,
-				scheme:        s,
-				recorder:      recorder,
-				log:           logf.Log.WithName(tt.name),
-			}
+			k8sClient := fake.NewClientBuilder().WithStatusSubresource(&datadoghqv1alpha1.DatadogMonitor{}).Build()
+			ddClient := datadogclient.DatadogMonitorClient{Client: client, Auth: testAuth}
+			r, _ := NewReconciler(k8sClient, k8sClient, ddClient, &version.Info{}, s, logf.Log.WithName(tt.name), recorder, "", utils.NamespaceTags{})
 
 			// First monitor action
 			if tt.args.firstAction != nil {
-				tt.args.firstAction(r.client)
+				tt.args.firstAction(k8sClient)
 				// Make sure there's minimum 1 reconcile loop
 				if tt.args.firstReconcileCount == 0 {
 					tt.args.firstReconcileCount = 1
@@ -478,7 +545,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 
 			// Second monitor action
 			if tt.args.secondAction != nil {
-				tt.args.secondAction(r.client)
+				tt.args.secondAction(k8sClient)
 				// Make sure there's minimum 1 reconcile loop
 				if tt.args.secondReconcileCount == 0 {
 					tt.args.secondReconcileCount = 1
@@ -490,7 +557,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 			}
 
 			if tt.wantFunc != nil {
-				err := tt.wantFunc(r.client)
+				err := tt.wantFunc(k8sClient)
 				if tt.wantErr {
 					assert.Error(t, err, "ReconcileDatadogMonitor.Reconcile() expected an error")
 				} else {
@@ -942,3 +1009,22 @@ func testAuditMonitor() *datadoghqv1alpha1.DatadogMonitor {
 		},
 	}
 }
+
+func testCompositeMonitor() *datadoghqv1alpha1.DatadogMonitor {
+	return &datadoghqv1alpha1.DatadogMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogMonitor",
+			APIVersion: fmt.Sprintf("%s/%s", datadoghqv1alpha1.GroupVersion.Group, datadoghqv1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourcesNamespace,
+			Name:      resourcesName,
+		},
+		Spec: datadoghqv1alpha1.DatadogMonitorSpec{
+			Query:   "referenced-monitor && 12345",
+			Type:    datadoghqv1alpha1.DatadogMonitorTypeComposite,
+			Name:    "test composite monitor",
+			Message: "something is wrong",
+		},
+	}
+}
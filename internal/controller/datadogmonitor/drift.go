@@ -0,0 +1,46 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"sort"
+
+	"github.com/go-logr/logr"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+)
+
+// monitorDrift returns the JSON paths of the DatadogMonitor spec fields that differ in the monitor in Datadog.
+func monitorDrift(logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor, m datadogV1.Monitor) []string {
+	// Build the desired monitor as it is sent to the API, so that both sides are formatted the same way
+	desiredMonitor, _ := buildMonitor(logger, dm)
+	desiredMonitor.SetRestrictedRoles(dm.Spec.RestrictedRoles)
+	_, desired := ExportMonitorSpec(*desiredMonitor)
+	_, actual := ExportMonitorSpec(m)
+
+	// Metric monitors are returned as query monitors by the API
+	if desired.Type == datadoghqv1alpha1.DatadogMonitorTypeMetric && actual.Type == datadoghqv1alpha1.DatadogMonitorTypeQuery {
+		actual.Type = desired.Type
+	}
+	desired.Tags = sortedCopy(desired.Tags)
+	actual.Tags = sortedCopy(actual.Tags)
+	desired.RestrictedRoles = sortedCopy(desired.RestrictedRoles)
+	actual.RestrictedRoles = sortedCopy(actual.RestrictedRoles)
+
+	return comparison.SpecDrift(desired, actual)
+}
+
+func sortedCopy(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+
+	return sorted
+}
@@ -0,0 +1,196 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+func Test_monitorDrift(t *testing.T) {
+	desired := &datadoghqv1alpha1.DatadogMonitor{
+		Spec: datadoghqv1alpha1.DatadogMonitorSpec{
+			Name:    "test monitor",
+			Message: "something is wrong",
+			Query:   "avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.5",
+			Type:    datadoghqv1alpha1.DatadogMonitorTypeMetric,
+			Tags:    []string{"generated:kubernetes", "env:prod"},
+			Options: datadoghqv1alpha1.DatadogMonitorOptions{
+				Thresholds: &datadoghqv1alpha1.DatadogMonitorOptionsThresholds{
+					Critical: apiutils.NewStringPointer("0.50"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		remote func(m *datadogV1.Monitor)
+		want   []string
+	}{
+		{
+			name: "no drift, with the API normalization and defaults",
+			remote: func(m *datadogV1.Monitor) {
+				m.SetType(datadogV1.MONITORTYPE_QUERY_ALERT)
+				m.SetTags([]string{"env:prod", "generated:kubernetes"})
+				m.Options.SetNotifyNoData(false)
+				m.Options.SetIncludeTags(true)
+			},
+			want: []string{},
+		},
+		{
+			name: "changed in the UI",
+			remote: func(m *datadogV1.Monitor) {
+				m.SetName("renamed monitor")
+				m.SetTags([]string{"env:prod"})
+				m.Options.Thresholds.SetCritical(0.9)
+			},
+			want: []string{"name", "tags", "options.thresholds.critical"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := zap.New(zap.UseDevMode(true))
+			m, _ := buildMonitor(logger, desired)
+			m.SetId(1234)
+			tt.remote(m)
+
+			assert.Equal(t, tt.want, monitorDrift(logger, desired, *m))
+		})
+	}
+}
+
+func TestReconciler_drift(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(datadoghqv1alpha1.GroupVersion, &datadoghqv1alpha1.DatadogMonitor{})
+	lastSync := metav1.NewTime(time.Now().Add(-10 * time.Minute).Truncate(time.Second))
+
+	tests := []struct {
+		name          string
+		driftPolicy   datadoghqv1alpha1.DriftPolicy
+		modified      time.Time
+		wantUpdates   int
+		wantCondition *datadoghqv1alpha1.DatadogMonitorCondition
+		wantEvent     string
+	}{
+		{
+			name:          "monitor changed in Datadog, enforce",
+			modified:      lastSync.Add(5 * time.Minute),
+			wantUpdates:   1,
+			wantCondition: &datadoghqv1alpha1.DatadogMonitorCondition{Status: corev1.ConditionFalse, Message: "Reverted the Monitor changes made outside of Kubernetes: name"},
+			wantEvent:     "Warning Drift DatadogMonitor bar/foo: Reverted the Monitor changes made outside of Kubernetes: name",
+		},
+		{
+			name:          "monitor changed in Datadog, observe",
+			driftPolicy:   datadoghqv1alpha1.DriftPolicyObserve,
+			modified:      lastSync.Add(5 * time.Minute),
+			wantUpdates:   0,
+			wantCondition: &datadoghqv1alpha1.DatadogMonitorCondition{Status: corev1.ConditionTrue, Message: "Monitor changed outside of Kubernetes: name"},
+			wantEvent:     "Warning Drift DatadogMonitor bar/foo: Monitor changed outside of Kubernetes: name",
+		},
+		{
+			name:        "monitor not modified since the last sync",
+			modified:    lastSync.Add(-5 * time.Minute),
+			wantUpdates: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := genericDatadogMonitor()
+			dm.Finalizers = []string{datadogMonitorFinalizer}
+			dm.Spec.Tags = utils.GetRequiredTags()
+			dm.Spec.ControllerOptions.DriftPolicy = tt.driftPolicy
+			hash, _ := comparison.GenerateMD5ForSpec(&dm.Spec)
+			dm.Status = datadoghqv1alpha1.DatadogMonitorStatus{
+				ID:                       1234,
+				Primary:                  true,
+				CurrentHash:              hash,
+				MonitorLastForceSyncTime: &lastSync,
+			}
+
+			updates := 0
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				m, _ := buildMonitor(zap.New(), dm)
+				m.SetId(1234)
+				m.SetModified(tt.modified)
+				switch {
+				case r.Method == http.MethodGet:
+					m.SetName("renamed in the UI")
+				case r.Method == http.MethodPut:
+					updates++
+					m.SetModified(time.Now())
+				}
+				_ = json.NewEncoder(w).Encode(m)
+			}))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			recorder := record.NewFakeRecorder(10)
+			k8sClient := fake.NewClientBuilder().WithStatusSubresource(&datadoghqv1alpha1.DatadogMonitor{}).WithObjects(dm).Build()
+			ddClient := datadogclient.DatadogMonitorClient{Client: datadogV1.NewMonitorsApi(datadogapi.NewAPIClient(testConfig)), Auth: setupTestAuth(httpServer.URL)}
+			r, _ := NewReconciler(k8sClient, k8sClient, ddClient, &version.Info{}, s, zap.New(zap.UseDevMode(true)), recorder, "", utils.NamespaceTags{})
+
+			_, err := r.Reconcile(context.TODO(), newRequest(resourcesNamespace, resourcesName))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUpdates, updates)
+
+			got := &datadoghqv1alpha1.DatadogMonitor{}
+			require.NoError(t, k8sClient.Get(context.TODO(), newRequest(resourcesNamespace, resourcesName).NamespacedName, got))
+			var drifted *datadoghqv1alpha1.DatadogMonitorCondition
+			for i := range got.Status.Conditions {
+				if got.Status.Conditions[i].Type == datadoghqv1alpha1.DatadogMonitorConditionTypeDrifted {
+					drifted = &got.Status.Conditions[i]
+				}
+			}
+			if tt.wantCondition == nil {
+				assert.Nil(t, drifted)
+			} else {
+				require.NotNil(t, drifted)
+				assert.Equal(t, tt.wantCondition.Status, drifted.Status)
+				assert.Equal(t, tt.wantCondition.Message, drifted.Message)
+			}
+
+			events := []string{}
+			for len(recorder.Events) > 0 {
+				event := <-recorder.Events
+				if event != "Normal Update DatadogMonitor bar/foo" {
+					events = append(events, event)
+				}
+			}
+			if tt.wantEvent == "" {
+				assert.Empty(t, events)
+			} else {
+				assert.Equal(t, []string{tt.wantEvent}, events)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"strconv"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// ExportMonitorSpec returns the ID and the DatadogMonitorSpec of an existing Datadog monitor, reversing the mapping
+// of buildMonitor. The ID is returned as well because it isn't parsed by the API client for some monitor types.
+func ExportMonitorSpec(m datadogV1.Monitor) (int64, datadoghqv1alpha1.DatadogMonitorSpec) {
+	m = parseUnknownTypeMonitor(m)
+	spec := datadoghqv1alpha1.DatadogMonitorSpec{
+		Name:            m.GetName(),
+		Message:         m.GetMessage(),
+		Priority:        m.GetPriority(),
+		Query:           m.GetQuery(),
+		RestrictedRoles: m.GetRestrictedRoles(),
+		Tags:            m.GetTags(),
+		Type:            datadoghqv1alpha1.DatadogMonitorType(m.GetType()),
+	}
+
+	o, ok := m.GetOptionsOk()
+	if !ok {
+		return m.GetId(), spec
+	}
+	options := &spec.Options
+
+	if thresholds, ok := o.GetThresholdsOk(); ok {
+		options.Thresholds = exportThresholds(thresholds)
+	}
+	if thresholdWindows, ok := o.GetThresholdWindowsOk(); ok {
+		recoveryWindow, _ := thresholdWindows.GetRecoveryWindowOk()
+		triggerWindow, _ := thresholdWindows.GetTriggerWindowOk()
+		if recoveryWindow != nil || triggerWindow != nil {
+			options.ThresholdWindows = &datadoghqv1alpha1.DatadogMonitorOptionsThresholdWindows{
+				RecoveryWindow: recoveryWindow,
+				TriggerWindow:  triggerWindow,
+			}
+		}
+	}
+
+	options.EnableLogsSample, _ = o.GetEnableLogsSampleOk()
+	options.EscalationMessage, _ = o.GetEscalationMessageOk()
+	options.EvaluationDelay, _ = o.GetEvaluationDelayOk()
+	options.GroupbySimpleMonitor, _ = o.GetGroupbySimpleMonitorOk()
+	options.IncludeTags, _ = o.GetIncludeTagsOk()
+	options.Locked, _ = o.GetLockedOk()
+	options.NewGroupDelay, _ = o.GetNewGroupDelayOk()
+	options.NoDataTimeframe, _ = o.GetNoDataTimeframeOk()
+	options.NotifyAudit, _ = o.GetNotifyAuditOk()
+	options.NotifyBy = o.GetNotifyBy()
+	options.NotifyNoData, _ = o.GetNotifyNoDataOk()
+	options.RenotifyInterval, _ = o.GetRenotifyIntervalOk()
+	options.RenotifyOccurrences, _ = o.GetRenotifyOccurrencesOk()
+	options.RenotifyStatuses = o.GetRenotifyStatuses()
+	options.RequireFullWindow, _ = o.GetRequireFullWindowOk()
+	options.TimeoutH, _ = o.GetTimeoutHOk()
+
+	if preset, ok := o.GetNotificationPresetNameOk(); ok {
+		options.NotificationPresetName = datadoghqv1alpha1.DatadogMonitorOptionsNotificationPreset(*preset)
+	}
+	if onMissingData, ok := o.GetOnMissingDataOk(); ok {
+		options.OnMissingData = datadoghqv1alpha1.DatadogMonitorOptionsOnMissingData(*onMissingData)
+	}
+
+	return m.GetId(), spec
+}
+
+func exportThresholds(thresholds *datadogV1.MonitorThresholds) *datadoghqv1alpha1.DatadogMonitorOptionsThresholds {
+	t := &datadoghqv1alpha1.DatadogMonitorOptionsThresholds{}
+	empty := true
+	formatThreshold := func(value *float64, ok bool) *string {
+		if !ok || value == nil {
+			return nil
+		}
+		empty = false
+		formatted := strconv.FormatFloat(*value, 'f', -1, 64)
+		return &formatted
+	}
+
+	t.Critical = formatThreshold(thresholds.GetCriticalOk())
+	t.CriticalRecovery = formatThreshold(thresholds.GetCriticalRecoveryOk())
+	t.OK = formatThreshold(thresholds.GetOkOk())
+	t.Unknown = formatThreshold(thresholds.GetUnknownOk())
+	t.Warning = formatThreshold(thresholds.GetWarningOk())
+	t.WarningRecovery = formatThreshold(thresholds.GetWarningRecoveryOk())
+
+	if empty {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+)
+
+func Test_ExportMonitorSpec(t *testing.T) {
+	tests := []struct {
+		name string
+		spec datadoghqv1alpha1.DatadogMonitorSpec
+	}{
+		{
+			name: "metric monitor without options",
+			spec: datadoghqv1alpha1.DatadogMonitorSpec{
+				Name:    "Disk usage",
+				Message: "Disk is full",
+				Query:   "avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.5",
+				Type:    datadoghqv1alpha1.DatadogMonitorTypeMetric,
+				Tags:    []string{"env:prod", "team:storage"},
+			},
+		},
+		{
+			name: "log monitor with options",
+			spec: datadoghqv1alpha1.DatadogMonitorSpec{
+				Name:            "Errors",
+				Message:         "Too many errors",
+				Priority:        2,
+				Query:           "logs(\"status:error\").index(\"*\").rollup(\"count\").last(\"5m\") > 100",
+				RestrictedRoles: []string{"an-admin-uuid"},
+				Type:            datadoghqv1alpha1.DatadogMonitorTypeLog,
+				Tags:            []string{"env:prod"},
+				Options: datadoghqv1alpha1.DatadogMonitorOptions{
+					EnableLogsSample:       apiutils.NewBoolPointer(true),
+					EscalationMessage:      apiutils.NewStringPointer("Still too many errors"),
+					EvaluationDelay:        apiutils.NewInt64Pointer(300),
+					NotificationPresetName: datadoghqv1alpha1.DatadogMonitorOptionsNotificationPresetHideQuery,
+					NotifyBy:               []string{"service"},
+					OnMissingData:          "resolve",
+					RenotifyInterval:       apiutils.NewInt64Pointer(60),
+					RenotifyStatuses:       []datadogV1.MonitorRenotifyStatusType{datadogV1.MONITORRENOTIFYSTATUSTYPE_ALERT},
+					Thresholds: &datadoghqv1alpha1.DatadogMonitorOptionsThresholds{
+						Critical: apiutils.NewStringPointer("100"),
+						Warning:  apiutils.NewStringPointer("50.5"),
+					},
+					ThresholdWindows: &datadoghqv1alpha1.DatadogMonitorOptionsThresholdWindows{
+						TriggerWindow: apiutils.NewStringPointer("last_5m"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := &datadoghqv1alpha1.DatadogMonitor{Spec: *tt.spec.DeepCopy()}
+			m, _ := buildMonitor(zap.New(zap.UseDevMode(true)), dm)
+			// Restricted roles are only part of the update request
+			m.SetRestrictedRoles(tt.spec.RestrictedRoles)
+			m.SetId(1234)
+
+			id, spec := ExportMonitorSpec(*m)
+			assert.Equal(t, int64(1234), id)
+			assert.Equal(t, tt.spec, spec)
+		})
+	}
+}
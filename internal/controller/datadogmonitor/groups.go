@@ -0,0 +1,117 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/utils"
+)
+
+const (
+	// maxGroupsSize caps the estimated encoded size of the groups stored in a DatadogMonitorGroups, to keep it well
+	// under the 1.5 MiB size limit of the Kubernetes objects whatever the length of the group names, and its updates
+	// cheap for the API server.
+	maxGroupsSize = 256 << 10
+	// groupSizeOverhead is the encoded size of a group without its name: its field names, state and transition time.
+	groupSizeOverhead = 80
+)
+
+// buildMonitorGroups returns the DatadogMonitorGroups of a DatadogMonitor from the state of its monitor. Only the groups
+// that aren't OK are stored, and when they would exceed maxGroupsSize, only the most severe ones that fit are kept.
+func buildMonitorGroups(dm *datadoghqv1alpha1.DatadogMonitor, m datadogV1.Monitor) *datadoghqv1alpha1.DatadogMonitorGroups {
+	monitorGroups := &datadoghqv1alpha1.DatadogMonitorGroups{
+		ObjectMeta: metav1.ObjectMeta{Namespace: dm.Namespace, Name: dm.Name},
+		MonitorID:  int(m.GetId()),
+	}
+
+	var groups []datadoghqv1alpha1.DatadogMonitorGroup
+	for name, group := range m.State.GetGroups() {
+		state := datadoghqv1alpha1.DatadogMonitorState(group.GetStatus())
+		monitorGroups.GroupCount++
+		if isTriggered(string(state)) {
+			monitorGroups.TriggeredCount++
+		}
+		if state == datadoghqv1alpha1.DatadogMonitorStateOK {
+			continue
+		}
+		groups = append(groups, datadoghqv1alpha1.DatadogMonitorGroup{
+			Name:               name,
+			State:              state,
+			LastTransitionTime: metav1.Unix(utils.GetMax(group.GetLastTriggeredTs(), utils.GetMax(group.GetLastNodataTs(), group.GetLastResolvedTs())), 0),
+		})
+	}
+
+	datadoghqv1alpha1.SortDatadogMonitorGroupsBySeverity(groups)
+	size := 0
+	for i, group := range groups {
+		if size += len(group.Name) + groupSizeOverhead; size > maxGroupsSize {
+			groups = groups[:i]
+			break
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	monitorGroups.Groups = groups
+
+	return monitorGroups
+}
+
+// updateMonitorGroups creates or updates the DatadogMonitorGroups of a DatadogMonitor. It's only created once the
+// monitor has groups, and is deleted with the DatadogMonitor by the garbage collector. It's read from the API server
+// rather than the cache, so that the operator doesn't keep the groups of all the monitors in memory, and it isn't
+// updated when only the transition times of its groups changed, to limit the writes to the API server.
+func (a *adapter) updateMonitorGroups(ctx context.Context, logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor, desired *datadoghqv1alpha1.DatadogMonitorGroups) error {
+	current := &datadoghqv1alpha1.DatadogMonitorGroups{}
+	err := a.apiReader.Get(ctx, types.NamespacedName{Namespace: dm.Namespace, Name: dm.Name}, current)
+	switch {
+	case apierrors.IsNotFound(err):
+		if desired.GroupCount == 0 {
+			return nil
+		}
+		if err = controllerutil.SetControllerReference(dm, desired, a.scheme); err != nil {
+			return fmt.Errorf("error setting the owner of DatadogMonitorGroups: %w", err)
+		}
+		if err = a.client.Create(ctx, desired); err != nil {
+			return fmt.Errorf("error creating DatadogMonitorGroups: %w", err)
+		}
+		logger.V(1).Info("Created DatadogMonitorGroups", "groups", desired.GroupCount)
+		return nil
+	case err != nil:
+		return fmt.Errorf("error getting DatadogMonitorGroups: %w", err)
+	}
+
+	if current.MonitorID == desired.MonitorID && current.GroupCount == desired.GroupCount && current.TriggeredCount == desired.TriggeredCount &&
+		sameGroupStates(current.Groups, desired.Groups) {
+		return nil
+	}
+	current.MonitorID = desired.MonitorID
+	current.GroupCount = desired.GroupCount
+	current.TriggeredCount = desired.TriggeredCount
+	current.Groups = desired.Groups
+	if err = a.client.Update(ctx, current); err != nil {
+		return fmt.Errorf("error updating DatadogMonitorGroups: %w", err)
+	}
+	logger.V(1).Info("Updated DatadogMonitorGroups", "groups", desired.GroupCount)
+	return nil
+}
+
+// sameGroupStates returns true if the groups have the same names and states, whatever their transition times.
+func sameGroupStates(current, desired []datadoghqv1alpha1.DatadogMonitorGroup) bool {
+	return slices.EqualFunc(current, desired, func(c, d datadoghqv1alpha1.DatadogMonitorGroup) bool {
+		return c.Name == d.Name && c.State == d.State
+	})
+}
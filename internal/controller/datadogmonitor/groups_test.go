@@ -0,0 +1,242 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_buildMonitorGroups(t *testing.T) {
+	triggerTs := int64(1612244495)
+	resolveTs := triggerTs + 300
+	okState := datadogV1.MONITOROVERALLSTATES_OK
+	alertState := datadogV1.MONITOROVERALLSTATES_ALERT
+	warnState := datadogV1.MONITOROVERALLSTATES_WARN
+	noDataState := datadogV1.MONITOROVERALLSTATES_NO_DATA
+
+	okGroups := map[string]datadogV1.MonitorStateGroup{}
+	for i := 0; i < 15000; i++ {
+		okGroups[fmt.Sprintf("host:%05d", i)] = datadogV1.MonitorStateGroup{Status: &okState, LastResolvedTs: &resolveTs}
+	}
+	okGroups["host:99999"] = datadogV1.MonitorStateGroup{Status: &alertState, LastTriggeredTs: &triggerTs}
+
+	manyGroups := map[string]datadogV1.MonitorStateGroup{}
+	for i := 0; i < 15000; i++ {
+		manyGroups[fmt.Sprintf("host:%05d", i)] = datadogV1.MonitorStateGroup{Status: &warnState, LastTriggeredTs: &resolveTs}
+	}
+	manyGroups["host:99999"] = datadogV1.MonitorStateGroup{Status: &alertState, LastTriggeredTs: &triggerTs}
+
+	longGroups := map[string]datadogV1.MonitorStateGroup{}
+	for i := 0; i < 500; i++ {
+		longGroups[fmt.Sprintf("pod_name:%05d-%s", i, strings.Repeat("x", 1000))] = datadogV1.MonitorStateGroup{Status: &warnState, LastTriggeredTs: &resolveTs}
+	}
+	longGroups["pod_name:alert"] = datadogV1.MonitorStateGroup{Status: &alertState, LastTriggeredTs: &triggerTs}
+
+	tests := []struct {
+		name              string
+		groups            map[string]datadogV1.MonitorStateGroup
+		wantGroupCount    int
+		wantTriggered     int
+		wantGroups        []datadoghqv1alpha1.DatadogMonitorGroup
+		wantCapped        bool
+		wantIncludedGroup string
+	}{
+		{
+			name: "no groups",
+		},
+		{
+			name: "several groups",
+			groups: map[string]datadogV1.MonitorStateGroup{
+				"host:b": {Status: &alertState, LastTriggeredTs: &triggerTs},
+				"host:a": {Status: &okState, LastTriggeredTs: &triggerTs, LastResolvedTs: &resolveTs},
+				"host:c": {Status: &noDataState, LastNodataTs: &resolveTs},
+			},
+			wantGroupCount: 3,
+			wantTriggered:  2,
+			wantGroups: []datadoghqv1alpha1.DatadogMonitorGroup{
+				{Name: "host:b", State: datadoghqv1alpha1.DatadogMonitorStateAlert, LastTransitionTime: metav1.Unix(triggerTs, 0)},
+				{Name: "host:c", State: datadoghqv1alpha1.DatadogMonitorStateNoData, LastTransitionTime: metav1.Unix(resolveTs, 0)},
+			},
+		},
+		{
+			name:           "OK groups not stored",
+			groups:         okGroups,
+			wantGroupCount: 15001,
+			wantTriggered:  1,
+			wantGroups: []datadoghqv1alpha1.DatadogMonitorGroup{
+				{Name: "host:99999", State: datadoghqv1alpha1.DatadogMonitorStateAlert, LastTransitionTime: metav1.Unix(triggerTs, 0)},
+			},
+		},
+		{
+			name:              "too many groups",
+			groups:            manyGroups,
+			wantGroupCount:    15001,
+			wantTriggered:     15001,
+			wantCapped:        true,
+			wantIncludedGroup: "host:99999",
+		},
+		{
+			name:              "long group names",
+			groups:            longGroups,
+			wantGroupCount:    501,
+			wantTriggered:     501,
+			wantCapped:        true,
+			wantIncludedGroup: "pod_name:alert",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := genericMonitor(12345)
+			if tt.groups != nil {
+				m.State = &datadogV1.MonitorState{Groups: tt.groups}
+			}
+			dm := &datadoghqv1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: resourcesName}}
+
+			got := buildMonitorGroups(dm, m)
+			assert.Equal(t, resourcesNamespace, got.Namespace)
+			assert.Equal(t, resourcesName, got.Name)
+			assert.Equal(t, 12345, got.MonitorID)
+			assert.Equal(t, tt.wantGroupCount, got.GroupCount)
+			assert.Equal(t, tt.wantTriggered, got.TriggeredCount)
+			if !tt.wantCapped {
+				assert.Equal(t, tt.wantGroups, got.Groups)
+				return
+			}
+			assert.Less(t, len(got.Groups), tt.wantGroupCount)
+			encoded, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(encoded), maxGroupsSize)
+			assert.Contains(t, got.Groups, datadoghqv1alpha1.DatadogMonitorGroup{
+				Name: tt.wantIncludedGroup, State: datadoghqv1alpha1.DatadogMonitorStateAlert, LastTransitionTime: metav1.Unix(triggerTs, 0),
+			})
+			assert.IsIncreasing(t, []string{got.Groups[0].Name, got.Groups[1].Name, got.Groups[len(got.Groups)-1].Name})
+		})
+	}
+}
+
+func Test_updateMonitorGroups(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, datadoghqv1alpha1.AddToScheme(s))
+
+	dm := &datadoghqv1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: resourcesName, UID: "uid"}}
+	alertGroup := datadoghqv1alpha1.DatadogMonitorGroup{Name: "host:a", State: datadoghqv1alpha1.DatadogMonitorStateAlert, LastTransitionTime: metav1.Unix(1612244495, 0)}
+	warnGroup := datadoghqv1alpha1.DatadogMonitorGroup{Name: "host:a", State: datadoghqv1alpha1.DatadogMonitorStateWarn, LastTransitionTime: metav1.Unix(1612244795, 0)}
+	retriggeredGroup := datadoghqv1alpha1.DatadogMonitorGroup{Name: "host:a", State: datadoghqv1alpha1.DatadogMonitorStateAlert, LastTransitionTime: metav1.Unix(1612245095, 0)}
+	newGroups := func(groups ...datadoghqv1alpha1.DatadogMonitorGroup) *datadoghqv1alpha1.DatadogMonitorGroups {
+		monitorGroups := &datadoghqv1alpha1.DatadogMonitorGroups{
+			ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: resourcesName},
+			MonitorID:  12345,
+			GroupCount: len(groups),
+			Groups:     groups,
+		}
+		for _, group := range groups {
+			if isTriggered(string(group.State)) {
+				monitorGroups.TriggeredCount++
+			}
+		}
+		return monitorGroups
+	}
+
+	tests := []struct {
+		name       string
+		existing   *datadoghqv1alpha1.DatadogMonitorGroups
+		desired    *datadoghqv1alpha1.DatadogMonitorGroups
+		wantGroups []datadoghqv1alpha1.DatadogMonitorGroup
+		wantFound  bool
+		wantUpdate bool
+	}{
+		{
+			name:    "no groups",
+			desired: newGroups(),
+		},
+		{
+			name:       "create",
+			desired:    newGroups(alertGroup),
+			wantGroups: []datadoghqv1alpha1.DatadogMonitorGroup{alertGroup},
+			wantFound:  true,
+		},
+		{
+			name:       "unchanged",
+			existing:   newGroups(alertGroup),
+			desired:    newGroups(alertGroup),
+			wantGroups: []datadoghqv1alpha1.DatadogMonitorGroup{alertGroup},
+			wantFound:  true,
+		},
+		{
+			name:       "update",
+			existing:   newGroups(alertGroup),
+			desired:    newGroups(warnGroup),
+			wantGroups: []datadoghqv1alpha1.DatadogMonitorGroup{warnGroup},
+			wantFound:  true,
+			wantUpdate: true,
+		},
+		{
+			name:       "only the transition time changed",
+			existing:   newGroups(alertGroup),
+			desired:    newGroups(retriggeredGroup),
+			wantGroups: []datadoghqv1alpha1.DatadogMonitorGroup{alertGroup},
+			wantFound:  true,
+		},
+		{
+			name:       "groups removed",
+			existing:   newGroups(alertGroup),
+			desired:    newGroups(),
+			wantFound:  true,
+			wantUpdate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(s)
+			if tt.existing != nil {
+				builder = builder.WithObjects(tt.existing)
+			}
+			k8sClient := builder.Build()
+			a := &adapter{client: k8sClient, apiReader: k8sClient, scheme: s}
+
+			err := a.updateMonitorGroups(context.TODO(), logr.Discard(), dm, tt.desired)
+			require.NoError(t, err)
+
+			got := &datadoghqv1alpha1.DatadogMonitorGroups{}
+			err = a.client.Get(context.TODO(), types.NamespacedName{Namespace: resourcesNamespace, Name: resourcesName}, got)
+			if !tt.wantFound {
+				assert.True(t, apierrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, got.Groups, len(tt.wantGroups))
+			for i := range tt.wantGroups {
+				assert.True(t, got.Groups[i].LastTransitionTime.Equal(&tt.wantGroups[i].LastTransitionTime))
+				assert.Equal(t, tt.wantGroups[i].State, got.Groups[i].State)
+			}
+			assert.Equal(t, len(tt.wantGroups), got.GroupCount)
+			if tt.existing == nil {
+				require.Len(t, got.OwnerReferences, 1)
+				assert.Equal(t, dm.UID, got.OwnerReferences[0].UID)
+			} else {
+				assert.Equal(t, tt.wantUpdate, got.ResourceVersion != tt.existing.ResourceVersion)
+			}
+		})
+	}
+}
@@ -7,17 +7,16 @@ package datadogmonitor
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"net/url"
+	"encoding/json"
 	"sort"
 	"strconv"
 
 	"github.com/go-logr/logr"
 
-	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 )
 
 func buildMonitor(logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor) (*datadogV1.Monitor, *datadogV1.MonitorUpdateRequest) {
@@ -203,16 +202,16 @@ func getMonitor(auth context.Context, client *datadogV1.MonitorsApi, monitorID i
 	}
 	m, _, err := client.GetMonitor(auth, int64(monitorID), optionalParams)
 	if err != nil {
-		return datadogV1.Monitor{}, translateClientError(err, "error getting monitor")
+		return datadogV1.Monitor{}, datadogclient.TranslateClientError(err, "error getting monitor")
 	}
 
-	return m, nil
+	return parseUnknownTypeMonitor(m), nil
 }
 
 func validateMonitor(auth context.Context, logger logr.Logger, client *datadogV1.MonitorsApi, dm *datadoghqv1alpha1.DatadogMonitor) error {
 	m, _ := buildMonitor(logger, dm)
 	if _, _, err := client.ValidateMonitor(auth, *m); err != nil {
-		return translateClientError(err, "error validating monitor")
+		return datadogclient.TranslateClientError(err, "error validating monitor")
 	}
 
 	return nil
@@ -222,10 +221,10 @@ func createMonitor(auth context.Context, logger logr.Logger, client *datadogV1.M
 	m, _ := buildMonitor(logger, dm)
 	mCreated, _, err := client.CreateMonitor(auth, *m)
 	if err != nil {
-		return datadogV1.Monitor{}, translateClientError(err, "error creating monitor")
+		return datadogV1.Monitor{}, datadogclient.TranslateClientError(err, "error creating monitor")
 	}
 
-	return mCreated, nil
+	return parseUnknownTypeMonitor(mCreated), nil
 }
 
 func updateMonitor(auth context.Context, logger logr.Logger, client *datadogV1.MonitorsApi, dm *datadoghqv1alpha1.DatadogMonitor) (datadogV1.Monitor, error) {
@@ -233,40 +232,71 @@ func updateMonitor(auth context.Context, logger logr.Logger, client *datadogV1.M
 
 	mUpdated, _, err := client.UpdateMonitor(auth, int64(dm.Status.ID), *u)
 	if err != nil {
-		return datadogV1.Monitor{}, translateClientError(err, "error updating monitor")
+		return datadogV1.Monitor{}, datadogclient.TranslateClientError(err, "error updating monitor")
 	}
 
 	// TODO additional logic to handle downtimes (and silenced param if needed)
 
-	return mUpdated, nil
+	return parseUnknownTypeMonitor(mUpdated), nil
 }
 
-func deleteMonitor(auth context.Context, client *datadogV1.MonitorsApi, monitorID int) error {
-	force := "false"
-	optionalParams := datadogV1.DeleteMonitorOptionalParameters{
-		Force: &force,
+// parseUnknownTypeMonitor parses a monitor whose type isn't known by the API client, such as cost monitors.
+// The API client keeps such monitors unparsed, which would otherwise leave their ID and state empty.
+func parseUnknownTypeMonitor(m datadogV1.Monitor) datadogV1.Monitor {
+	monitorType, ok := m.UnparsedObject["type"].(string)
+	if !ok || datadogV1.MonitorType(monitorType).IsValid() {
+		return m
 	}
-	if _, _, err := client.DeleteMonitor(auth, int64(monitorID), optionalParams); err != nil {
-		return translateClientError(err, "error deleting monitor")
+
+	raw := make(map[string]interface{}, len(m.UnparsedObject))
+	for k, v := range m.UnparsedObject {
+		raw[k] = v
 	}
+	// Any known type lets the API client parse the other fields
+	raw["type"] = string(datadogV1.MONITORTYPE_QUERY_ALERT)
 
-	return nil
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return m
+	}
+	parsed := datadogV1.Monitor{}
+	if err = json.Unmarshal(b, &parsed); err != nil || parsed.UnparsedObject != nil {
+		return m
+	}
+	parsed.Type = datadogV1.MonitorType(monitorType)
+
+	return parsed
 }
 
-func translateClientError(err error, msg string) error {
-	if msg == "" {
-		msg = "an error occurred"
+// orphanMonitor tags the monitor as no longer managed by the operator. Only the tags are updated, so that the monitor
+// is left as it is otherwise.
+func orphanMonitor(auth context.Context, client *datadogV1.MonitorsApi, monitorID int) error {
+	m, err := getMonitor(auth, client, monitorID)
+	if err != nil {
+		return err
+	}
+	tags, changed := utils.AddOrphanedTag(m.GetTags())
+	if !changed {
+		return nil
 	}
 
-	var apiErr datadogapi.GenericOpenAPIError
-	var errURL *url.Error
-	if errors.As(err, &apiErr) {
-		return fmt.Errorf(msg+": %w: %s", err, apiErr.Body())
+	u := datadogV1.MonitorUpdateRequest{}
+	u.SetTags(tags)
+	if _, _, err = client.UpdateMonitor(auth, int64(monitorID), u); err != nil {
+		return datadogclient.TranslateClientError(err, "error orphaning monitor")
 	}
 
-	if errors.As(err, &errURL) {
-		return fmt.Errorf(msg+" (url.Error): %s", errURL)
+	return nil
+}
+
+func deleteMonitor(auth context.Context, client *datadogV1.MonitorsApi, monitorID int) error {
+	force := "false"
+	optionalParams := datadogV1.DeleteMonitorOptionalParameters{
+		Force: &force,
+	}
+	if _, _, err := client.DeleteMonitor(auth, int64(monitorID), optionalParams); err != nil {
+		return datadogclient.TranslateClientError(err, "error deleting monitor")
 	}
 
-	return fmt.Errorf(msg+": %w", err)
+	return nil
 }
@@ -7,8 +7,7 @@ package datadogmonitor
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -17,7 +16,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
@@ -26,6 +27,10 @@ import (
 
 const dateFormat = "2006-01-02 15:04:05.999999999 -0700 MST"
 
+var (
+	testLogger logr.Logger = zap.New(zap.UseDevMode(true))
+)
+
 func Test_buildMonitor(t *testing.T) {
 	evalDelay := int64(100)
 	escalationMsg := "This is an escalation message"
@@ -251,6 +256,37 @@ func Test_createMonitor(t *testing.T) {
 	assert.Equal(t, dm.Spec.Tags, monitor.GetTags(), "discrepancy found in parameter: Tags")
 }
 
+func Test_createMonitor_unknownType(t *testing.T) {
+	dm := &datadoghqv1alpha1.DatadogMonitor{
+		Spec: datadoghqv1alpha1.DatadogMonitorSpec{
+			Query:   "formula(\"query1\").last(\"7d\").anomaly(direction=\"above\", threshold=10) >= 5",
+			Type:    datadoghqv1alpha1.DatadogMonitorTypeCost,
+			Name:    "Test cost monitor",
+			Message: "Costs are increasing",
+		},
+	}
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":12345,"name":"Test cost monitor","type":"cost alert","query":"q","overall_state":"OK"}`))
+	}))
+	defer httpServer.Close()
+
+	testConfig := datadogapi.NewConfiguration()
+	testConfig.HTTPClient = httpServer.Client()
+	apiClient := datadogapi.NewAPIClient(testConfig)
+	client := datadogV1.NewMonitorsApi(apiClient)
+	testAuth := setupTestAuth(httpServer.URL)
+
+	monitor, err := createMonitor(testAuth, testLogger, client, dm)
+	assert.Nil(t, err)
+
+	assert.Nil(t, monitor.UnparsedObject)
+	assert.Equal(t, int64(12345), monitor.GetId())
+	assert.Equal(t, "cost alert", string(monitor.GetType()))
+	assert.Equal(t, datadogV1.MONITOROVERALLSTATES_OK, monitor.GetOverallState())
+}
+
 func Test_updateMonitor(t *testing.T) {
 	mId := 12345
 	expectedMonitor := genericMonitor(mId)
@@ -314,6 +350,70 @@ func Test_deleteMonitor(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_orphanMonitor(t *testing.T) {
+	mId := 12345
+
+	tests := []struct {
+		name       string
+		tags       []string
+		wantUpdate []string
+	}{
+		{
+			name:       "orphaned tag added",
+			tags:       []string{"env:staging"},
+			wantUpdate: []string{"env:staging", "orphaned:kubernetes"},
+		},
+		{
+			name:       "generated tag replaced",
+			tags:       []string{"generated:kubernetes", "env:staging"},
+			wantUpdate: []string{"env:staging", "orphaned:kubernetes"},
+		},
+		{
+			name: "monitor already orphaned",
+			tags: []string{"env:staging", "orphaned:kubernetes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			monitor := genericMonitor(mId)
+			monitor.Tags = tt.tags
+			jsonMonitor, _ := monitor.MarshalJSON()
+
+			var gotUpdate *datadogV1.MonitorUpdateRequest
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut {
+					gotUpdate = &datadogV1.MonitorUpdateRequest{}
+					_ = json.NewDecoder(r.Body).Decode(gotUpdate)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonMonitor)
+			}))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV1.NewMonitorsApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			err := orphanMonitor(testAuth, client, mId)
+			assert.Nil(t, err)
+
+			if tt.wantUpdate == nil {
+				assert.Nil(t, gotUpdate)
+				return
+			}
+			if assert.NotNil(t, gotUpdate) {
+				assert.Equal(t, tt.wantUpdate, gotUpdate.GetTags())
+				// Only the tags are updated
+				assert.Empty(t, gotUpdate.GetQuery())
+				assert.Empty(t, gotUpdate.GetName())
+			}
+		})
+	}
+}
+
 func genericMonitor(mID int) datadogV1.Monitor {
 	fakeRawNow := time.Unix(1612244495, 0)
 	fakeNow, _ := time.Parse(dateFormat, strings.Split(fakeRawNow.String(), " m=")[0])
@@ -365,58 +465,3 @@ func setupTestAuth(apiURL string) context.Context {
 
 	return testAuth
 }
-
-func Test_translateClientError(t *testing.T) {
-	var ErrGeneric = errors.New("generic error")
-
-	testCases := []struct {
-		name                   string
-		error                  error
-		message                string
-		expectedErrorType      error
-		expectedError          error
-		expectedErrorInterface interface{}
-	}{
-		{
-			name:              "no message, generic error",
-			error:             ErrGeneric,
-			message:           "",
-			expectedErrorType: ErrGeneric,
-		},
-		{
-			name:              "generic message, generic error",
-			error:             ErrGeneric,
-			message:           "generic message",
-			expectedErrorType: ErrGeneric,
-		},
-		{
-			name:                   "generic message, error type datadogV1.GenericOpenAPIError",
-			error:                  datadogapi.GenericOpenAPIError{},
-			message:                "generic message",
-			expectedErrorInterface: &datadogapi.GenericOpenAPIError{},
-		},
-		{
-			name:          "generic message, error type *url.Error",
-			error:         &url.Error{Err: fmt.Errorf("generic url error")},
-			message:       "generic message",
-			expectedError: fmt.Errorf("generic message (url.Error):  \"\": generic url error"),
-		},
-	}
-	for _, test := range testCases {
-		t.Run(test.name, func(t *testing.T) {
-			result := translateClientError(test.error, test.message)
-
-			if test.expectedErrorType != nil {
-				assert.True(t, errors.Is(result, test.expectedErrorType))
-			}
-
-			if test.expectedErrorInterface != nil {
-				assert.True(t, errors.As(result, test.expectedErrorInterface))
-			}
-
-			if test.expectedError != nil {
-				assert.Equal(t, test.expectedError, result)
-			}
-		})
-	}
-}
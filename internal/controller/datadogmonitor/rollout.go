@@ -0,0 +1,431 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	datadogV2 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	deploymentKind  = "Deployment"
+	statefulSetKind = "StatefulSet"
+
+	// Tags set by the Datadog Agent on Kubernetes resources
+	kubeNamespaceTag   = "kube_namespace"
+	kubeDeploymentTag  = "kube_deployment"
+	kubeStatefulSetTag = "kube_stateful_set"
+
+	// rolloutDowntimeDuration is how long the rollout downtime lasts from its last update. It's extended when half of
+	// it has elapsed and the rollouts continue.
+	rolloutDowntimeDuration = time.Hour
+)
+
+// muteDuringRollouts creates a downtime muting the monitor while the workloads of its rollout mute roll out, updates
+// it when the workloads in rollout change or its end nears, and cancels it once the rollouts complete or the rollout
+// mute is removed. The downtime ends by itself if the operator stops extending it, and is found back by the UID of the
+// DatadogMonitor in its message if its ID is lost from the status.
+func (a *adapter) muteDuringRollouts(ctx context.Context, logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor) error {
+	status := &dm.Status.DowntimeStatus
+
+	var workloads []string
+	if dm.Spec.RolloutMute != nil {
+		var err error
+		if workloads, err = a.getWorkloadsInRollout(ctx, dm.Namespace, dm.Spec.RolloutMute); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	end := metav1.NewTime(now.Add(rolloutDowntimeDuration).Truncate(time.Second))
+	switch {
+	case len(workloads) == 0 && status.RolloutDowntimeID == "":
+		return nil
+	case len(workloads) == 0:
+		if err := a.cancelRolloutDowntime(ctx, dm); err != nil {
+			return err
+		}
+		logger.Info("Rollouts completed; unmuted the monitor", "ID", dm.Status.ID)
+		return nil
+	case status.RolloutDowntimeID == "":
+		id, err := a.findRolloutDowntime(ctx, dm)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			status.RolloutDowntimeID = id
+			if err = a.updateRolloutDowntime(ctx, dm, workloads, end); err != nil {
+				return err
+			}
+			logger.Info("Found the downtime muting the monitor during rollouts", "ID", dm.Status.ID, "downtime ID", status.RolloutDowntimeID, "workloads", workloads)
+			break
+		}
+		downtime, _, err := a.downtimeClient.CreateDowntime(a.auth(ctx), *buildRolloutDowntimeCreateRequest(dm, workloads, end.Time))
+		if err != nil {
+			return datadogclient.TranslateClientError(err, "error creating rollout downtime")
+		}
+		status.RolloutDowntimeID = downtime.Data.GetId()
+		status.RolloutDowntimeEnd = &end
+		logger.Info("Muted the monitor during rollouts", "ID", dm.Status.ID, "downtime ID", status.RolloutDowntimeID, "workloads", workloads)
+	case !slices.Equal(workloads, status.RolloutWorkloads):
+		if err := a.updateRolloutDowntime(ctx, dm, workloads, end); err != nil {
+			return err
+		}
+		logger.Info("Updated the workloads in rollout muting the monitor", "ID", dm.Status.ID, "downtime ID", status.RolloutDowntimeID, "workloads", workloads)
+	case status.RolloutDowntimeEnd == nil || status.RolloutDowntimeEnd.Sub(now) < rolloutDowntimeDuration/2:
+		if err := a.updateRolloutDowntime(ctx, dm, workloads, end); err != nil {
+			return err
+		}
+		logger.Info("Extended the downtime muting the monitor during rollouts", "ID", dm.Status.ID, "downtime ID", status.RolloutDowntimeID, "end", end)
+	}
+
+	status.RolloutWorkloads = workloads
+	status.IsDowntimed = true
+	return nil
+}
+
+// findRolloutDowntime returns the ID of the current downtime muting the monitor during rollouts in Datadog, empty if
+// there's none.
+func (a *adapter) findRolloutDowntime(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) (string, error) {
+	marker := buildRolloutDowntimeMarker(dm)
+	results, cancel := a.downtimeClient.ListDowntimesWithPagination(a.auth(ctx), *datadogV2.NewListDowntimesOptionalParameters().WithCurrentOnly(true))
+	defer cancel()
+	for result := range results {
+		if result.Error != nil {
+			return "", datadogclient.TranslateClientError(result.Error, "error listing downtimes")
+		}
+		attributes := result.Item.GetAttributes()
+		monitorIdentifier := attributes.GetMonitorIdentifier()
+		if monitorIdentifier.DowntimeMonitorIdentifierId == nil || monitorIdentifier.DowntimeMonitorIdentifierId.MonitorId != int64(dm.Status.ID) {
+			continue
+		}
+		if attributes.GetStatus() != datadogV2.DOWNTIMESTATUS_CANCELED && attributes.GetStatus() != datadogV2.DOWNTIMESTATUS_ENDED && strings.Contains(attributes.GetMessage(), marker) {
+			return result.Item.GetId(), nil
+		}
+	}
+	return "", nil
+}
+
+// updateRolloutDowntime updates the downtime muting the monitor during rollouts with the workloads in rollout and a
+// new end.
+func (a *adapter) updateRolloutDowntime(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor, workloads []string, end metav1.Time) error {
+	status := &dm.Status.DowntimeStatus
+	if _, _, err := a.downtimeClient.UpdateDowntime(a.auth(ctx), status.RolloutDowntimeID, *buildRolloutDowntimeUpdateRequest(dm, workloads, end.Time)); err != nil {
+		return datadogclient.TranslateClientError(err, "error updating rollout downtime")
+	}
+	status.RolloutDowntimeEnd = &end
+	return nil
+}
+
+// cancelRolloutDowntime cancels the downtime muting the monitor during rollouts, if any. A downtime already canceled
+// or deleted in Datadog is ignored.
+func (a *adapter) cancelRolloutDowntime(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) error {
+	status := &dm.Status.DowntimeStatus
+	if status.RolloutDowntimeID == "" {
+		return nil
+	}
+
+	if _, err := a.downtimeClient.CancelDowntime(a.auth(ctx), status.RolloutDowntimeID); err != nil && !strings.Contains(err.Error(), ctrutils.NotFoundString) {
+		return datadogclient.TranslateClientError(err, "error canceling rollout downtime")
+	}
+	status.RolloutDowntimeID = ""
+	status.RolloutWorkloads = nil
+	status.RolloutDowntimeEnd = nil
+	status.IsDowntimed = false
+	return nil
+}
+
+// getWorkloadsInRollout returns the sorted workloads of the rollout mute that are rolling out, as <kind>/<name>. The
+// named workloads that don't exist are ignored.
+func (a *adapter) getWorkloadsInRollout(ctx context.Context, namespace string, mute *datadoghqv1alpha1.DatadogMonitorRolloutMute) ([]string, error) {
+	deployments := map[string]*appsv1.Deployment{}
+	statefulSets := map[string]*appsv1.StatefulSet{}
+
+	for _, name := range mute.Deployments {
+		deployment := &appsv1.Deployment{}
+		if err := a.getWorkload(ctx, namespace, deploymentKind, name, deployment); err != nil {
+			return nil, err
+		} else if deployment.Name != "" {
+			deployments[name] = deployment
+		}
+	}
+	for _, name := range mute.StatefulSets {
+		statefulSet := &appsv1.StatefulSet{}
+		if err := a.getWorkload(ctx, namespace, statefulSetKind, name, statefulSet); err != nil {
+			return nil, err
+		} else if statefulSet.Name != "" {
+			statefulSets[name] = statefulSet
+		}
+	}
+
+	if mute.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(mute.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rollout mute label selector: %w", err)
+		}
+		deploymentList := &appsv1.DeploymentList{}
+		if err = a.client.List(ctx, deploymentList, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("error listing Deployments: %w", err)
+		}
+		for i := range deploymentList.Items {
+			deployments[deploymentList.Items[i].Name] = &deploymentList.Items[i]
+		}
+		statefulSetList := &appsv1.StatefulSetList{}
+		if err = a.client.List(ctx, statefulSetList, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("error listing StatefulSets: %w", err)
+		}
+		for i := range statefulSetList.Items {
+			statefulSets[statefulSetList.Items[i].Name] = &statefulSetList.Items[i]
+		}
+	}
+
+	var workloads []string
+	for name, deployment := range deployments {
+		if isDeploymentRollingOut(deployment) {
+			workloads = append(workloads, deploymentKind+"/"+name)
+		}
+	}
+	for name, statefulSet := range statefulSets {
+		if isStatefulSetRollingOut(statefulSet) {
+			workloads = append(workloads, statefulSetKind+"/"+name)
+		}
+	}
+	sort.Strings(workloads)
+	return workloads, nil
+}
+
+// IsRolloutWorkload returns whether the rollouts of a Deployment or StatefulSet mute a DatadogMonitor, or whether the
+// workload is muting it, so that the DatadogMonitor is synced when the workload changes.
+func IsRolloutWorkload(dm *datadoghqv1alpha1.DatadogMonitor, workload client.Object) bool {
+	if dm.Namespace != workload.GetNamespace() {
+		return false
+	}
+
+	mute := dm.Spec.RolloutMute
+	var kind string
+	var names []string
+	switch workload.(type) {
+	case *appsv1.Deployment:
+		kind = deploymentKind
+		if mute != nil {
+			names = mute.Deployments
+		}
+	case *appsv1.StatefulSet:
+		kind = statefulSetKind
+		if mute != nil {
+			names = mute.StatefulSets
+		}
+	default:
+		return false
+	}
+
+	if slices.Contains(dm.Status.DowntimeStatus.RolloutWorkloads, kind+"/"+workload.GetName()) {
+		return true
+	}
+	if mute == nil {
+		return false
+	}
+	if slices.Contains(names, workload.GetName()) {
+		return true
+	}
+	if mute.LabelSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(mute.LabelSelector)
+	return err == nil && selector.Matches(labels.Set(workload.GetLabels()))
+}
+
+// RolloutChangedPredicate filters the updates of the Deployments and StatefulSets that can't start or complete a
+// rollout: only the changes of their generation, labels and rollout status are kept.
+var RolloutChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() || !maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels()) {
+			return true
+		}
+		switch oldWorkload := e.ObjectOld.(type) {
+		case *appsv1.Deployment:
+			newWorkload, ok := e.ObjectNew.(*appsv1.Deployment)
+			return !ok || deploymentRolloutStatus(oldWorkload) != deploymentRolloutStatus(newWorkload)
+		case *appsv1.StatefulSet:
+			newWorkload, ok := e.ObjectNew.(*appsv1.StatefulSet)
+			return !ok || statefulSetRolloutStatus(oldWorkload) != statefulSetRolloutStatus(newWorkload)
+		}
+		return true
+	},
+}
+
+// rolloutStatus is the part of the status of a workload used to detect its rollouts.
+type rolloutStatus struct {
+	observedGeneration int64
+	replicas           int32
+	updatedReplicas    int32
+	readyReplicas      int32
+	availableReplicas  int32
+	currentRevision    string
+	updateRevision     string
+}
+
+func deploymentRolloutStatus(deployment *appsv1.Deployment) rolloutStatus {
+	status := deployment.Status
+	return rolloutStatus{
+		observedGeneration: status.ObservedGeneration,
+		replicas:           status.Replicas,
+		updatedReplicas:    status.UpdatedReplicas,
+		readyReplicas:      status.ReadyReplicas,
+		availableReplicas:  status.AvailableReplicas,
+	}
+}
+
+func statefulSetRolloutStatus(statefulSet *appsv1.StatefulSet) rolloutStatus {
+	status := statefulSet.Status
+	return rolloutStatus{
+		observedGeneration: status.ObservedGeneration,
+		replicas:           status.Replicas,
+		updatedReplicas:    status.UpdatedReplicas,
+		readyReplicas:      status.ReadyReplicas,
+		availableReplicas:  status.AvailableReplicas,
+		currentRevision:    status.CurrentRevision,
+		updateRevision:     status.UpdateRevision,
+	}
+}
+
+// getWorkload gets a workload, leaving obj empty if it doesn't exist.
+func (a *adapter) getWorkload(ctx context.Context, namespace, kind, name string, obj client.Object) error {
+	if err := a.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error getting %s %s: %w", kind, name, err)
+	}
+	return nil
+}
+
+// isDeploymentRollingOut returns true until the rollout of the Deployment completes, like `kubectl rollout status`:
+// its latest spec is observed, and all its replicas are updated and available.
+func isDeploymentRollingOut(deployment *appsv1.Deployment) bool {
+	status := deployment.Status
+	if deployment.Generation > status.ObservedGeneration {
+		return true
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return status.UpdatedReplicas < replicas || status.Replicas > status.UpdatedReplicas || status.AvailableReplicas < status.UpdatedReplicas
+}
+
+// isStatefulSetRollingOut returns true until the rolling update of the StatefulSet completes, like `kubectl rollout
+// status`: its latest spec is observed, and its replicas beyond the partition are updated and ready. StatefulSets
+// updated on delete never roll out.
+func isStatefulSetRollingOut(statefulSet *appsv1.StatefulSet) bool {
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return false
+	}
+	status := statefulSet.Status
+	if statefulSet.Generation > status.ObservedGeneration {
+		return true
+	}
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	if status.ReadyReplicas < replicas {
+		return true
+	}
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		return status.UpdatedReplicas < replicas-*rollingUpdate.Partition
+	}
+	return status.UpdateRevision != status.CurrentRevision
+}
+
+// buildRolloutScope returns the scope of the rollout downtime: the one of the rollout mute, or else the namespace and
+// the workloads in rollout.
+func buildRolloutScope(dm *datadoghqv1alpha1.DatadogMonitor, workloads []string) string {
+	if dm.Spec.RolloutMute.Scope != "" {
+		return dm.Spec.RolloutMute.Scope
+	}
+
+	var deployments, statefulSets []string
+	for _, workload := range workloads {
+		kind, name, _ := strings.Cut(workload, "/")
+		if kind == deploymentKind {
+			deployments = append(deployments, name)
+		} else {
+			statefulSets = append(statefulSets, name)
+		}
+	}
+
+	var workloadQueries []string
+	if len(deployments) > 0 {
+		workloadQueries = append(workloadQueries, buildTagQuery(kubeDeploymentTag, deployments))
+	}
+	if len(statefulSets) > 0 {
+		workloadQueries = append(workloadQueries, buildTagQuery(kubeStatefulSetTag, statefulSets))
+	}
+	workloadQuery := strings.Join(workloadQueries, " OR ")
+	if len(workloadQueries) > 1 {
+		workloadQuery = "(" + workloadQuery + ")"
+	}
+
+	return buildTagQuery(kubeNamespaceTag, []string{dm.Namespace}) + " AND " + workloadQuery
+}
+
+func buildTagQuery(name string, values []string) string {
+	if len(values) == 1 {
+		return fmt.Sprintf("%s:%s", name, values[0])
+	}
+	return fmt.Sprintf("%s:(%s)", name, strings.Join(values, " OR "))
+}
+
+// buildRolloutDowntimeMarker returns the part of the message of the rollout downtime identifying the DatadogMonitor.
+func buildRolloutDowntimeMarker(dm *datadoghqv1alpha1.DatadogMonitor) string {
+	return fmt.Sprintf("(DatadogMonitor UID: %s)", dm.UID)
+}
+
+func buildRolloutDowntimeMessage(dm *datadoghqv1alpha1.DatadogMonitor, workloads []string) string {
+	return fmt.Sprintf("Muted by the DatadogMonitor %s/%s while %s roll out %s", dm.Namespace, dm.Name, strings.Join(workloads, ", "), buildRolloutDowntimeMarker(dm))
+}
+
+func buildRolloutDowntimeSchedule(end time.Time) *datadogV2.DowntimeScheduleOneTimeCreateUpdateRequest {
+	schedule := datadogV2.NewDowntimeScheduleOneTimeCreateUpdateRequest()
+	schedule.SetEnd(end)
+	return schedule
+}
+
+// buildRolloutDowntimeCreateRequest returns a downtime muting the monitor from now on, until it's canceled or ends.
+func buildRolloutDowntimeCreateRequest(dm *datadoghqv1alpha1.DatadogMonitor, workloads []string, end time.Time) *datadogV2.DowntimeCreateRequest {
+	monitorIdentifier := datadogV2.DowntimeMonitorIdentifierIdAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierId(int64(dm.Status.ID)))
+	attributes := datadogV2.NewDowntimeCreateRequestAttributes(monitorIdentifier, buildRolloutScope(dm, workloads))
+	attributes.SetMessage(buildRolloutDowntimeMessage(dm, workloads))
+	attributes.SetSchedule(datadogV2.DowntimeScheduleOneTimeCreateUpdateRequestAsDowntimeScheduleCreateRequest(buildRolloutDowntimeSchedule(end)))
+	return datadogV2.NewDowntimeCreateRequest(*datadogV2.NewDowntimeCreateRequestData(*attributes, datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME))
+}
+
+func buildRolloutDowntimeUpdateRequest(dm *datadoghqv1alpha1.DatadogMonitor, workloads []string, end time.Time) *datadogV2.DowntimeUpdateRequest {
+	attributes := datadogV2.NewDowntimeUpdateRequestAttributes()
+	attributes.SetMonitorIdentifier(datadogV2.DowntimeMonitorIdentifierIdAsDowntimeMonitorIdentifier(datadogV2.NewDowntimeMonitorIdentifierId(int64(dm.Status.ID))))
+	attributes.SetScope(buildRolloutScope(dm, workloads))
+	attributes.SetMessage(buildRolloutDowntimeMessage(dm, workloads))
+	attributes.SetSchedule(datadogV2.DowntimeScheduleOneTimeCreateUpdateRequestAsDowntimeScheduleUpdateRequest(buildRolloutDowntimeSchedule(end)))
+	return datadogV2.NewDowntimeUpdateRequest(*datadogV2.NewDowntimeUpdateRequestData(*attributes, dm.Status.DowntimeStatus.RolloutDowntimeID, datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME))
+}
@@ -0,0 +1,514 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	datadogV2 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/pkg/testutils/fakedatadog"
+)
+
+func Test_isDeploymentRollingOut(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment appsv1.Deployment
+		want       bool
+	}{
+		{
+			name: "rolled out",
+			deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+		},
+		{
+			name: "spec not observed",
+			deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 3},
+				Spec:       appsv1.DeploymentSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			want: true,
+		},
+		{
+			name: "replicas not updated",
+			deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 2},
+			},
+			want: true,
+		},
+		{
+			name: "old replicas not terminated",
+			deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 2, AvailableReplicas: 2},
+			},
+			want: true,
+		},
+		{
+			name: "updated replicas not available",
+			deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1},
+			},
+			want: true,
+		},
+		{
+			name: "default replicas",
+			deployment: appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status:     appsv1.DeploymentStatus{ObservedGeneration: 1},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isDeploymentRollingOut(&tt.deployment))
+		})
+	}
+}
+
+func Test_isStatefulSetRollingOut(t *testing.T) {
+	tests := []struct {
+		name        string
+		statefulSet appsv1.StatefulSet
+		want        bool
+	}{
+		{
+			name: "rolled out",
+			statefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.StatefulSetSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 2, UpdatedReplicas: 2, CurrentRevision: "rev-2", UpdateRevision: "rev-2"},
+			},
+		},
+		{
+			name: "spec not observed",
+			statefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 3},
+				Spec:       appsv1.StatefulSetSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 2, UpdatedReplicas: 2, CurrentRevision: "rev-2", UpdateRevision: "rev-2"},
+			},
+			want: true,
+		},
+		{
+			name: "replicas not ready",
+			statefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.StatefulSetSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 1, UpdatedReplicas: 2, CurrentRevision: "rev-2", UpdateRevision: "rev-2"},
+			},
+			want: true,
+		},
+		{
+			name: "revision not updated",
+			statefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       appsv1.StatefulSetSpec{Replicas: apiutils.NewInt32Pointer(2)},
+				Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 2, UpdatedReplicas: 1, CurrentRevision: "rev-1", UpdateRevision: "rev-2"},
+			},
+			want: true,
+		},
+		{
+			name: "replicas beyond the partition updated",
+			statefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: apiutils.NewInt32Pointer(3),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: apiutils.NewInt32Pointer(2)},
+					},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 2, ReadyReplicas: 3, UpdatedReplicas: 1, CurrentRevision: "rev-1", UpdateRevision: "rev-2"},
+			},
+		},
+		{
+			name: "updated on delete",
+			statefulSet: appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Generation: 3},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       apiutils.NewInt32Pointer(2),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{ObservedGeneration: 2, CurrentRevision: "rev-1", UpdateRevision: "rev-2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isStatefulSetRollingOut(&tt.statefulSet))
+		})
+	}
+}
+
+func TestIsRolloutWorkload(t *testing.T) {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "api", Labels: map[string]string{"app": "api"}}}
+	statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "api"}}
+
+	tests := []struct {
+		name     string
+		mute     *datadoghqv1alpha1.DatadogMonitorRolloutMute
+		status   datadoghqv1alpha1.DatadogMonitorDowntimeStatus
+		workload client.Object
+		want     bool
+	}{
+		{
+			name:     "named deployment",
+			mute:     &datadoghqv1alpha1.DatadogMonitorRolloutMute{Deployments: []string{"api"}},
+			workload: deployment,
+			want:     true,
+		},
+		{
+			name:     "stateful set with the name of a muting deployment",
+			mute:     &datadoghqv1alpha1.DatadogMonitorRolloutMute{Deployments: []string{"api"}},
+			workload: statefulSet,
+			want:     false,
+		},
+		{
+			name:     "other deployment",
+			mute:     &datadoghqv1alpha1.DatadogMonitorRolloutMute{Deployments: []string{"worker"}},
+			workload: deployment,
+			want:     false,
+		},
+		{
+			name:     "deployment selected by labels",
+			mute:     &datadoghqv1alpha1.DatadogMonitorRolloutMute{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}},
+			workload: deployment,
+			want:     true,
+		},
+		{
+			name:     "deployment not selected by labels",
+			mute:     &datadoghqv1alpha1.DatadogMonitorRolloutMute{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "worker"}}},
+			workload: deployment,
+			want:     false,
+		},
+		{
+			name:     "deployment muting the monitor after the rollout mute is removed",
+			status:   datadoghqv1alpha1.DatadogMonitorDowntimeStatus{RolloutDowntimeID: "1", RolloutWorkloads: []string{"Deployment/api"}},
+			workload: deployment,
+			want:     true,
+		},
+		{
+			name:     "no rollout mute",
+			workload: deployment,
+			want:     false,
+		},
+		{
+			name:     "deployment of another namespace",
+			mute:     &datadoghqv1alpha1.DatadogMonitorRolloutMute{Deployments: []string{"api"}},
+			workload: &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "api"}},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := &datadoghqv1alpha1.DatadogMonitor{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+				Spec:       datadoghqv1alpha1.DatadogMonitorSpec{RolloutMute: tt.mute},
+				Status:     datadoghqv1alpha1.DatadogMonitorStatus{DowntimeStatus: tt.status},
+			}
+			assert.Equal(t, tt.want, IsRolloutWorkload(dm, tt.workload))
+		})
+	}
+}
+
+func TestRolloutChangedPredicate(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "api", Generation: 2, ResourceVersion: "1"},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2},
+	}
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "db", Generation: 2, ResourceVersion: "1"},
+		Status:     appsv1.StatefulSetStatus{ObservedGeneration: 2, Replicas: 2, CurrentRevision: "db-1", UpdateRevision: "db-1"},
+	}
+
+	tests := []struct {
+		name   string
+		old    client.Object
+		update func(obj client.Object)
+		want   bool
+	}{
+		{
+			name: "deployment resynced",
+			old:  deployment,
+			update: func(obj client.Object) {
+				obj.SetResourceVersion("2")
+			},
+			want: false,
+		},
+		{
+			name: "deployment condition updated",
+			old:  deployment,
+			update: func(obj client.Object) {
+				obj.(*appsv1.Deployment).Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing}}
+			},
+			want: false,
+		},
+		{
+			name: "deployment spec updated",
+			old:  deployment,
+			update: func(obj client.Object) {
+				obj.SetGeneration(3)
+			},
+			want: true,
+		},
+		{
+			name: "deployment labels updated",
+			old:  deployment,
+			update: func(obj client.Object) {
+				obj.SetLabels(map[string]string{"app": "api"})
+			},
+			want: true,
+		},
+		{
+			name: "deployment replica updated",
+			old:  deployment,
+			update: func(obj client.Object) {
+				obj.(*appsv1.Deployment).Status.UpdatedReplicas = 1
+			},
+			want: true,
+		},
+		{
+			name: "stateful set revision updated",
+			old:  statefulSet,
+			update: func(obj client.Object) {
+				obj.(*appsv1.StatefulSet).Status.UpdateRevision = "db-2"
+			},
+			want: true,
+		},
+		{
+			name: "stateful set resynced",
+			old:  statefulSet,
+			update: func(obj client.Object) {
+				obj.SetResourceVersion("2")
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := tt.old.DeepCopyObject().(client.Object)
+			tt.update(updated)
+			assert.Equal(t, tt.want, RolloutChangedPredicate.Update(event.UpdateEvent{ObjectOld: tt.old, ObjectNew: updated}))
+		})
+	}
+}
+
+func Test_buildRolloutScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		scope     string
+		workloads []string
+		want      string
+	}{
+		{
+			name:      "one deployment",
+			workloads: []string{"Deployment/api"},
+			want:      "kube_namespace:foo AND kube_deployment:api",
+		},
+		{
+			name:      "several deployments",
+			workloads: []string{"Deployment/api", "Deployment/worker"},
+			want:      "kube_namespace:foo AND kube_deployment:(api OR worker)",
+		},
+		{
+			name:      "deployments and stateful sets",
+			workloads: []string{"Deployment/api", "StatefulSet/db"},
+			want:      "kube_namespace:foo AND (kube_deployment:api OR kube_stateful_set:db)",
+		},
+		{
+			name:      "custom scope",
+			scope:     "*",
+			workloads: []string{"Deployment/api"},
+			want:      "*",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := &datadoghqv1alpha1.DatadogMonitor{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+				Spec:       datadoghqv1alpha1.DatadogMonitorSpec{RolloutMute: &datadoghqv1alpha1.DatadogMonitorRolloutMute{Scope: tt.scope}},
+			}
+			assert.Equal(t, tt.want, buildRolloutScope(dm, tt.workloads))
+		})
+	}
+}
+
+func Test_muteDuringRollouts(t *testing.T) {
+	server := fakedatadog.NewServer()
+	defer server.Close()
+
+	testConfig := datadogapi.NewConfiguration()
+	testConfig.HTTPClient = server.Client()
+	downtimeClient := datadogV2.NewDowntimesApi(datadogapi.NewAPIClient(testConfig))
+
+	newDeployment := func(name string, labels map[string]string, rollingOut bool) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: name, Labels: labels, Generation: 2},
+			Spec:       appsv1.DeploymentSpec{Replicas: apiutils.NewInt32Pointer(1)},
+			Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1},
+		}
+		if rollingOut {
+			deployment.Status.ObservedGeneration = 1
+		}
+		return deployment
+	}
+
+	dm := &datadoghqv1alpha1.DatadogMonitor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: resourcesNamespace, Name: resourcesName, UID: "uid"},
+		Spec: datadoghqv1alpha1.DatadogMonitorSpec{
+			RolloutMute: &datadoghqv1alpha1.DatadogMonitorRolloutMute{
+				Deployments:   []string{"api", "missing"},
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}},
+			},
+		},
+		Status: datadoghqv1alpha1.DatadogMonitorStatus{ID: 123},
+	}
+
+	steps := []struct {
+		name          string
+		deployments   []*appsv1.Deployment
+		removeMute    bool
+		setStatus     func(status *datadoghqv1alpha1.DatadogMonitorDowntimeStatus)
+		wantWorkloads []string
+		wantScope     string
+		wantCanceled  bool
+		wantSameID    bool
+	}{
+		{
+			name:        "no rollout",
+			deployments: []*appsv1.Deployment{newDeployment("api", nil, false), newDeployment("worker", map[string]string{"team": "foo"}, false)},
+		},
+		{
+			name:          "named deployment rolling out",
+			deployments:   []*appsv1.Deployment{newDeployment("api", nil, true), newDeployment("worker", map[string]string{"team": "foo"}, false)},
+			wantWorkloads: []string{"Deployment/api"},
+			wantScope:     "kube_namespace:bar AND kube_deployment:api",
+		},
+		{
+			name:          "selected deployment rolling out",
+			deployments:   []*appsv1.Deployment{newDeployment("api", nil, true), newDeployment("worker", map[string]string{"team": "foo"}, true)},
+			wantWorkloads: []string{"Deployment/api", "Deployment/worker"},
+			wantScope:     "kube_namespace:bar AND kube_deployment:(api OR worker)",
+		},
+		{
+			name:        "downtime ID lost",
+			deployments: []*appsv1.Deployment{newDeployment("api", nil, true), newDeployment("worker", map[string]string{"team": "foo"}, true)},
+			setStatus: func(status *datadoghqv1alpha1.DatadogMonitorDowntimeStatus) {
+				*status = datadoghqv1alpha1.DatadogMonitorDowntimeStatus{}
+			},
+			wantWorkloads: []string{"Deployment/api", "Deployment/worker"},
+			wantScope:     "kube_namespace:bar AND kube_deployment:(api OR worker)",
+			wantSameID:    true,
+		},
+		{
+			name:        "downtime ending",
+			deployments: []*appsv1.Deployment{newDeployment("api", nil, true), newDeployment("worker", map[string]string{"team": "foo"}, true)},
+			setStatus: func(status *datadoghqv1alpha1.DatadogMonitorDowntimeStatus) {
+				status.RolloutDowntimeEnd = &metav1.Time{Time: time.Now().Add(time.Minute)}
+			},
+			wantWorkloads: []string{"Deployment/api", "Deployment/worker"},
+			wantScope:     "kube_namespace:bar AND kube_deployment:(api OR worker)",
+			wantSameID:    true,
+		},
+		{
+			name:         "rollouts completed",
+			deployments:  []*appsv1.Deployment{newDeployment("api", nil, false), newDeployment("worker", map[string]string{"team": "foo"}, false)},
+			wantCanceled: true,
+		},
+		{
+			name:          "rollout started again",
+			deployments:   []*appsv1.Deployment{newDeployment("api", nil, true)},
+			wantWorkloads: []string{"Deployment/api"},
+			wantScope:     "kube_namespace:bar AND kube_deployment:api",
+		},
+		{
+			name:         "rollout mute removed",
+			deployments:  []*appsv1.Deployment{newDeployment("api", nil, true)},
+			removeMute:   true,
+			wantCanceled: true,
+		},
+	}
+
+	var downtimeID string
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			for _, deployment := range step.deployments {
+				builder = builder.WithObjects(deployment)
+			}
+			a := &adapter{client: builder.Build(), downtimeClient: downtimeClient, datadogAuth: setupTestAuth(server.URL)}
+			if step.removeMute {
+				dm.Spec.RolloutMute = nil
+			}
+			if step.setStatus != nil {
+				step.setStatus(&dm.Status.DowntimeStatus)
+			}
+
+			err := a.muteDuringRollouts(context.TODO(), logr.Discard(), dm)
+			require.NoError(t, err)
+
+			status := dm.Status.DowntimeStatus
+			assert.Equal(t, step.wantWorkloads, status.RolloutWorkloads)
+			assert.Equal(t, len(step.wantWorkloads) > 0, status.IsDowntimed)
+			if step.wantCanceled {
+				assert.Empty(t, status.RolloutDowntimeID)
+				downtime, found := server.Downtime(downtimeID)
+				require.True(t, found)
+				assert.Equal(t, datadogV2.DOWNTIMESTATUS_CANCELED, downtime.Attributes.GetStatus())
+				return
+			}
+			if len(step.wantWorkloads) == 0 {
+				assert.Empty(t, status.RolloutDowntimeID)
+				return
+			}
+
+			if step.wantSameID {
+				assert.Equal(t, downtimeID, status.RolloutDowntimeID)
+			}
+			downtimeID = status.RolloutDowntimeID
+			downtime, found := server.Downtime(downtimeID)
+			require.True(t, found)
+			assert.Equal(t, step.wantScope, downtime.Attributes.GetScope())
+			assert.Contains(t, downtime.Attributes.GetMessage(), "Muted by the DatadogMonitor bar/foo")
+			assert.Contains(t, downtime.Attributes.GetMessage(), "(DatadogMonitor UID: uid)")
+			require.NotNil(t, status.RolloutDowntimeEnd)
+			assert.Greater(t, time.Until(status.RolloutDowntimeEnd.Time), rolloutDowntimeDuration/2)
+			assert.True(t, status.RolloutDowntimeEnd.Time.Equal(*downtime.Attributes.Schedule.DowntimeScheduleOneTimeResponse.End.Get()))
+			assert.Equal(t, int64(123), downtime.Attributes.MonitorIdentifier.DowntimeMonitorIdentifierId.MonitorId)
+		})
+	}
+	assert.Equal(t, 2, server.RequestCount("POST", "/api/v2/downtime"))
+	assert.Equal(t, 3, server.RequestCount("PATCH", "/api/v2/downtime/"))
+}
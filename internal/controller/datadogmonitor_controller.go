@@ -9,32 +9,44 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	"github.com/DataDog/datadog-operator/internal/controller/datadogmonitor"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
 
 	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 )
 
 // DatadogMonitorReconciler reconciles a DatadogMonitor object.
 type DatadogMonitorReconciler struct {
-	Client      client.Client
-	DDClient    datadogclient.DatadogMonitorClient
-	VersionInfo *version.Info
-	Log         logr.Logger
-	Scheme      *runtime.Scheme
-	Recorder    record.EventRecorder
-	internal    *datadogmonitor.Reconciler
+	Client         client.Client
+	APIReader      client.Reader
+	DDClient       datadogclient.DatadogMonitorClient
+	VersionInfo    *version.Info
+	Log            logr.Logger
+	Scheme         *runtime.Scheme
+	Recorder       record.EventRecorder
+	DeletionPolicy datadoghqv1alpha1.DeletionPolicy
+	NamespaceTags  utils.NamespaceTags
+	internal       *datadogmonitor.Reconciler
 }
 
 // +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitors/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitors/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitorgroups,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;namespaces,verbs=get
 
 // Reconcile loop for DatadogMonitor.
 func (r *DatadogMonitorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -43,19 +55,45 @@ func (r *DatadogMonitorReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 // SetupWithManager creates a new DatadogMonitor controller.
 func (r *DatadogMonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	internal, err := datadogmonitor.NewReconciler(r.Client, r.DDClient, r.VersionInfo, r.Scheme, r.Log, r.Recorder)
+	internal, err := datadogmonitor.NewReconciler(r.Client, r.APIReader, r.DDClient, r.VersionInfo, r.Scheme, r.Log, r.Recorder, r.DeletionPolicy, r.NamespaceTags)
 	if err != nil {
 		return err
 	}
 	r.internal = internal
 
-	builder := ctrl.NewControllerManagedBy(mgr).
-		For(&datadoghqv1alpha1.DatadogMonitor{})
+	// The status of the workloads is needed to detect rollouts, so they are not watched as metadata only
+	workloadHandler := handler.EnqueueRequestsFromMapFunc(r.enqueueRolloutMonitors)
+	workloadPredicate := builder.WithPredicates(datadogmonitor.RolloutChangedPredicate)
 
-	err = builder.Complete(r)
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&datadoghqv1alpha1.DatadogMonitor{}).
+		Watches(&appsv1.Deployment{}, workloadHandler, workloadPredicate).
+		Watches(&appsv1.StatefulSet{}, workloadHandler, workloadPredicate)
+
+	err = r.internal.WatchNamespaces(controllerBuilder).Complete(r)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// enqueueRolloutMonitors enqueues the DatadogMonitors muted during the rollouts of a workload, or muted by its rollout.
+func (r *DatadogMonitorReconciler) enqueueRolloutMonitors(ctx context.Context, obj client.Object) []reconcile.Request {
+	monitorList := &datadoghqv1alpha1.DatadogMonitorList{}
+	if err := r.Client.List(ctx, monitorList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "unable to list DatadogMonitors", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range monitorList.Items {
+		if !datadogmonitor.IsRolloutWorkload(&monitorList.Items[i], obj) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: monitorList.Items[i].Namespace, Name: monitorList.Items[i].Name},
+		})
+	}
+	return requests
+}
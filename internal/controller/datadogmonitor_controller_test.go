@@ -0,0 +1,70 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build integration_v2
+// +build integration_v2
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// These tests run the DatadogMonitor controller against the fake Datadog API.
+var _ = Describe("DatadogMonitor Controller", func() {
+	namespace := "default"
+	ctx := context.Background()
+
+	It("should create, update and delete the monitor in Datadog", func() {
+		monitor := &v1alpha1.DatadogMonitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cpu"},
+			Spec: v1alpha1.DatadogMonitorSpec{
+				Name:    "CPU",
+				Message: "CPU usage is high",
+				Query:   "avg(last_5m):avg:system.cpu.user{*} > 90",
+				Type:    v1alpha1.DatadogMonitorTypeMetric,
+			},
+		}
+		Expect(k8sClient.Create(ctx, monitor)).Should(Succeed())
+
+		key := types.NamespacedName{Namespace: namespace, Name: "cpu"}
+		Eventually(func() int {
+			_ = k8sClient.Get(ctx, key, monitor)
+			return monitor.Status.ID
+		}, timeout, interval).ShouldNot(BeZero())
+		id := int64(monitor.Status.ID)
+		created, found := fakeDatadog.Monitor(id)
+		Expect(found).To(BeTrue())
+		Expect(created.GetName()).To(Equal("CPU"))
+
+		// The controller updates the object too, so the update is retried on conflicts
+		Eventually(func() error {
+			if err := k8sClient.Get(ctx, key, monitor); err != nil {
+				return err
+			}
+			monitor.Spec.Name = "CPU usage"
+			return k8sClient.Update(ctx, monitor)
+		}, timeout, interval).Should(Succeed())
+		Eventually(func() string {
+			m, _ := fakeDatadog.Monitor(id)
+			return m.GetName()
+		}, timeout, interval).Should(Equal("CPU usage"))
+
+		Expect(k8sClient.Delete(ctx, monitor)).Should(Succeed())
+		Eventually(func() bool {
+			return apierrors.IsNotFound(k8sClient.Get(ctx, key, monitor))
+		}, timeout, interval).Should(BeTrue())
+		_, found = fakeDatadog.Monitor(id)
+		Expect(found).To(BeFalse())
+	})
+})
@@ -0,0 +1,320 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitortemplate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
+)
+
+const (
+	defaultErrRequeuePeriod = 5 * time.Second
+	datadogMonitorKind      = "DatadogMonitor"
+
+	// workloadAnnotationKey is set on the DatadogMonitors created from a template to the kind and name of their workload.
+	workloadAnnotationKey = "monitortemplate.datadoghq.com/workload"
+	// specHashAnnotationKey is the hash of the rendered DatadogMonitor spec. DatadogMonitors are only updated when it changes,
+	// so that the changes made by the DatadogMonitor controller, such as the required tags, aren't reverted.
+	specHashAnnotationKey = "monitortemplate.datadoghq.com/spec-hash"
+)
+
+// Reconciler reconciles a DatadogMonitorTemplate object
+type Reconciler struct {
+	client   client.Client
+	scheme   *runtime.Scheme
+	log      logr.Logger
+	recorder record.EventRecorder
+}
+
+// NewReconciler returns a new Reconciler object
+func NewReconciler(client client.Client, scheme *runtime.Scheme, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		client:   client,
+		scheme:   scheme,
+		log:      log,
+		recorder: recorder,
+	}
+}
+
+var _ reconcile.Reconciler = (*Reconciler)(nil)
+
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internalReconcile(ctx, req)
+}
+
+func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("datadogmonitortemplate", req.NamespacedName)
+	logger.Info("Reconciling DatadogMonitorTemplate")
+	now := metav1.NewTime(time.Now())
+
+	// Get instance
+	instance := &v1alpha1.DatadogMonitorTemplate{}
+	if err := r.client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The DatadogMonitors created from the template are garbage collected with their owner
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if !instance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	status := instance.Status.DeepCopy()
+
+	if err := v1alpha1.IsValidDatadogMonitorTemplate(&instance.Spec); err != nil {
+		logger.Error(err, "invalid DatadogMonitorTemplate")
+		updateErrStatus(status, now, "ValidatingTemplate", err)
+		// The template is reconciled again once its spec is fixed
+		return r.updateStatusIfNeeded(logger, instance, status, ctrl.Result{})
+	}
+
+	desired, renderErr := r.desiredMonitors(ctx, instance)
+	if renderErr != nil {
+		logger.Error(renderErr, "error rendering DatadogMonitors")
+	}
+
+	monitors, syncErr := r.syncMonitors(ctx, logger, instance, desired, renderErr == nil)
+	if syncErr != nil {
+		logger.Error(syncErr, "error syncing DatadogMonitors")
+	}
+
+	status.Monitors = monitors
+	status.MonitorCount = int32(len(monitors))
+	if err := utilserrors.NewAggregate([]error{renderErr, syncErr}); err != nil {
+		updateErrStatus(status, now, "SyncingMonitors", err)
+		return r.updateStatusIfNeeded(logger, instance, status, ctrl.Result{RequeueAfter: defaultErrRequeuePeriod})
+	}
+
+	meta.RemoveStatusCondition(&status.Conditions, string(condition.DatadogConditionTypeError))
+	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeActive, metav1.ConditionTrue, "SyncingMonitors", "DatadogMonitors synced")
+
+	return r.updateStatusIfNeeded(logger, instance, status, ctrl.Result{})
+}
+
+// desiredMonitors returns the DatadogMonitors to create for the workloads selected by the template, by name.
+// Workloads whose DatadogMonitor can't be rendered are skipped, and the rendering errors are returned.
+func (r *Reconciler) desiredMonitors(ctx context.Context, instance *v1alpha1.DatadogMonitorTemplate) (map[string]*v1alpha1.DatadogMonitor, error) {
+	selector := labels.Everything()
+	if instance.Spec.Selector.LabelSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(instance.Spec.Selector.LabelSelector); err != nil {
+			return nil, err
+		}
+	}
+
+	var errs []error
+	desired := map[string]*v1alpha1.DatadogMonitor{}
+	for _, kind := range selectedKinds(&instance.Spec) {
+		gvk := workloadGVK(kind)
+		workloads := &metav1.PartialObjectMetadataList{}
+		workloads.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.client.List(ctx, workloads, client.InNamespace(instance.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			errs = append(errs, fmt.Errorf("unable to list %ss: %w", kind, err))
+			continue
+		}
+
+		for i := range workloads.Items {
+			workload := &workloads.Items[i]
+			if !workload.DeletionTimestamp.IsZero() {
+				continue
+			}
+			monitor, err := r.buildMonitor(instance, kind, workload)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("unable to render the DatadogMonitor of %s %s: %w", kind, workload.Name, err))
+				continue
+			}
+			desired[monitor.Name] = monitor
+		}
+	}
+
+	return desired, utilserrors.NewAggregate(errs)
+}
+
+// buildMonitor renders the DatadogMonitor of a workload. The DatadogMonitor is controlled by the template, and also
+// owned by the workload so that it's garbage collected with the workload.
+func (r *Reconciler) buildMonitor(instance *v1alpha1.DatadogMonitorTemplate, kind v1alpha1.DatadogMonitorTemplateWorkloadKind, workload *metav1.PartialObjectMetadata) (*v1alpha1.DatadogMonitor, error) {
+	data := newWorkloadData(kind, workload)
+
+	spec, err := renderMonitorSpec(&instance.Spec.MonitorTemplate.Spec, data)
+	if err != nil {
+		return nil, err
+	}
+	if err = v1alpha1.IsValidDatadogMonitor(spec); err != nil {
+		return nil, err
+	}
+	hash, err := comparison.GenerateMD5ForSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	monitorLabels := make(map[string]string, len(instance.Spec.MonitorTemplate.Labels))
+	for key, value := range instance.Spec.MonitorTemplate.Labels {
+		if monitorLabels[key], err = renderString(value, data); err != nil {
+			return nil, fmt.Errorf("label %s: %w", key, err)
+		}
+	}
+
+	gvk := workloadGVK(kind)
+	monitor := &v1alpha1.DatadogMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      monitorName(instance.Name, kind, workload.Name),
+			Namespace: instance.Namespace,
+			Labels:    monitorLabels,
+			Annotations: map[string]string{
+				workloadAnnotationKey: fmt.Sprintf("%s/%s", kind, workload.Name),
+				specHashAnnotationKey: hash,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: gvk.GroupVersion().String(),
+					Kind:       gvk.Kind,
+					Name:       workload.Name,
+					UID:        workload.UID,
+				},
+			},
+		},
+		Spec: *spec,
+	}
+	if err = controllerutil.SetControllerReference(instance, monitor, r.scheme); err != nil {
+		return nil, err
+	}
+
+	return monitor, nil
+}
+
+// syncMonitors creates and updates the desired DatadogMonitors, and deletes the other DatadogMonitors controlled by
+// the template when deleteStale is true. It returns the sorted names of the DatadogMonitors controlled by the template.
+func (r *Reconciler) syncMonitors(ctx context.Context, logger logr.Logger, instance *v1alpha1.DatadogMonitorTemplate, desired map[string]*v1alpha1.DatadogMonitor, deleteStale bool) ([]string, error) {
+	monitorList := &v1alpha1.DatadogMonitorList{}
+	if err := r.client.List(ctx, monitorList, client.InNamespace(instance.Namespace)); err != nil {
+		return instance.Status.Monitors, fmt.Errorf("unable to list DatadogMonitors: %w", err)
+	}
+
+	var errs []error
+	existing := map[string]*v1alpha1.DatadogMonitor{}
+	var monitors []string
+	for i := range monitorList.Items {
+		monitor := &monitorList.Items[i]
+		existing[monitor.Name] = monitor
+		if !metav1.IsControlledBy(monitor, instance) {
+			continue
+		}
+		if _, found := desired[monitor.Name]; found || !deleteStale {
+			monitors = append(monitors, monitor.Name)
+			continue
+		}
+
+		logger.Info("Deleting DatadogMonitor of a workload no longer selected", "monitor", monitor.Name, "workload", monitor.Annotations[workloadAnnotationKey])
+		if err := r.client.Delete(ctx, monitor); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to delete DatadogMonitor %s: %w", monitor.Name, err))
+			monitors = append(monitors, monitor.Name)
+			continue
+		}
+		r.recordEvent(instance, monitor.Name, datadog.DeletionEvent)
+	}
+
+	for name, monitor := range desired {
+		current, found := existing[name]
+		if !found {
+			logger.Info("Creating DatadogMonitor", "monitor", name, "workload", monitor.Annotations[workloadAnnotationKey])
+			if err := r.client.Create(ctx, monitor); err != nil {
+				errs = append(errs, fmt.Errorf("unable to create DatadogMonitor %s: %w", name, err))
+				continue
+			}
+			r.recordEvent(instance, name, datadog.CreationEvent)
+			monitors = append(monitors, name)
+			continue
+		}
+
+		if !metav1.IsControlledBy(current, instance) {
+			errs = append(errs, fmt.Errorf("DatadogMonitor %s already exists and isn't managed by the template", name))
+			continue
+		}
+		if !needsUpdate(current, monitor) {
+			continue
+		}
+
+		logger.Info("Updating DatadogMonitor", "monitor", name, "workload", monitor.Annotations[workloadAnnotationKey])
+		updated := current.DeepCopy()
+		updated.Labels = monitor.Labels
+		if updated.Annotations == nil {
+			updated.Annotations = map[string]string{}
+		}
+		for key, value := range monitor.Annotations {
+			updated.Annotations[key] = value
+		}
+		updated.OwnerReferences = monitor.OwnerReferences
+		updated.Spec = monitor.Spec
+		if err := r.client.Update(ctx, updated); err != nil {
+			errs = append(errs, fmt.Errorf("unable to update DatadogMonitor %s: %w", name, err))
+			continue
+		}
+		r.recordEvent(instance, name, datadog.UpdateEvent)
+	}
+
+	sort.Strings(monitors)
+	return monitors, utilserrors.NewAggregate(errs)
+}
+
+// needsUpdate returns true if the rendered DatadogMonitor differs from the current one.
+func needsUpdate(current, desired *v1alpha1.DatadogMonitor) bool {
+	if current.Annotations[specHashAnnotationKey] != desired.Annotations[specHashAnnotationKey] {
+		return true
+	}
+	if current.Annotations[workloadAnnotationKey] != desired.Annotations[workloadAnnotationKey] {
+		return true
+	}
+	return !apiequality.Semantic.DeepEqual(current.Labels, desired.Labels)
+}
+
+func updateErrStatus(status *v1alpha1.DatadogMonitorTemplateStatus, now metav1.Time, reason string, err error) {
+	condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, reason, err)
+}
+
+func (r *Reconciler) updateStatusIfNeeded(logger logr.Logger, instance *v1alpha1.DatadogMonitorTemplate, status *v1alpha1.DatadogMonitorTemplateStatus, result ctrl.Result) (ctrl.Result, error) {
+	if !apiequality.Semantic.DeepEqual(&instance.Status, status) {
+		instance.Status = *status
+		if err := r.client.Status().Update(context.TODO(), instance); err != nil {
+			if apierrors.IsConflict(err) {
+				logger.Error(err, "unable to update DatadogMonitorTemplate status due to update conflict")
+				return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, nil
+			}
+			logger.Error(err, "unable to update DatadogMonitorTemplate status")
+			return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, err
+		}
+	}
+	return result, nil
+}
+
+// recordEvent records an event about a DatadogMonitor created from the template.
+func (r *Reconciler) recordEvent(instance *v1alpha1.DatadogMonitorTemplate, monitorName string, eventType datadog.EventType) {
+	info := utils.BuildEventInfo(monitorName, instance.Namespace, datadogMonitorKind, eventType)
+	r.recorder.Event(instance, corev1.EventTypeNormal, info.GetReason(), info.GetMessage())
+}
@@ -0,0 +1,261 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitortemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+const (
+	resourceNamespace = "default"
+	resourceName      = "errors"
+)
+
+// TestReconciler_Reconcile tests the Reconcile method of the Reconciler
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogMonitorTemplate{}, &v1alpha1.DatadogMonitorTemplateList{}, &v1alpha1.DatadogMonitor{}, &v1alpha1.DatadogMonitorList{})
+
+	request := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		objects        []client.Object
+		update         func(t *testing.T, c client.Client)
+		expectedResult ctrl.Result
+		checkMonitors  func(t *testing.T, monitors []v1alpha1.DatadogMonitor)
+		checkStatus    func(t *testing.T, status v1alpha1.DatadogMonitorTemplateStatus)
+	}{
+		{
+			name: "Create a DatadogMonitor per selected workload",
+			objects: []client.Object{
+				defaultTemplate(),
+				testDeployment("checkout", map[string]string{"team": "payments"}),
+				testDeployment("cart", map[string]string{"team": "shop"}),
+				testDeployment("untracked", nil),
+				testStatefulSet("db", map[string]string{"team": "payments"}),
+			},
+			expectedResult: ctrl.Result{},
+			checkMonitors: func(t *testing.T, monitors []v1alpha1.DatadogMonitor) {
+				require.Len(t, monitors, 2)
+				monitor := monitors[1]
+				assert.Equal(t, "errors-deployment-checkout", monitor.Name)
+				assert.Equal(t, "checkout error rate", monitor.Spec.Name)
+				assert.Equal(t, "sum(last_5m):sum:trace.http.request.errors{kube_deployment:checkout}.as_count() > 10", monitor.Spec.Query)
+				assert.Equal(t, []string{"team:payments"}, monitor.Spec.Tags)
+				assert.Equal(t, map[string]string{"team": "payments"}, monitor.Labels)
+				assert.Equal(t, "Deployment/checkout", monitor.Annotations[workloadAnnotationKey])
+				require.Len(t, monitor.OwnerReferences, 2)
+				assert.Equal(t, "Deployment", monitor.OwnerReferences[0].Kind)
+				assert.Equal(t, "checkout", monitor.OwnerReferences[0].Name)
+				assert.Equal(t, "DatadogMonitorTemplate", monitor.OwnerReferences[1].Kind)
+				assert.True(t, *monitor.OwnerReferences[1].Controller)
+			},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogMonitorTemplateStatus) {
+				assert.Equal(t, []string{"errors-deployment-cart", "errors-deployment-checkout"}, status.Monitors)
+				assert.Equal(t, int32(2), status.MonitorCount)
+				assert.True(t, hasCondition(status.Conditions, condition.DatadogConditionTypeActive))
+				assert.False(t, hasCondition(status.Conditions, condition.DatadogConditionTypeError))
+			},
+		},
+		{
+			name: "Delete the DatadogMonitor of a workload no longer selected",
+			objects: []client.Object{
+				defaultTemplate(),
+				testDeployment("checkout", map[string]string{"team": "payments"}),
+				testDeployment("cart", map[string]string{"team": "shop"}),
+			},
+			update: func(t *testing.T, c client.Client) {
+				deployment := &appsv1.Deployment{}
+				require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: resourceNamespace, Name: "cart"}, deployment))
+				deployment.Labels = nil
+				require.NoError(t, c.Update(ctx, deployment))
+			},
+			expectedResult: ctrl.Result{},
+			checkMonitors: func(t *testing.T, monitors []v1alpha1.DatadogMonitor) {
+				require.Len(t, monitors, 1)
+				assert.Equal(t, "errors-deployment-checkout", monitors[0].Name)
+			},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogMonitorTemplateStatus) {
+				assert.Equal(t, []string{"errors-deployment-checkout"}, status.Monitors)
+			},
+		},
+		{
+			name: "Update the DatadogMonitors when the template changes, keeping the tags added by the monitor controller",
+			objects: []client.Object{
+				defaultTemplate(),
+				testDeployment("checkout", map[string]string{"team": "payments"}),
+			},
+			update: func(t *testing.T, c client.Client) {
+				monitor := &v1alpha1.DatadogMonitor{}
+				require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: resourceNamespace, Name: "errors-deployment-checkout"}, monitor))
+				monitor.Spec.Tags = append(monitor.Spec.Tags, "generated:kubernetes")
+				require.NoError(t, c.Update(ctx, monitor))
+
+				template := &v1alpha1.DatadogMonitorTemplate{}
+				require.NoError(t, c.Get(ctx, request.NamespacedName, template))
+				template.Spec.MonitorTemplate.Spec.Message = "Errors are increasing in [[ .Name ]]"
+				require.NoError(t, c.Update(ctx, template))
+			},
+			expectedResult: ctrl.Result{},
+			checkMonitors: func(t *testing.T, monitors []v1alpha1.DatadogMonitor) {
+				require.Len(t, monitors, 1)
+				assert.Equal(t, "Errors are increasing in checkout", monitors[0].Spec.Message)
+				assert.Equal(t, []string{"team:payments"}, monitors[0].Spec.Tags)
+			},
+		},
+		{
+			name: "Keep DatadogMonitors not created from the template",
+			objects: []client.Object{
+				defaultTemplate(),
+				testDeployment("checkout", map[string]string{"team": "payments"}),
+				&v1alpha1.DatadogMonitor{
+					ObjectMeta: metav1.ObjectMeta{Namespace: resourceNamespace, Name: "errors-deployment-checkout"},
+				},
+				&v1alpha1.DatadogMonitor{
+					ObjectMeta: metav1.ObjectMeta{Namespace: resourceNamespace, Name: "other"},
+				},
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkMonitors: func(t *testing.T, monitors []v1alpha1.DatadogMonitor) {
+				require.Len(t, monitors, 2)
+				assert.Empty(t, monitors[0].Spec.Query)
+				assert.Equal(t, "other", monitors[1].Name)
+			},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogMonitorTemplateStatus) {
+				assert.Empty(t, status.Monitors)
+				assert.True(t, hasCondition(status.Conditions, condition.DatadogConditionTypeError))
+			},
+		},
+		{
+			name: "Invalid template",
+			objects: []client.Object{
+				&v1alpha1.DatadogMonitorTemplate{
+					ObjectMeta: metav1.ObjectMeta{Namespace: resourceNamespace, Name: resourceName},
+				},
+				testDeployment("checkout", nil),
+			},
+			expectedResult: ctrl.Result{},
+			checkMonitors: func(t *testing.T, monitors []v1alpha1.DatadogMonitor) {
+				assert.Empty(t, monitors)
+			},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogMonitorTemplateStatus) {
+				assert.True(t, hasCondition(status.Conditions, condition.DatadogConditionTypeError))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(s).
+				WithStatusSubresource(&v1alpha1.DatadogMonitorTemplate{}).
+				WithObjects(tt.objects...).
+				Build()
+			r := NewReconciler(k8sClient, s, zap.New(zap.UseDevMode(true)), record.NewFakeRecorder(10))
+
+			result, err := r.Reconcile(ctx, request)
+			if tt.update != nil {
+				assert.NoError(t, err)
+				tt.update(t, k8sClient)
+				result, err = r.Reconcile(ctx, request)
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+
+			monitors := &v1alpha1.DatadogMonitorList{}
+			require.NoError(t, k8sClient.List(ctx, monitors, client.InNamespace(resourceNamespace)))
+			if tt.checkMonitors != nil {
+				tt.checkMonitors(t, monitors.Items)
+			}
+
+			template := &v1alpha1.DatadogMonitorTemplate{}
+			require.NoError(t, k8sClient.Get(ctx, request.NamespacedName, template))
+			if tt.checkStatus != nil {
+				tt.checkStatus(t, template.Status)
+			}
+		})
+	}
+}
+
+func defaultTemplate() *v1alpha1.DatadogMonitorTemplate {
+	return &v1alpha1.DatadogMonitorTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+		Spec: v1alpha1.DatadogMonitorTemplateSpec{
+			Selector: v1alpha1.DatadogMonitorTemplateSelector{
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpExists}},
+				},
+			},
+			MonitorTemplate: v1alpha1.DatadogMonitorTemplateMonitor{
+				Labels: map[string]string{"team": "[[ .Labels.team ]]"},
+				Spec: v1alpha1.DatadogMonitorSpec{
+					Name:    "[[ .Name ]] error rate",
+					Message: "Errors are increasing",
+					Query:   "sum(last_5m):sum:trace.http.request.errors{kube_deployment:[[ .Name ]]}.as_count() > 10",
+					Type:    v1alpha1.DatadogMonitorTypeQuery,
+					Tags:    []string{"team:[[ .Labels.team ]]"},
+				},
+			},
+		},
+	}
+}
+
+func testDeployment(name string, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      name,
+			Labels:    labels,
+			UID:       types.UID(name + "-uid"),
+		},
+	}
+}
+
+func testStatefulSet(name string, labels map[string]string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      name,
+			Labels:    labels,
+			UID:       types.UID(name + "-uid"),
+		},
+	}
+}
+
+func hasCondition(conditions []metav1.Condition, conditionType condition.Type) bool {
+	for _, c := range conditions {
+		if c.Type == string(conditionType) && c.Status == metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitortemplate
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+const (
+	templateLeftDelim  = "[["
+	templateRightDelim = "]]"
+)
+
+// workloadData is the data available in the templates of a DatadogMonitorTemplate.
+type workloadData struct {
+	Kind        string
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+func newWorkloadData(kind v1alpha1.DatadogMonitorTemplateWorkloadKind, workload *metav1.PartialObjectMetadata) workloadData {
+	return workloadData{
+		Kind:        string(kind),
+		Name:        workload.Name,
+		Namespace:   workload.Namespace,
+		Labels:      workload.Labels,
+		Annotations: workload.Annotations,
+	}
+}
+
+// workloadGVK returns the GroupVersionKind of a workload kind.
+func workloadGVK(kind v1alpha1.DatadogMonitorTemplateWorkloadKind) schema.GroupVersionKind {
+	switch kind {
+	case v1alpha1.DatadogMonitorTemplateWorkloadKindStatefulSet:
+		return appsv1.SchemeGroupVersion.WithKind(string(kind))
+	case v1alpha1.DatadogMonitorTemplateWorkloadKindService:
+		return corev1.SchemeGroupVersion.WithKind(string(kind))
+	default:
+		return appsv1.SchemeGroupVersion.WithKind(string(v1alpha1.DatadogMonitorTemplateWorkloadKindDeployment))
+	}
+}
+
+// selectedKinds returns the workload kinds selected by a DatadogMonitorTemplate.
+func selectedKinds(spec *v1alpha1.DatadogMonitorTemplateSpec) []v1alpha1.DatadogMonitorTemplateWorkloadKind {
+	if len(spec.Selector.Kinds) == 0 {
+		return []v1alpha1.DatadogMonitorTemplateWorkloadKind{v1alpha1.DatadogMonitorTemplateWorkloadKindDeployment}
+	}
+	return spec.Selector.Kinds
+}
+
+// monitorName returns the name of the DatadogMonitor created from a template for a workload.
+// Names too long for a Kubernetes object are truncated and suffixed with a hash to stay unique.
+func monitorName(templateName string, kind v1alpha1.DatadogMonitorTemplateWorkloadKind, workloadName string) string {
+	name := fmt.Sprintf("%s-%s-%s", templateName, strings.ToLower(string(kind)), workloadName)
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+
+	hash := md5.Sum([]byte(name))
+	suffix := hex.EncodeToString(hash[:])[:10]
+	prefix := strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength-len(suffix)-1], "-.")
+
+	return prefix + "-" + suffix
+}
+
+// renderMonitorSpec renders every string field of a templated DatadogMonitorSpec for a workload.
+func renderMonitorSpec(spec *v1alpha1.DatadogMonitorSpec, data workloadData) (*v1alpha1.DatadogMonitorSpec, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var fields interface{}
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	rendered, err := renderValue(fields, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, err = json.Marshal(rendered); err != nil {
+		return nil, err
+	}
+	renderedSpec := &v1alpha1.DatadogMonitorSpec{}
+	if err = json.Unmarshal(raw, renderedSpec); err != nil {
+		return nil, err
+	}
+
+	return renderedSpec, nil
+}
+
+func renderValue(value interface{}, data workloadData) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return renderString(v, data)
+	case []interface{}:
+		for i := range v {
+			rendered, err := renderValue(v[i], data)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = rendered
+		}
+	case map[string]interface{}:
+		for key := range v {
+			rendered, err := renderValue(v[key], data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			v[key] = rendered
+		}
+	}
+
+	return value, nil
+}
+
+func renderString(value string, data workloadData) (string, error) {
+	if !strings.Contains(value, templateLeftDelim) {
+		return value, nil
+	}
+
+	tpl, err := template.New("").Delims(templateLeftDelim, templateRightDelim).Option("missingkey=zero").Parse(value)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
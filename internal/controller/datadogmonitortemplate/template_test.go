@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitortemplate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+)
+
+func Test_monitorName(t *testing.T) {
+	assert.Equal(t, "latency-deployment-foo", monitorName("latency", v1alpha1.DatadogMonitorTemplateWorkloadKindDeployment, "foo"))
+	assert.Equal(t, "latency-statefulset-foo", monitorName("latency", v1alpha1.DatadogMonitorTemplateWorkloadKindStatefulSet, "foo"))
+
+	longName := monitorName("latency", v1alpha1.DatadogMonitorTemplateWorkloadKindService, strings.Repeat("a", 250))
+	assert.Len(t, longName, validation.DNS1123SubdomainMaxLength)
+	assert.Empty(t, validation.IsDNS1123Subdomain(longName))
+	assert.NotEqual(t, longName, monitorName("latency", v1alpha1.DatadogMonitorTemplateWorkloadKindService, strings.Repeat("a", 251)))
+}
+
+func Test_renderMonitorSpec(t *testing.T) {
+	critical := "[[ index .Annotations \"example.com/error-threshold\" ]]"
+	data := workloadData{
+		Kind:        "Deployment",
+		Name:        "checkout",
+		Namespace:   "shop",
+		Labels:      map[string]string{"team": "payments"},
+		Annotations: map[string]string{"example.com/error-threshold": "10"},
+	}
+
+	tests := []struct {
+		name    string
+		spec    v1alpha1.DatadogMonitorSpec
+		want    v1alpha1.DatadogMonitorSpec
+		wantErr bool
+	}{
+		{
+			name: "templated fields",
+			spec: v1alpha1.DatadogMonitorSpec{
+				Name:    "[[ .Kind ]] [[ .Name ]] error rate",
+				Message: "{{#is_alert}}Errors in [[ .Namespace ]]/[[ .Name ]]{{/is_alert}} @team-[[ .Labels.team ]]",
+				Query:   "sum(last_5m):sum:trace.http.request.errors{kube_namespace:[[ .Namespace ]],kube_deployment:[[ .Name ]]}.as_count() > [[ index .Annotations \"example.com/error-threshold\" ]]",
+				Type:    v1alpha1.DatadogMonitorTypeQuery,
+				Tags:    []string{"team:[[ .Labels.team ]]", "owner:[[ .Labels.owner ]]"},
+				Options: v1alpha1.DatadogMonitorOptions{
+					Thresholds: &v1alpha1.DatadogMonitorOptionsThresholds{Critical: &critical},
+				},
+			},
+			want: v1alpha1.DatadogMonitorSpec{
+				Name:    "Deployment checkout error rate",
+				Message: "{{#is_alert}}Errors in shop/checkout{{/is_alert}} @team-payments",
+				Query:   "sum(last_5m):sum:trace.http.request.errors{kube_namespace:shop,kube_deployment:checkout}.as_count() > 10",
+				Type:    v1alpha1.DatadogMonitorTypeQuery,
+				Tags:    []string{"team:payments", "owner:"},
+				Options: v1alpha1.DatadogMonitorOptions{
+					Thresholds: &v1alpha1.DatadogMonitorOptionsThresholds{Critical: apiutils.NewStringPointer("10")},
+				},
+			},
+		},
+		{
+			name:    "invalid template",
+			spec:    v1alpha1.DatadogMonitorSpec{Name: "[[ .Name "},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderMonitorSpec(&tt.spec, data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/datadogmonitortemplate"
+)
+
+// DatadogMonitorTemplateReconciler reconciles a DatadogMonitorTemplate object.
+type DatadogMonitorTemplateReconciler struct {
+	Client   client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	internal *datadogmonitortemplate.Reconciler
+}
+
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitortemplates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitortemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitortemplates/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+
+// Reconcile loop for DatadogMonitorTemplate.
+func (r *DatadogMonitorTemplateReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internal.Reconcile(ctx, req)
+}
+
+// SetupWithManager creates a new DatadogMonitorTemplate controller.
+func (r *DatadogMonitorTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.internal = datadogmonitortemplate.NewReconciler(r.Client, r.Scheme, r.Log, r.Recorder)
+
+	// Only the metadata of the workloads is used, so they are watched as metadata to limit the cache size
+	workloadHandler := handler.EnqueueRequestsFromMapFunc(r.enqueueNamespaceTemplates)
+	workloadPredicates := builder.WithPredicates(predicate.Or(predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{}))
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DatadogMonitorTemplate{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&v1alpha1.DatadogMonitor{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WatchesMetadata(&appsv1.Deployment{}, workloadHandler, workloadPredicates).
+		WatchesMetadata(&appsv1.StatefulSet{}, workloadHandler, workloadPredicates).
+		WatchesMetadata(&corev1.Service{}, workloadHandler, workloadPredicates)
+
+	err := controllerBuilder.Complete(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// enqueueNamespaceTemplates enqueues the DatadogMonitorTemplates in the namespace of a workload.
+func (r *DatadogMonitorTemplateReconciler) enqueueNamespaceTemplates(ctx context.Context, obj client.Object) []reconcile.Request {
+	templateList := &v1alpha1.DatadogMonitorTemplateList{}
+	if err := r.Client.List(ctx, templateList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "unable to list DatadogMonitorTemplates", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(templateList.Items))
+	for _, template := range templateList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: template.Namespace, Name: template.Name},
+		})
+	}
+	return requests
+}
+
+var _ reconcile.Reconciler = (*DatadogMonitorTemplateReconciler)(nil)
@@ -7,306 +7,267 @@ package datadogslo
 
 import (
 	"context"
-	"strings"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
-	apiequality "k8s.io/apimachinery/pkg/api/equality"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/tools/record"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	apiutils "github.com/DataDog/datadog-operator/api/utils"
-	"github.com/DataDog/datadog-operator/internal/controller/finalizer"
+	"github.com/DataDog/datadog-operator/internal/controller/apiresource"
 	"github.com/DataDog/datadog-operator/internal/controller/utils"
-	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
-	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
 	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 )
 
 const (
-	defaultRequeuePeriod    = 60 * time.Second
-	defaultErrRequeuePeriod = 5 * time.Second
-	defaultForceSyncPeriod  = 60 * time.Minute
+	defaultRequeuePeriod    = apiresource.DefaultRequeuePeriod
+	defaultErrRequeuePeriod = apiresource.DefaultErrRequeuePeriod
 	datadogSLOKind          = "DatadogSLO"
 	datadogSLOFinalizer     = "finalizer.slo.datadoghq.com"
 )
 
-type Reconciler struct {
+// Reconciler reconciles DatadogSLOs.
+type Reconciler = apiresource.Reconciler[*v1alpha1.DatadogSLO, datadogV1.ServiceLevelObjective]
+
+func NewReconciler(client client.Client, apiReader client.Reader, ddClient datadogclient.DatadogSLOClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder, deletionPolicy v1alpha1.DeletionPolicy, namespaceTags utils.NamespaceTags) *Reconciler {
+	return apiresource.NewReconciler(client, apiReader, &adapter{client: client, datadogClient: ddClient.Client, datadogAuth: ddClient.Auth}, versionInfo, log, recorder, deletionPolicy, namespaceTags)
+}
+
+// adapter adapts DatadogSLOs to the apiresource.Reconciler.
+type adapter struct {
 	client        client.Client
 	datadogClient *datadogV1.ServiceLevelObjectivesApi
 	datadogAuth   context.Context
-	versionInfo   *version.Info
-	log           logr.Logger
-	recorder      record.EventRecorder
 }
 
-func NewReconciler(client client.Client, ddClient datadogclient.DatadogSLOClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder) *Reconciler {
-	return &Reconciler{
-		client:        client,
-		datadogClient: ddClient.Client,
-		datadogAuth:   ddClient.Auth,
-		versionInfo:   versionInfo,
-		log:           log,
-		recorder:      recorder,
-	}
+var (
+	_ apiresource.Adapter[*v1alpha1.DatadogSLO, datadogV1.ServiceLevelObjective] = (*adapter)(nil)
+	_ apiresource.Orphaner[*v1alpha1.DatadogSLO]                                 = (*adapter)(nil)
+	_ apiresource.Credentialer[*v1alpha1.DatadogSLO]                             = (*adapter)(nil)
+	_ apiresource.Resolver[*v1alpha1.DatadogSLO]                                 = (*adapter)(nil)
+	_ apiresource.Tagger[*v1alpha1.DatadogSLO]                                   = (*adapter)(nil)
+	_ apiresource.Adopter[*v1alpha1.DatadogSLO]                                  = (*adapter)(nil)
+	_ apiresource.Drifter[*v1alpha1.DatadogSLO, datadogV1.ServiceLevelObjective] = (*adapter)(nil)
+	_ apiresource.Refresher[*v1alpha1.DatadogSLO]                                = (*adapter)(nil)
+)
+
+func (a *adapter) Kind() string {
+	return datadogSLOKind
 }
 
-var _ reconcile.Reconciler = (*Reconciler)(nil)
+func (a *adapter) Finalizer() string {
+	return datadogSLOFinalizer
+}
 
-func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-	res, err := r.internalReconcile(ctx, req)
-	return res, err
+func (a *adapter) NewObject() *v1alpha1.DatadogSLO {
+	return &v1alpha1.DatadogSLO{}
 }
 
-func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-	logger := r.log.WithValues("datadogslo", req.NamespacedName)
-	logger.Info("Reconciling Datadog SLO", "version", r.versionInfo.String())
-	now := metav1.NewTime(time.Now())
-
-	// Get instance
-	instance := &v1alpha1.DatadogSLO{}
-	var result ctrl.Result
-	var err error
-	if err = r.client.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: req.Name}, instance); err != nil {
-		if apierrors.IsNotFound(err) {
-			return ctrl.Result{}, nil
-		}
-		return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, err
+func (a *adapter) Status(instance *v1alpha1.DatadogSLO) apiresource.Status {
+	return &apiresource.StatusFields[v1alpha1.DatadogSLOSyncStatus]{
+		Conditions:        &instance.Status.Conditions,
+		ID:                &instance.Status.ID,
+		Creator:           &instance.Status.Creator,
+		Created:           &instance.Status.Created,
+		SyncStatus:        &instance.Status.SyncStatus,
+		LastForceSyncTime: &instance.Status.LastForceSyncTime,
+		CurrentHash:       &instance.Status.CurrentHash,
+		SyncStatusOK:      v1alpha1.DatadogSLOSyncStatusOK,
+		SyncStatusErrors: map[apiresource.Step]v1alpha1.DatadogSLOSyncStatus{
+			apiresource.StepValidate:     v1alpha1.DatadogSLOSyncStatusValidateError,
+			apiresource.StepAuthenticate: v1alpha1.DatadogSLOSyncStatusCredentialsError,
+			apiresource.StepResolve:      v1alpha1.DatadogSLOSyncStatusMonitorRefError,
+			apiresource.StepCreate:       v1alpha1.DatadogSLOSyncStatusCreateError,
+			apiresource.StepGet:          v1alpha1.DatadogSLOSyncStatusGetError,
+			apiresource.StepUpdate:       v1alpha1.DatadogSLOSyncStatusUpdateError,
+		},
 	}
+}
 
-	final := finalizer.NewFinalizer(
-		logger,
-		r.client,
-		r.deleteResource(logger, instance),
-		defaultRequeuePeriod,
-		defaultErrRequeuePeriod,
-	)
-	if result, err = final.HandleFinalizer(ctx, instance, instance.Status.ID, datadogSLOFinalizer); ctrutils.ShouldReturn(result, err) {
-		return result, err
-	}
+func (a *adapter) Validate(instance *v1alpha1.DatadogSLO) error {
+	return v1alpha1.IsValidDatadogSLO(&instance.Spec)
+}
 
-	status := instance.Status.DeepCopy()
-	statusSpecHash := instance.Status.CurrentHash
+// HashedSpec returns the spec and the IDs of the referenced DatadogMonitors, if any.
+func (a *adapter) HashedSpec(instance *v1alpha1.DatadogSLO) interface{} {
+	return struct {
+		*v1alpha1.DatadogSLOSpec
+		ResolvedMonitorIDs []int64 `json:"resolvedMonitorIDs,omitempty"`
+	}{&instance.Spec, instance.Status.MonitorIDs}
+}
 
-	// Validate the SLO spec
-	if err = v1alpha1.IsValidDatadogSLO(&instance.Spec); err != nil {
-		logger.Error(err, "invalid SLO")
-		updateErrStatus(status, now, v1alpha1.DatadogSLOSyncStatusValidateError, "ValidatingSLO", err)
-		return r.updateStatusIfNeeded(logger, instance, status, result)
-	}
+func (a *adapter) DeletionPolicy(instance *v1alpha1.DatadogSLO) v1alpha1.DeletionPolicy {
+	return instance.Spec.DeletionPolicy
+}
 
-	instanceSpecHash, err := comparison.GenerateMD5ForSpec(&instance.Spec)
-	if err != nil {
-		logger.Error(err, "error generating hash")
-		updateErrStatus(status, now, v1alpha1.DatadogSLOSyncStatusUpdateError, "GeneratingSLOSpecHash", err)
-		return r.updateStatusIfNeeded(logger, instance, status, result)
-	}
+func (a *adapter) CredentialsRef(instance *v1alpha1.DatadogSLO) *v1alpha1.DatadogCredentialsReference {
+	return instance.Spec.CredentialsRef
+}
 
-	shouldCreate := false
-	shouldUpdate := false
-
-	if instance.Status.ID == "" {
-		shouldCreate = true
-	} else {
-		if instanceSpecHash != statusSpecHash {
-			shouldUpdate = true
-		} else if instance.Status.LastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(instance.Status.LastForceSyncTime.Time)) <= 0 {
-			// Periodically force a sync with the API SLO to ensure parity
-			// Get SLO to make sure it exists before trying any updates. If it doesn't, set shouldCreate
-			_, err = r.get(instance)
-			if err != nil {
-				logger.Error(err, "error getting SLO", "SLO ID", instance.Status.ID)
-				if strings.Contains(err.Error(), ctrutils.NotFoundString) {
-					shouldCreate = true
-				}
-			} else {
-				shouldUpdate = true
-			}
-			status.LastForceSyncTime = &now
-		}
-	}
+// auth returns the authentication context of the Datadog API requests made for the SLO: the one of its credentials,
+// if any, or else the one of the operator credentials.
+func (a *adapter) auth(ctx context.Context) context.Context {
+	return datadogclient.AuthFromContext(ctx, a.datadogAuth)
+}
 
-	if shouldCreate || shouldUpdate {
-		// Check that required tags are present
-		tagsUpdated, err := r.checkRequiredTags(logger, instance)
-		if err != nil {
-			result.RequeueAfter = defaultErrRequeuePeriod
-			return r.updateStatusIfNeeded(logger, instance, status, result)
-		} else if tagsUpdated {
-			// A reconcile is triggered by the update
-			return r.updateStatusIfNeeded(logger, instance, status, result)
-		}
+// Resolve sets the Datadog IDs of the DatadogMonitors referenced by the SLO in the status of a copy. It returns an
+// error if a DatadogMonitor doesn't exist or isn't created in Datadog yet.
+func (a *adapter) Resolve(ctx context.Context, instance *v1alpha1.DatadogSLO) (*v1alpha1.DatadogSLO, error) {
+	resolved := instance.DeepCopy()
+	resolved.Status.MonitorIDs = nil
+	if len(instance.Spec.MonitorRefs) == 0 {
+		return resolved, nil
+	}
 
-		if shouldCreate {
-			err = r.create(logger, instance, status, now, instanceSpecHash)
-		} else if shouldUpdate {
-			err = r.update(logger, instance, status, now, instanceSpecHash)
+	monitorIDs := make([]int64, 0, len(instance.Spec.MonitorRefs))
+	for _, ref := range instance.Spec.MonitorRefs {
+		key := monitorRefKey(instance, ref)
+		monitor := &v1alpha1.DatadogMonitor{}
+		if err := a.client.Get(ctx, key, monitor); err != nil {
+			return nil, fmt.Errorf("unable to get DatadogMonitor %s: %w", key, err)
 		}
-
-		if err != nil {
-			result.RequeueAfter = defaultErrRequeuePeriod
+		if monitor.Status.ID == 0 {
+			return nil, fmt.Errorf("DatadogMonitor %s isn't created in Datadog yet", key)
 		}
+		monitorIDs = append(monitorIDs, int64(monitor.Status.ID))
 	}
+	resolved.Status.MonitorIDs = monitorIDs
+	return resolved, nil
+}
 
-	// If reconcile was successful and uneventful, requeue with period defaultRequeuePeriod
-	if !result.Requeue && result.RequeueAfter == 0 {
-		result.RequeueAfter = defaultRequeuePeriod
-	}
-
-	return r.updateStatusIfNeeded(logger, instance, status, result)
+func (a *adapter) SetResolved(instance, desired *v1alpha1.DatadogSLO) {
+	instance.Status.MonitorIDs = desired.Status.MonitorIDs
 }
 
-func (r *Reconciler) checkRequiredTags(logger logr.Logger, instance *v1alpha1.DatadogSLO) (bool, error) {
+func (a *adapter) Tags(instance *v1alpha1.DatadogSLO) *[]string {
 	if instance.Spec.ControllerOptions != nil && apiutils.BoolValue(instance.Spec.ControllerOptions.DisableRequiredTags) {
-		return false, nil
+		return nil
 	}
+	return &instance.Spec.Tags
+}
 
-	tags := instance.Spec.Tags
-	tagsToAdd := utils.GetTagsToAdd(instance.Spec.Tags)
-
-	if len(tagsToAdd) > 0 {
-		tags = append(tags, tagsToAdd...)
-		instance.Spec.Tags = tags
-		err := r.client.Update(context.TODO(), instance)
-		if err != nil {
-			logger.Error(err, "failed to update DatadogSLO with required tags")
-
-			return false, err
-		}
-		logger.Info("Added required tags", "SLO ID", instance.Status.ID)
-		return true, nil
+// AdoptID returns the ID of the SLO the DatadogSLO should adopt, if it has the adoption annotation and hasn't adopted
+// it yet. Once adopted, an SLO deleted in Datadog is recreated like any other.
+func (a *adapter) AdoptID(instance *v1alpha1.DatadogSLO) string {
+	adoptID := instance.Annotations[v1alpha1.DatadogSLOAdoptIDAnnotationKey]
+	if adoptID == instance.Status.ID {
+		return ""
 	}
 
-	return false, nil
+	return adoptID
 }
 
-// TODO implement when /search endpoint has been updated
-// func updateSLOState(logger logr.Logger, instance *v1alpha1.DatadogSLO, sloHistory *datadogV1.SLOHistoryResponseData, status *v1alpha1.DatadogSLOStatus, now metav1.Time, err error) {
-// 	if err != nil {
-// 		status.SLIValue = nil
-// 		status.ErrorBudgetRemaining = nil
-// 		return
-// 	}
-// 	rawSLIVal := sloHistory.Overall.SliValue.Get()
-// 	if rawSLIVal == nil && len(sloHistory.Overall.Errors) > 0 {
-// 		logger.Info("Problem with Datadog SLO", "error message", sloHistory.Overall.Errors[0].ErrorMessage, "SLO ID", instance.Status.ID)
-// 		status.Message = fmt.Sprintf("SLO Error: %s", sloHistory.Overall.Errors[0].ErrorMessage)
-// 		return
-// 	}
-// 	sliVal := fmt.Sprintf("%.2f", *rawSLIVal)
-// 	status.SLIValue = &sliVal
-// 	// There should be only one element in map
-// 	for _, v := range sloHistory.Overall.ErrorBudgetRemaining {
-// 		ebr := fmt.Sprintf("%.2f", v)
-// 		status.ErrorBudgetRemaining = &ebr
-// 	}
-// }
-
-func updateErrStatus(status *v1alpha1.DatadogSLOStatus, now metav1.Time, syncStatus v1alpha1.DatadogSLOSyncStatus, reason string, err error) {
-	condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, reason, err)
-	status.SyncStatus = syncStatus
+func (a *adapter) DriftPolicy(instance *v1alpha1.DatadogSLO) v1alpha1.DriftPolicy {
+	if instance.Spec.ControllerOptions == nil {
+		return ""
+	}
+	return instance.Spec.ControllerOptions.DriftPolicy
 }
 
-func (r *Reconciler) updateStatusIfNeeded(logger logr.Logger, instance *v1alpha1.DatadogSLO, status *v1alpha1.DatadogSLOStatus, result ctrl.Result) (ctrl.Result, error) {
-	if !apiequality.Semantic.DeepEqual(&instance.Status, status) {
-		instance.Status = *status
-		if err := r.client.Status().Update(context.TODO(), instance); err != nil {
-			if apierrors.IsConflict(err) {
-				logger.Error(err, "unable to update DatadogSLO status due to update conflict")
-				return ctrl.Result{Requeue: true, RequeueAfter: defaultErrRequeuePeriod}, nil
-			}
-			logger.Error(err, "unable to update DatadogSLO status")
-			return ctrl.Result{Requeue: true, RequeueAfter: defaultRequeuePeriod}, err
-		}
-	}
-	return result, nil
+func (a *adapter) Drift(instance *v1alpha1.DatadogSLO, slo datadogV1.ServiceLevelObjective) ([]string, error) {
+	return sloDrift(instance, slo, instance.Status.MonitorIDs)
 }
 
-func (r *Reconciler) create(logger logr.Logger, instance *v1alpha1.DatadogSLO, status *v1alpha1.DatadogSLOStatus, now metav1.Time, hash string) error {
-	logger.V(1).Info("SLO ID is not set; creating SLO in Datadog")
+func (a *adapter) Create(ctx context.Context, instance *v1alpha1.DatadogSLO) (datadogV1.ServiceLevelObjective, error) {
+	return createSLO(a.auth(ctx), a.datadogClient, instance, instance.Status.MonitorIDs)
+}
 
-	// Create SLO in Datadog
-	createdSLO, err := createSLO(r.datadogAuth, r.datadogClient, instance)
+func (a *adapter) Get(ctx context.Context, id string) (datadogV1.ServiceLevelObjective, error) {
+	slo, err := getSLO(a.auth(ctx), a.datadogClient, id)
 	if err != nil {
-		logger.Error(err, "error creating SLO")
-		updateErrStatus(status, now, v1alpha1.DatadogSLOSyncStatusCreateError, "CreatingSLO", err)
-		return err
+		return datadogV1.ServiceLevelObjective{}, err
 	}
+	return sloFromResponse(slo), nil
+}
 
-	// Set condition and status
-	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeCreated, metav1.ConditionTrue, "CreatingSLO", "DatadogSLO Created")
-	creator := createdSLO.GetCreator()
-	createdTime := metav1.Unix(createdSLO.GetCreatedAt(), 0)
-
-	status.SyncStatus = v1alpha1.DatadogSLOSyncStatusOK
-	status.ID = createdSLO.GetId()
-	status.Creator = creator.GetEmail()
-	status.Created = &createdTime
-	status.LastForceSyncTime = &createdTime
-	status.CurrentHash = hash
-
-	logger.Info("Created a new DatadogSLO", "SLO ID", status.ID)
-	r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.CreationEvent))
+func (a *adapter) Update(ctx context.Context, instance *v1alpha1.DatadogSLO) (datadogV1.ServiceLevelObjective, error) {
+	updatedSLO, err := updateSLO(a.auth(ctx), a.datadogClient, instance, instance.Status.MonitorIDs)
+	if err != nil || len(updatedSLO.Data) == 0 {
+		return datadogV1.ServiceLevelObjective{}, err
+	}
+	return updatedSLO.Data[0], nil
+}
 
-	return nil
+func (a *adapter) Delete(ctx context.Context, instance *v1alpha1.DatadogSLO) error {
+	return deleteSLO(a.auth(ctx), a.datadogClient, instance.Status.ID)
 }
 
-func (r *Reconciler) get(instance *v1alpha1.DatadogSLO) (*datadogV1.SLOResponseData, error) {
-	return getSLO(r.datadogAuth, r.datadogClient, instance.Status.ID)
+func (a *adapter) Orphan(ctx context.Context, instance *v1alpha1.DatadogSLO) error {
+	return orphanSLO(a.auth(ctx), a.datadogClient, instance.Status.ID)
 }
 
-func (r *Reconciler) update(logger logr.Logger, instance *v1alpha1.DatadogSLO, status *v1alpha1.DatadogSLOStatus, now metav1.Time, hash string) error {
-	if _, err := updateSLO(r.datadogAuth, r.datadogClient, instance); err != nil {
-		logger.Error(err, "error updating SLO", "SLO ID", instance.Status.ID)
-		updateErrStatus(status, now, v1alpha1.DatadogSLOSyncStatusUpdateError, "UpdatingSLO", err)
-		return err
+func (a *adapter) Info(slo datadogV1.ServiceLevelObjective) apiresource.Info {
+	creator := slo.GetCreator()
+	info := apiresource.Info{ID: slo.GetId(), Creator: creator.GetEmail()}
+	if slo.CreatedAt != nil {
+		info.Created = time.Unix(slo.GetCreatedAt(), 0)
+	}
+	if slo.ModifiedAt != nil {
+		info.Modified = time.Unix(slo.GetModifiedAt(), 0)
 	}
-	r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.UpdateEvent))
+	return info
+}
 
-	// Set condition and status
-	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeUpdated, metav1.ConditionTrue, "UpdatingSLO", "DatadogSLO Updated")
-	status.SyncStatus = v1alpha1.DatadogSLOSyncStatusOK
-	status.CurrentHash = hash
-	status.LastForceSyncTime = &now
+// Refresh sets the SLI value, the remaining error budget and the state of the SLO over its timeframe in the status,
+// from the SLO history, at most once per requeue period. The previous values are kept if the history can't be fetched.
+func (a *adapter) Refresh(ctx context.Context, logger logr.Logger, instance *v1alpha1.DatadogSLO, now metav1.Time) {
+	status := &instance.Status
+	if status.StateLastUpdateTime != nil && now.Sub(status.StateLastUpdateTime.Time) < defaultRequeuePeriod {
+		return
+	}
 
-	logger.Info("Updated DatadogSLO", "SLO ID", instance.Status.ID)
-	return nil
-}
+	sloHistory, err := getSLOHistory(a.auth(ctx), a.datadogClient, instance, status.ID, now.Time)
+	if err != nil {
+		logger.Error(err, "error getting SLO history", "SLO ID", status.ID)
+		return
+	}
+	status.StateLastUpdateTime = &now
 
-func (r *Reconciler) deleteResource(logger logr.Logger, instance *v1alpha1.DatadogSLO) finalizer.ResourceDeleteFunc {
-	return func(ctx context.Context, k8sObj client.Object, datadogID string) error {
-		if datadogID != "" {
-			kind := k8sObj.GetObjectKind().GroupVersionKind().Kind
-			if err := deleteSLO(r.datadogAuth, r.datadogClient, datadogID); err != nil {
-				logger.Error(err, "error deleting SLO", "kind", kind, "ID", datadogID)
-				return err
-			}
-			logger.Info("Successfully deleted object", "kind", kind, "ID", datadogID)
+	rawSLIVal := sloHistory.Overall.SliValue.Get()
+	if rawSLIVal == nil {
+		if len(sloHistory.Overall.Errors) > 0 {
+			logger.Info("Problem with Datadog SLO", "error message", sloHistory.Overall.Errors[0].ErrorMessage, "SLO ID", status.ID)
 		}
-		r.recordEvent(instance, buildEventInfo(k8sObj.GetName(), k8sObj.GetNamespace(), datadog.DeletionEvent))
-		return nil
+		status.SLIValue = nil
+		status.ErrorBudgetRemaining = nil
+		status.State = v1alpha1.DatadogSLOStateNoData
+		return
+	}
+
+	timeframe := string(instance.Spec.Timeframe)
+	sliVal := fmt.Sprintf("%.2f", *rawSLIVal)
+	status.SLIValue = &sliVal
+	status.ErrorBudgetRemaining = nil
+	if ebr, found := sloHistory.Overall.ErrorBudgetRemaining[timeframe]; found {
+		ebrVal := fmt.Sprintf("%.2f", ebr)
+		status.ErrorBudgetRemaining = &ebrVal
 	}
+	threshold := buildThreshold(instance.Spec)[0]
+	status.State = sloState(*rawSLIVal, threshold.Target, threshold.Warning)
 }
 
-// buildEventInfo creates a new EventInfo instance.
-func buildEventInfo(name, ns string, eventType datadog.EventType) utils.EventInfo {
-	return utils.BuildEventInfo(name, ns, datadogSLOKind, eventType)
+// monitorRefKey returns the namespaced name of a DatadogMonitor referenced by a DatadogSLO.
+func monitorRefKey(instance *v1alpha1.DatadogSLO, ref v1alpha1.DatadogSLOMonitorReference) types.NamespacedName {
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = instance.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}
 }
 
-// recordEvent wraps the manager event recorder.
-func (r *Reconciler) recordEvent(slo runtime.Object, info utils.EventInfo) {
-	r.recorder.Event(slo, corev1.EventTypeNormal, info.GetReason(), info.GetMessage())
+// ReferencesMonitor returns true if the DatadogSLO references the DatadogMonitor in its MonitorRefs.
+func ReferencesMonitor(instance *v1alpha1.DatadogSLO, monitor types.NamespacedName) bool {
+	for _, ref := range instance.Spec.MonitorRefs {
+		if monitorRefKey(instance, ref) == monitor {
+			return true
+		}
+	}
+	return false
 }
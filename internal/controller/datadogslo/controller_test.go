@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,8 +31,12 @@ import (
 	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
 )
 
 const (
@@ -44,7 +49,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 	ctx := context.Background()
 	testLogger := zap.New(zap.UseDevMode(true))
 	s := scheme.Scheme
-	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogSLO{})
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogSLO{}, &v1alpha1.DatadogMonitor{})
 
 	type mockedFields struct {
 		k8sClient client.Client
@@ -55,6 +60,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 		expectedResult       ctrl.Result
 		mockOn               func(t *testing.T, m *mockedFields)
 		datadogClientHandler http.HandlerFunc
+		checkStatus          func(t *testing.T, status v1alpha1.DatadogSLOStatus)
 	}{
 		{
 			name: "Create SLO when not exists",
@@ -120,6 +126,239 @@ func TestReconciler_Reconcile(t *testing.T) {
 			}),
 			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
 		},
+		{
+			name: "Adopt existing SLO instead of creating it",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				slo := defaultSLO()
+				slo.Annotations = map[string]string{v1alpha1.DatadogSLOAdoptIDAnnotationKey: "SLO123"}
+				_ = m.k8sClient.Create(context.TODO(), slo)
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodPost:
+					http.Error(w, "SLO should be adopted", http.StatusBadRequest)
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(datadogV1.SLOResponse{Data: &datadogV1.SLOResponseData{Id: ptrString("SLO123")}})
+				default:
+					_ = json.NewEncoder(w).Encode(defaultDatadogSLOResponse())
+				}
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Equal(t, "SLO123", status.ID)
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusOK, status.SyncStatus)
+			},
+		},
+		{
+			name: "Return Error and Requeue result when the SLO to adopt doesn't exist",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				slo := defaultSLO()
+				slo.Annotations = map[string]string{v1alpha1.DatadogSLOAdoptIDAnnotationKey: "SLO123"}
+				_ = m.k8sClient.Create(context.TODO(), slo)
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "not found", http.StatusNotFound)
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusGetError, status.SyncStatus)
+			},
+		},
+		{
+			name: "Revert SLO changed in Datadog",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedSLO(v1alpha1.DriftPolicyEnforce))
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(datadogV1.SLOResponse{Data: driftedDatadogSLO()})
+				default:
+					_ = json.NewEncoder(w).Encode(defaultDatadogSLOResponse())
+				}
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusOK, status.SyncStatus)
+				drifted := meta.FindStatusCondition(status.Conditions, string(condition.DatadogConditionTypeDrifted))
+				if assert.NotNil(t, drifted) {
+					assert.Equal(t, metav1.ConditionFalse, drifted.Status)
+					assert.Equal(t, "Reverted the SLO changes made outside of Kubernetes: name, targetThreshold", drifted.Message)
+				}
+			},
+		},
+		{
+			name: "Report SLO changed in Datadog with the observe drift policy",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedSLO(v1alpha1.DriftPolicyObserve))
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(datadogV1.SLOResponse{Data: driftedDatadogSLO()})
+				default:
+					http.Error(w, "SLO should not be updated", http.StatusBadRequest)
+				}
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusOK, status.SyncStatus)
+				drifted := meta.FindStatusCondition(status.Conditions, string(condition.DatadogConditionTypeDrifted))
+				if assert.NotNil(t, drifted) {
+					assert.Equal(t, metav1.ConditionTrue, drifted.Status)
+					assert.Equal(t, "SLO changed outside of Kubernetes: name, targetThreshold", drifted.Message)
+				}
+			},
+		},
+		{
+			name: "Report the SLI value, error budget and state of the SLO",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedSLO(v1alpha1.DriftPolicyEnforce))
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.URL.Path == "/api/v1/slo/SLO123/history":
+					assert.Equal(t, "99", r.URL.Query().Get("target"))
+					_ = json.NewEncoder(w).Encode(defaultDatadogSLOHistoryResponse())
+				case r.Method == http.MethodGet:
+					slo := driftedDatadogSLO()
+					slo.SetModifiedAt(time.Now().Add(-time.Hour).Unix())
+					_ = json.NewEncoder(w).Encode(datadogV1.SLOResponse{Data: slo})
+				default:
+					http.Error(w, "SLO should not be updated", http.StatusBadRequest)
+				}
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Equal(t, apiutils.NewStringPointer("98.50"), status.SLIValue)
+				assert.Equal(t, apiutils.NewStringPointer("-50.00"), status.ErrorBudgetRemaining)
+				assert.Equal(t, v1alpha1.DatadogSLOStateBreached, status.State)
+				assert.NotNil(t, status.StateLastUpdateTime)
+			},
+		},
+		{
+			name: "Report the SLI value of a fully synced SLO",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				slo := syncedSLO(v1alpha1.DriftPolicyEnforce)
+				slo.Status.SyncStatus = v1alpha1.DatadogSLOSyncStatusOK
+				_ = m.k8sClient.Create(context.TODO(), slo)
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.URL.Path == "/api/v1/slo/SLO123/history":
+					_ = json.NewEncoder(w).Encode(defaultDatadogSLOHistoryResponse())
+				case r.Method == http.MethodGet:
+					slo := driftedDatadogSLO()
+					slo.SetModifiedAt(time.Now().Add(-time.Hour).Unix())
+					_ = json.NewEncoder(w).Encode(datadogV1.SLOResponse{Data: slo})
+				default:
+					http.Error(w, "SLO should not be updated", http.StatusBadRequest)
+				}
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Equal(t, apiutils.NewStringPointer("98.50"), status.SLIValue)
+				assert.Equal(t, v1alpha1.DatadogSLOStateBreached, status.State)
+				assert.NotNil(t, status.StateLastUpdateTime)
+			},
+		},
+		{
+			name: "Requeue when a referenced DatadogMonitor isn't created in Datadog yet",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), monitorSLO())
+				_ = m.k8sClient.Create(context.TODO(), &v1alpha1.DatadogMonitor{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "monitors", Name: "checkout-errors"},
+				})
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "SLO should not be created", http.StatusBadRequest)
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogSLOSyncStatusMonitorRefError, status.SyncStatus)
+			},
+		},
+		{
+			name: "Create SLO with the IDs of the referenced DatadogMonitors",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), monitorSLO())
+				monitor := &v1alpha1.DatadogMonitor{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "monitors", Name: "checkout-errors"},
+				}
+				_ = m.k8sClient.Create(context.TODO(), monitor)
+				monitor.Status.ID = 5678
+				_ = m.k8sClient.Status().Update(context.TODO(), monitor)
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodPost {
+					sloReq := datadogV1.ServiceLevelObjectiveRequest{}
+					_ = json.NewDecoder(r.Body).Decode(&sloReq)
+					assert.Equal(t, []int64{1234, 5678}, sloReq.MonitorIds)
+				}
+				_ = json.NewEncoder(w).Encode(defaultDatadogSLOResponse())
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOStatus) {
+				assert.Equal(t, "SLO123", status.ID)
+				assert.Equal(t, []int64{5678}, status.MonitorIDs)
+			},
+		},
 	}
 
 	// Iterate through test cases
@@ -135,23 +374,23 @@ func TestReconciler_Reconcile(t *testing.T) {
 			testAuth := setupTestAuth(httpServer.URL)
 
 			m := mockedFields{
-				k8sClient: fake.NewClientBuilder().WithStatusSubresource(&v1alpha1.DatadogSLO{}).Build(),
+				k8sClient: fake.NewClientBuilder().WithStatusSubresource(&v1alpha1.DatadogSLO{}, &v1alpha1.DatadogMonitor{}).Build(),
 			}
 			if tt.mockOn != nil {
 				tt.mockOn(t, &m)
 			}
 			recorder := record.NewFakeRecorder(5)
-			r := &Reconciler{
-				client:        m.k8sClient,
-				datadogClient: client,
-				datadogAuth:   testAuth,
-				recorder:      recorder,
-				log:           testLogger,
-				versionInfo:   &version.Info{},
-			}
+			ddClient := datadogclient.DatadogSLOClient{Client: client, Auth: testAuth}
+			r := NewReconciler(m.k8sClient, m.k8sClient, ddClient, &version.Info{}, testLogger, recorder, "", utils.NamespaceTags{})
 
 			res, _ := r.Reconcile(ctx, tt.request)
 			assert.Equal(t, tt.expectedResult, res)
+
+			if tt.checkStatus != nil {
+				slo := &v1alpha1.DatadogSLO{}
+				assert.NoError(t, m.k8sClient.Get(ctx, tt.request.NamespacedName, slo))
+				tt.checkStatus(t, slo.Status)
+			}
 		})
 	}
 }
@@ -180,6 +419,62 @@ func defaultSLO() *v1alpha1.DatadogSLO {
 	}
 }
 
+// monitorSLO returns a monitor DatadogSLO referencing a DatadogMonitor in another namespace.
+func monitorSLO() *v1alpha1.DatadogSLO {
+	slo := defaultSLO()
+	slo.Spec.Query = nil
+	slo.Spec.Type = v1alpha1.DatadogSLOTypeMonitor
+	slo.Spec.MonitorIDs = []int64{1234}
+	slo.Spec.MonitorRefs = []v1alpha1.DatadogSLOMonitorReference{{Namespace: "monitors", Name: "checkout-errors"}}
+
+	return slo
+}
+
+// syncedSLO returns a DatadogSLO synced with Datadog 10 minutes ago.
+func syncedSLO(driftPolicy v1alpha1.DriftPolicy) *v1alpha1.DatadogSLO {
+	slo := defaultSLO()
+	slo.Finalizers = []string{datadogSLOFinalizer}
+	slo.Spec.ControllerOptions = &v1alpha1.DatadogSLOControllerOptions{DriftPolicy: driftPolicy}
+	hash, _ := comparison.GenerateMD5ForSpec(&slo.Spec)
+	lastSync := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	slo.Status = v1alpha1.DatadogSLOStatus{
+		ID:                "SLO123",
+		CurrentHash:       hash,
+		LastForceSyncTime: &lastSync,
+	}
+
+	return slo
+}
+
+// driftedDatadogSLO returns the SLO of syncedSLO, modified in Datadog since it was synced.
+func driftedDatadogSLO() *datadogV1.SLOResponseData {
+	modified := time.Now().Unix()
+	sloType := datadogV1.SLOTYPE_METRIC
+	return &datadogV1.SLOResponseData{
+		Id:         ptrString("SLO123"),
+		ModifiedAt: &modified,
+		Name:       ptrString("Renamed in the UI"),
+		Query: &datadogV1.ServiceLevelObjectiveQuery{
+			Numerator:   "sum:my.custom.count.metric{type:good_events}.as_count()",
+			Denominator: "sum:my.custom.count.metric{*}.as_count()",
+		},
+		Tags:       utils.GetRequiredTags(),
+		Thresholds: []datadogV1.SLOThreshold{{Timeframe: datadogV1.SLOTIMEFRAME_THIRTY_DAYS, Target: 95}},
+		Type:       &sloType,
+	}
+}
+
+func defaultDatadogSLOHistoryResponse() datadogV1.SLOHistoryResponse {
+	return datadogV1.SLOHistoryResponse{
+		Data: &datadogV1.SLOHistoryResponseData{
+			Overall: &datadogV1.SLOHistorySLIData{
+				SliValue:             *datadogapi.NewNullableFloat64(datadogapi.PtrFloat64(98.5)),
+				ErrorBudgetRemaining: map[string]float64{"30d": -50},
+			},
+		},
+	}
+}
+
 func defaultDatadogSLOResponse() datadogV1.SLOListResponse {
 	unix := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC).Unix()
 	return datadogV1.SLOListResponse{
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslo
+
+import (
+	"sort"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+)
+
+// sloDrift returns the JSON paths of the DatadogSLO spec fields that differ in the SLO in Datadog. monitorIDs are the
+// resolved IDs of the DatadogSLO MonitorRefs.
+func sloDrift(instance *v1alpha1.DatadogSLO, slo datadogV1.ServiceLevelObjective, monitorIDs []int64) ([]string, error) {
+	// Build the desired SLO as it is sent to the API, so that both sides are formatted the same way
+	_, desiredSLO := buildSLO(instance, monitorIDs)
+	desired, err := ExportSLOSpec(*desiredSLO)
+	if err != nil {
+		return nil, err
+	}
+	actual, err := ExportSLOSpec(slo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tags := range []*[]string{&desired.Tags, &actual.Tags, &desired.Groups, &actual.Groups} {
+		*tags = append([]string(nil), *tags...)
+		sort.Strings(*tags)
+	}
+
+	return comparison.SpecDrift(desired, actual), nil
+}
+
+// sloFromResponse converts the SLO returned by the get endpoint into the type returned by the other endpoints.
+func sloFromResponse(data *datadogV1.SLOResponseData) datadogV1.ServiceLevelObjective {
+	slo := datadogV1.NewServiceLevelObjective(data.GetName(), data.GetThresholds(), data.GetType())
+	slo.Id = data.Id
+	slo.CreatedAt = data.CreatedAt
+	slo.Creator = data.Creator
+	slo.ModifiedAt = data.ModifiedAt
+	slo.Description = data.Description
+	slo.Groups = data.Groups
+	slo.MonitorIds = data.MonitorIds
+	slo.Query = data.Query
+	slo.SliSpecification = data.SliSpecification
+	slo.Tags = data.Tags
+	slo.Timeframe = data.Timeframe
+
+	return *slo
+}
@@ -0,0 +1,130 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslo
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// ExportSLOSpec returns the DatadogSLOSpec of an existing Datadog SLO, reversing the mapping of buildSLO.
+// It returns an error if the SLO can't be represented by a DatadogSLO.
+func ExportSLOSpec(slo datadogV1.ServiceLevelObjective) (v1alpha1.DatadogSLOSpec, error) {
+	spec := v1alpha1.DatadogSLOSpec{
+		Name: slo.GetName(),
+		Tags: slo.GetTags(),
+		Type: v1alpha1.DatadogSLOType(slo.GetType()),
+	}
+	if !spec.Type.IsValid() {
+		return spec, fmt.Errorf("SLO type %s is not supported", spec.Type)
+	}
+
+	spec.Description, _ = slo.GetDescriptionOk()
+	switch spec.Type {
+	case v1alpha1.DatadogSLOTypeMetric:
+		query := slo.GetQuery()
+		spec.Query = &v1alpha1.DatadogSLOQuery{
+			Numerator:   query.GetNumerator(),
+			Denominator: query.GetDenominator(),
+		}
+	case v1alpha1.DatadogSLOTypeMonitor:
+		spec.MonitorIDs = slo.GetMonitorIds()
+		spec.Groups = slo.GetGroups()
+	case v1alpha1.DatadogSLOTypeTimeSlice:
+		timeSlice, err := exportTimeSlice(slo.GetSliSpecification())
+		if err != nil {
+			return spec, err
+		}
+		spec.TimeSlice = timeSlice
+	}
+
+	threshold, err := primaryThreshold(slo)
+	if err != nil {
+		return spec, err
+	}
+	spec.Timeframe = v1alpha1.DatadogSLOTimeFrame(threshold.GetTimeframe())
+	if spec.TargetThreshold, err = exportQuantity(threshold.GetTarget()); err != nil {
+		return spec, err
+	}
+	if warning, ok := threshold.GetWarningOk(); ok {
+		warningThreshold, err := exportQuantity(*warning)
+		if err != nil {
+			return spec, err
+		}
+		spec.WarningThreshold = &warningThreshold
+	}
+
+	return spec, nil
+}
+
+// primaryThreshold returns the threshold of the primary timeframe of an SLO, the only one a DatadogSLO supports.
+func primaryThreshold(slo datadogV1.ServiceLevelObjective) (datadogV1.SLOThreshold, error) {
+	thresholds := slo.GetThresholds()
+	if len(thresholds) == 0 {
+		return datadogV1.SLOThreshold{}, fmt.Errorf("SLO %s has no threshold", slo.GetId())
+	}
+
+	threshold := thresholds[0]
+	if timeframe, ok := slo.GetTimeframeOk(); ok {
+		for _, t := range thresholds {
+			if t.GetTimeframe() == *timeframe {
+				threshold = t
+				break
+			}
+		}
+	}
+
+	switch v1alpha1.DatadogSLOTimeFrame(threshold.GetTimeframe()) {
+	case v1alpha1.DatadogSLOTimeFrame7d, v1alpha1.DatadogSLOTimeFrame30d, v1alpha1.DatadogSLOTimeFrame90d:
+		return threshold, nil
+	default:
+		return threshold, fmt.Errorf("SLO timeframe %s is not supported", threshold.GetTimeframe())
+	}
+}
+
+// exportTimeSlice returns the DatadogSLOTimeSlice of the SLI specification of a time-slice SLO.
+func exportTimeSlice(sliSpec datadogV1.SLOSliSpec) (*v1alpha1.DatadogSLOTimeSlice, error) {
+	if sliSpec.SLOTimeSliceSpec == nil {
+		return nil, fmt.Errorf("SLO SLI specification is not supported")
+	}
+
+	condition := sliSpec.SLOTimeSliceSpec.TimeSlice
+	timeSlice := &v1alpha1.DatadogSLOTimeSlice{
+		Comparator: v1alpha1.DatadogSLOTimeSliceComparator(condition.GetComparator()),
+	}
+	for _, query := range condition.Query.GetQueries() {
+		if query.FormulaAndFunctionMetricQueryDefinition == nil {
+			return nil, fmt.Errorf("SLO time slice query is not supported")
+		}
+		timeSlice.Queries = append(timeSlice.Queries, v1alpha1.DatadogSLOTimeSliceQuery{
+			Name:  query.FormulaAndFunctionMetricQueryDefinition.GetName(),
+			Query: query.FormulaAndFunctionMetricQueryDefinition.GetQuery(),
+		})
+	}
+	if formulas := condition.Query.GetFormulas(); len(formulas) > 0 {
+		timeSlice.Formula = formulas[0].GetFormula()
+	}
+	if interval, ok := condition.GetQueryIntervalSecondsOk(); ok {
+		seconds := int32(*interval)
+		timeSlice.QueryIntervalSeconds = &seconds
+	}
+
+	var err error
+	if timeSlice.Threshold, err = exportQuantity(condition.GetThreshold()); err != nil {
+		return nil, err
+	}
+
+	return timeSlice, nil
+}
+
+func exportQuantity(value float64) (resource.Quantity, error) {
+	return resource.ParseQuantity(strconv.FormatFloat(value, 'f', -1, 64))
+}
@@ -0,0 +1,113 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslo
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/stretchr/testify/assert"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_ExportSLOSpec(t *testing.T) {
+	warningThreshold := resource.MustParse("99.95")
+	queryInterval := int32(300)
+
+	tests := []struct {
+		name    string
+		spec    v1alpha1.DatadogSLOSpec
+		slo     func(spec v1alpha1.DatadogSLOSpec) datadogV1.ServiceLevelObjective
+		wantErr string
+	}{
+		{
+			name: "metric SLO",
+			spec: v1alpha1.DatadogSLOSpec{
+				Name:        "API availability",
+				Description: ptrString("Availability of the API"),
+				Query: &v1alpha1.DatadogSLOQuery{
+					Numerator:   "sum:requests.success{*}.as_count()",
+					Denominator: "sum:requests.total{*}.as_count()",
+				},
+				Tags:             []string{"service:api"},
+				Type:             v1alpha1.DatadogSLOTypeMetric,
+				Timeframe:        v1alpha1.DatadogSLOTimeFrame30d,
+				TargetThreshold:  resource.MustParse("99.9"),
+				WarningThreshold: &warningThreshold,
+			},
+		},
+		{
+			name: "monitor SLO",
+			spec: v1alpha1.DatadogSLOSpec{
+				Name:            "API latency",
+				MonitorIDs:      []int64{1234},
+				Groups:          []string{"env:prod"},
+				Tags:            []string{"service:api"},
+				Type:            v1alpha1.DatadogSLOTypeMonitor,
+				Timeframe:       v1alpha1.DatadogSLOTimeFrame7d,
+				TargetThreshold: resource.MustParse("99"),
+			},
+		},
+		{
+			name: "time-slice SLO",
+			spec: v1alpha1.DatadogSLOSpec{
+				Name: "Checkout latency",
+				Tags: []string{"service:checkout"},
+				Type: v1alpha1.DatadogSLOTypeTimeSlice,
+				TimeSlice: &v1alpha1.DatadogSLOTimeSlice{
+					Queries: []v1alpha1.DatadogSLOTimeSliceQuery{
+						{Name: "query1", Query: "p95:trace.http.request{service:checkout}"},
+					},
+					Formula:              "query1",
+					Comparator:           v1alpha1.DatadogSLOTimeSliceComparatorLess,
+					Threshold:            resource.MustParse("0.5"),
+					QueryIntervalSeconds: &queryInterval,
+				},
+				Timeframe:       v1alpha1.DatadogSLOTimeFrame30d,
+				TargetThreshold: resource.MustParse("99.5"),
+			},
+		},
+		{
+			name: "SLO with a custom timeframe",
+			spec: v1alpha1.DatadogSLOSpec{
+				Name:            "API latency",
+				MonitorIDs:      []int64{1234},
+				Type:            v1alpha1.DatadogSLOTypeMonitor,
+				Timeframe:       v1alpha1.DatadogSLOTimeFrame7d,
+				TargetThreshold: resource.MustParse("99"),
+			},
+			slo: func(spec v1alpha1.DatadogSLOSpec) datadogV1.ServiceLevelObjective {
+				_, slo := buildSLO(&v1alpha1.DatadogSLO{Spec: spec}, nil)
+				slo.Thresholds[0].Timeframe = datadogV1.SLOTIMEFRAME_CUSTOM
+				return *slo
+			},
+			wantErr: "SLO timeframe custom is not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var slo datadogV1.ServiceLevelObjective
+			if tt.slo != nil {
+				slo = tt.slo(tt.spec)
+			} else {
+				_, built := buildSLO(&v1alpha1.DatadogSLO{Spec: tt.spec}, nil)
+				slo = *built
+			}
+
+			spec, err := ExportSLOSpec(slo)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, apiequality.Semantic.DeepEqual(tt.spec, spec), "unexpected spec: %+v", spec)
+		})
+	}
+}
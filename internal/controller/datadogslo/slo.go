@@ -9,17 +9,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
-	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 )
 
-func buildSLO(crdSLO *v1alpha1.DatadogSLO) (*datadogV1.ServiceLevelObjectiveRequest, *datadogV1.ServiceLevelObjective) {
+// buildSLO builds the SLO create and update requests of a DatadogSLO. monitorIDs are the resolved IDs of its
+// MonitorRefs, added to its MonitorIDs.
+func buildSLO(crdSLO *v1alpha1.DatadogSLO, monitorIDs []int64) (*datadogV1.ServiceLevelObjectiveRequest, *datadogV1.ServiceLevelObjective) {
 	sloType := datadogV1.SLOType(crdSLO.Spec.Type)
+	if len(monitorIDs) > 0 {
+		monitorIDs = append(append([]int64{}, crdSLO.Spec.MonitorIDs...), monitorIDs...)
+	} else {
+		monitorIDs = crdSLO.Spec.MonitorIDs
+	}
 
 	// Used for SLO creation
 	sloReq := datadogV1.NewServiceLevelObjectiveRequest(crdSLO.Spec.Name, buildThreshold(crdSLO.Spec), sloType)
@@ -37,9 +46,12 @@ func buildSLO(crdSLO *v1alpha1.DatadogSLO) (*datadogV1.ServiceLevelObjectiveRequ
 			})
 		}
 		if crdSLO.Spec.Type == v1alpha1.DatadogSLOTypeMonitor {
-			sloReq.SetMonitorIds(crdSLO.Spec.MonitorIDs)
+			sloReq.SetMonitorIds(monitorIDs)
 			sloReq.SetGroups(crdSLO.Spec.Groups)
 		}
+		if crdSLO.Spec.Type == v1alpha1.DatadogSLOTypeTimeSlice {
+			sloReq.SetSliSpecification(buildSliSpecification(crdSLO.Spec.TimeSlice))
+		}
 	}
 
 	// Used for SLO updates
@@ -58,14 +70,43 @@ func buildSLO(crdSLO *v1alpha1.DatadogSLO) (*datadogV1.ServiceLevelObjectiveRequ
 			})
 		}
 		if crdSLO.Spec.Type == v1alpha1.DatadogSLOTypeMonitor {
-			slo.SetMonitorIds(crdSLO.Spec.MonitorIDs)
+			slo.SetMonitorIds(monitorIDs)
 			slo.SetGroups(crdSLO.Spec.Groups)
 		}
+		if crdSLO.Spec.Type == v1alpha1.DatadogSLOTypeTimeSlice {
+			slo.SetSliSpecification(buildSliSpecification(crdSLO.Spec.TimeSlice))
+		}
 	}
 
 	return sloReq, slo
 }
 
+// buildSliSpecification converts the DatadogSLOTimeSlice of a time-slice SLO to its SLI specification.
+func buildSliSpecification(timeSlice *v1alpha1.DatadogSLOTimeSlice) datadogV1.SLOSliSpec {
+	queries := make([]datadogV1.SLODataSourceQueryDefinition, 0, len(timeSlice.Queries))
+	for _, query := range timeSlice.Queries {
+		queries = append(queries, datadogV1.FormulaAndFunctionMetricQueryDefinitionAsSLODataSourceQueryDefinition(
+			datadogV1.NewFormulaAndFunctionMetricQueryDefinition(datadogV1.FORMULAANDFUNCTIONMETRICDATASOURCE_METRICS, query.Name, query.Query),
+		))
+	}
+	formula := timeSlice.Formula
+	if formula == "" && len(timeSlice.Queries) == 1 {
+		formula = timeSlice.Queries[0].Name
+	}
+
+	threshold, _ := strconv.ParseFloat(timeSlice.Threshold.AsDec().String(), 64)
+	condition := datadogV1.NewSLOTimeSliceCondition(
+		datadogV1.SLOTimeSliceComparator(timeSlice.Comparator),
+		*datadogV1.NewSLOTimeSliceQuery([]datadogV1.SLOFormula{*datadogV1.NewSLOFormula(formula)}, queries),
+		threshold,
+	)
+	if timeSlice.QueryIntervalSeconds != nil {
+		condition.SetQueryIntervalSeconds(datadogV1.SLOTimeSliceInterval(*timeSlice.QueryIntervalSeconds))
+	}
+
+	return datadogV1.SLOTimeSliceSpecAsSLOSliSpec(datadogV1.NewSLOTimeSliceSpec(*condition))
+}
+
 func buildThreshold(sloSpec v1alpha1.DatadogSLOSpec) []datadogV1.SLOThreshold {
 	// Convert DatadogSLOSpec Timeframe, TargetThreshold, and WarningThreshold to datadogV1.SLOThreshold
 	// (returned as a single-item list) for backwards compatibility.
@@ -87,11 +128,11 @@ func buildThreshold(sloSpec v1alpha1.DatadogSLOSpec) []datadogV1.SLOThreshold {
 	return []datadogV1.SLOThreshold{threshold}
 }
 
-func createSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, crdSLO *v1alpha1.DatadogSLO) (datadogV1.ServiceLevelObjective, error) {
-	sloReq, _ := buildSLO(crdSLO)
+func createSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, crdSLO *v1alpha1.DatadogSLO, monitorIDs []int64) (datadogV1.ServiceLevelObjective, error) {
+	sloReq, _ := buildSLO(crdSLO, monitorIDs)
 	slo, _, err := client.CreateSLO(auth, *sloReq)
 	if err != nil {
-		return datadogV1.ServiceLevelObjective{}, translateClientError(err, "error creating SLO")
+		return datadogV1.ServiceLevelObjective{}, datadogclient.TranslateClientError(err, "error creating SLO")
 	}
 
 	return slo.Data[0], nil
@@ -100,46 +141,86 @@ func createSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi
 func getSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, sloId string) (*datadogV1.SLOResponseData, error) {
 	slo, _, err := client.GetSLO(auth, sloId, datadogV1.GetSLOOptionalParameters{})
 	if err != nil {
-		return &datadogV1.SLOResponseData{}, translateClientError(err, "error getting SLO")
+		return &datadogV1.SLOResponseData{}, datadogclient.TranslateClientError(err, "error getting SLO")
 	}
 
 	return slo.Data, nil
 }
 
-func updateSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, crdSLO *v1alpha1.DatadogSLO) (datadogV1.SLOListResponse, error) {
-	_, slo := buildSLO(crdSLO)
+// getSLOHistory returns the history of the SLO over its timeframe, until now.
+func getSLOHistory(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, crdSLO *v1alpha1.DatadogSLO, sloID string, now time.Time) (*datadogV1.SLOHistoryResponseData, error) {
+	from := now.Add(-timeframeDuration(crdSLO.Spec.Timeframe))
+	target := buildThreshold(crdSLO.Spec)[0].Target
+	history, _, err := client.GetSLOHistory(auth, sloID, from.Unix(), now.Unix(), datadogV1.GetSLOHistoryOptionalParameters{
+		Target: &target,
+	})
+	if err != nil {
+		return nil, datadogclient.TranslateClientError(err, "error getting SLO history")
+	}
+	if len(history.Errors) > 0 && history.Errors[0].Error != nil {
+		return nil, fmt.Errorf("error getting SLO history: %s", *history.Errors[0].Error)
+	}
+	if history.Data == nil || history.Data.Overall == nil {
+		return nil, errors.New("error getting SLO history: no data returned")
+	}
+
+	return history.Data, nil
+}
+
+// timeframeDuration converts a SLO timeframe, in days, to a duration.
+func timeframeDuration(timeframe v1alpha1.DatadogSLOTimeFrame) time.Duration {
+	days, _ := strconv.Atoi(strings.TrimSuffix(string(timeframe), "d"))
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// sloState returns the state of a SLO from its SLI value and thresholds, in percent.
+func sloState(sliValue, target float64, warning *float64) v1alpha1.DatadogSLOState {
+	switch {
+	case sliValue < target:
+		return v1alpha1.DatadogSLOStateBreached
+	case warning != nil && sliValue < *warning:
+		return v1alpha1.DatadogSLOStateWarning
+	default:
+		return v1alpha1.DatadogSLOStateOK
+	}
+}
+
+func updateSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, crdSLO *v1alpha1.DatadogSLO, monitorIDs []int64) (datadogV1.SLOListResponse, error) {
+	_, slo := buildSLO(crdSLO, monitorIDs)
 	sloListResponse, _, err := client.UpdateSLO(auth, crdSLO.Status.ID, *slo)
 	if err != nil {
-		return datadogV1.SLOListResponse{}, translateClientError(err, "error updating SLO")
+		return datadogV1.SLOListResponse{}, datadogclient.TranslateClientError(err, "error updating SLO")
 	}
 	return sloListResponse, nil
 }
 
+// orphanSLO tags the SLO as no longer managed by the operator. The SLO is updated from its current state in Datadog,
+// so that it's left as it is otherwise.
+func orphanSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, sloID string) error {
+	data, err := getSLO(auth, client, sloID)
+	if err != nil {
+		return err
+	}
+	slo := sloFromResponse(data)
+	tags, changed := utils.AddOrphanedTag(slo.GetTags())
+	if !changed {
+		return nil
+	}
+
+	slo.SetTags(tags)
+	if _, _, err = client.UpdateSLO(auth, sloID, slo); err != nil {
+		return datadogclient.TranslateClientError(err, "error orphaning SLO")
+	}
+	return nil
+}
+
 func deleteSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, sloID string) error {
 	force := "false"
 	optionalParams := datadogV1.DeleteSLOOptionalParameters{
 		Force: &force,
 	}
 	if _, _, err := client.DeleteSLO(auth, sloID, optionalParams); err != nil {
-		return translateClientError(err, "error deleting SLO")
+		return datadogclient.TranslateClientError(err, "error deleting SLO")
 	}
 	return nil
 }
-
-func translateClientError(err error, msg string) error {
-	if msg == "" {
-		msg = "an error occurred"
-	}
-
-	var apiErr datadogapi.GenericOpenAPIError
-	var errURL *url.Error
-	if errors.As(err, &apiErr) {
-		return fmt.Errorf(msg+": %w: %s", err, apiErr.Body())
-	}
-
-	if errors.As(err, &errURL) {
-		return fmt.Errorf(msg+" (url.Error): %s", errURL)
-	}
-
-	return fmt.Errorf(msg+": %w", err)
-}
@@ -7,6 +7,7 @@ package datadogslo
 
 import (
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 
@@ -66,6 +67,51 @@ func Test_buildThreshold(t *testing.T) {
 	}
 }
 
+func Test_sloState(t *testing.T) {
+	tests := []struct {
+		name     string
+		sliValue float64
+		warning  *float64
+		want     v1alpha1.DatadogSLOState
+	}{
+		{
+			name:     "above the target threshold",
+			sliValue: 99.95,
+			want:     v1alpha1.DatadogSLOStateOK,
+		},
+		{
+			name:     "above the warning threshold",
+			sliValue: 99.95,
+			warning:  float64Ptr(99.95),
+			want:     v1alpha1.DatadogSLOStateOK,
+		},
+		{
+			name:     "between the target and warning thresholds",
+			sliValue: 99.92,
+			warning:  float64Ptr(99.95),
+			want:     v1alpha1.DatadogSLOStateWarning,
+		},
+		{
+			name:     "below the target threshold",
+			sliValue: 99.5,
+			warning:  float64Ptr(99.95),
+			want:     v1alpha1.DatadogSLOStateBreached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sloState(tt.sliValue, 99.9, tt.warning))
+		})
+	}
+}
+
+func Test_timeframeDuration(t *testing.T) {
+	assert.Equal(t, 7*24*time.Hour, timeframeDuration(v1alpha1.DatadogSLOTimeFrame7d))
+	assert.Equal(t, 30*24*time.Hour, timeframeDuration(v1alpha1.DatadogSLOTimeFrame30d))
+	assert.Equal(t, 90*24*time.Hour, timeframeDuration(v1alpha1.DatadogSLOTimeFrame90d))
+}
+
 func float64Ptr(f float64) *float64 {
 	return &f
 }
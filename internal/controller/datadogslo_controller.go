@@ -11,12 +11,17 @@ import (
 	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 
 	"github.com/DataDog/datadog-operator/internal/controller/datadogslo"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -24,18 +29,23 @@ import (
 )
 
 type DatadogSLOReconciler struct {
-	Client      client.Client
-	DDClient    datadogclient.DatadogSLOClient
-	VersionInfo *version.Info
-	Log         logr.Logger
-	Scheme      *runtime.Scheme
-	Recorder    record.EventRecorder
-	internal    *datadogslo.Reconciler
+	Client         client.Client
+	APIReader      client.Reader
+	DDClient       datadogclient.DatadogSLOClient
+	VersionInfo    *version.Info
+	Log            logr.Logger
+	Scheme         *runtime.Scheme
+	Recorder       record.EventRecorder
+	DeletionPolicy v1alpha1.DeletionPolicy
+	NamespaceTags  utils.NamespaceTags
+	internal       *datadogslo.Reconciler
 }
 
 // +kubebuilder:rbac:groups=datadoghq.com,resources=datadogslos,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=datadoghq.com,resources=datadogslos/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=datadoghq.com,resources=datadogslos/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogmonitors,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;namespaces,verbs=get
 
 // Reconcile loop for Datadog SLO
 func (r *DatadogSLOReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -43,17 +53,46 @@ func (r *DatadogSLOReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 }
 
 func (r *DatadogSLOReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.internal = datadogslo.NewReconciler(r.Client, r.DDClient, r.VersionInfo, r.Log, r.Recorder)
+	r.internal = datadogslo.NewReconciler(r.Client, r.APIReader, r.DDClient, r.VersionInfo, r.Log, r.Recorder, r.DeletionPolicy, r.NamespaceTags)
 
-	builder := ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.DatadogSLO{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{})
+	// The SLOs referencing a DatadogMonitor are reconciled when the monitor ID changes, once created in Datadog
+	monitorPredicate := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldMonitor, oldOK := e.ObjectOld.(*v1alpha1.DatadogMonitor)
+			newMonitor, newOK := e.ObjectNew.(*v1alpha1.DatadogMonitor)
+			return !oldOK || !newOK || oldMonitor.Status.ID != newMonitor.Status.ID
+		},
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DatadogSLO{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(&v1alpha1.DatadogMonitor{}, handler.EnqueueRequestsFromMapFunc(r.enqueueReferencingSLOs), builder.WithPredicates(monitorPredicate))
 
-	err := builder.Complete(r)
+	err := r.internal.WatchNamespaces(controllerBuilder).Complete(r)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// enqueueReferencingSLOs enqueues the DatadogSLOs referencing a DatadogMonitor in their MonitorRefs.
+func (r *DatadogSLOReconciler) enqueueReferencingSLOs(ctx context.Context, obj client.Object) []reconcile.Request {
+	sloList := &v1alpha1.DatadogSLOList{}
+	if err := r.Client.List(ctx, sloList); err != nil {
+		r.Log.Error(err, "unable to list DatadogSLOs")
+		return nil
+	}
+
+	monitor := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	var requests []reconcile.Request
+	for i := range sloList.Items {
+		if datadogslo.ReferencesMonitor(&sloList.Items[i], monitor) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: sloList.Items[i].Namespace, Name: sloList.Items[i].Name},
+			})
+		}
+	}
+	return requests
+}
+
 var _ reconcile.Reconciler = (*DatadogSLOReconciler)(nil)
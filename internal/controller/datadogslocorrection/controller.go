@@ -0,0 +1,157 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslocorrection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/apiresource"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	defaultRequeuePeriod          = apiresource.DefaultRequeuePeriod
+	defaultErrRequeuePeriod       = apiresource.DefaultErrRequeuePeriod
+	defaultForceSyncPeriod        = apiresource.DefaultForceSyncPeriod
+	datadogSLOCorrectionKind      = "DatadogSLOCorrection"
+	datadogSLOCorrectionFinalizer = "finalizer.slocorrection.datadoghq.com"
+)
+
+// Reconciler reconciles DatadogSLOCorrections.
+type Reconciler = apiresource.Reconciler[*v1alpha1.DatadogSLOCorrection, datadogV1.SLOCorrection]
+
+func NewReconciler(client client.Client, ddClient datadogclient.DatadogSLOCorrectionClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder, deletionPolicy v1alpha1.DeletionPolicy) *Reconciler {
+	// The adapter isn't a Credentialer, no credentials are read with the API reader
+	return apiresource.NewReconciler(client, client, &adapter{client: client, datadogClient: ddClient.Client, datadogAuth: ddClient.Auth}, versionInfo, log, recorder, deletionPolicy, utils.NamespaceTags{})
+}
+
+// adapter adapts DatadogSLOCorrections to the apiresource.Reconciler.
+type adapter struct {
+	client        client.Client
+	datadogClient *datadogV1.ServiceLevelObjectiveCorrectionsApi
+	datadogAuth   context.Context
+}
+
+var (
+	_ apiresource.Adapter[*v1alpha1.DatadogSLOCorrection, datadogV1.SLOCorrection] = (*adapter)(nil)
+	_ apiresource.Resolver[*v1alpha1.DatadogSLOCorrection]                         = (*adapter)(nil)
+	_ apiresource.Replacer[*v1alpha1.DatadogSLOCorrection]                         = (*adapter)(nil)
+)
+
+func (a *adapter) Kind() string {
+	return datadogSLOCorrectionKind
+}
+
+func (a *adapter) Finalizer() string {
+	return datadogSLOCorrectionFinalizer
+}
+
+func (a *adapter) NewObject() *v1alpha1.DatadogSLOCorrection {
+	return &v1alpha1.DatadogSLOCorrection{}
+}
+
+func (a *adapter) Status(instance *v1alpha1.DatadogSLOCorrection) apiresource.Status {
+	return &apiresource.StatusFields[v1alpha1.DatadogSLOCorrectionSyncStatus]{
+		Conditions:        &instance.Status.Conditions,
+		ID:                &instance.Status.ID,
+		Creator:           &instance.Status.Creator,
+		Created:           &instance.Status.Created,
+		SyncStatus:        &instance.Status.SyncStatus,
+		LastForceSyncTime: &instance.Status.LastForceSyncTime,
+		CurrentHash:       &instance.Status.CurrentHash,
+		SyncStatusOK:      v1alpha1.DatadogSLOCorrectionSyncStatusOK,
+		SyncStatusErrors: map[apiresource.Step]v1alpha1.DatadogSLOCorrectionSyncStatus{
+			apiresource.StepValidate: v1alpha1.DatadogSLOCorrectionSyncStatusValidateError,
+			apiresource.StepResolve:  v1alpha1.DatadogSLOCorrectionSyncStatusSLORefError,
+			apiresource.StepCreate:   v1alpha1.DatadogSLOCorrectionSyncStatusCreateError,
+			apiresource.StepGet:      v1alpha1.DatadogSLOCorrectionSyncStatusGetError,
+			apiresource.StepUpdate:   v1alpha1.DatadogSLOCorrectionSyncStatusUpdateError,
+		},
+	}
+}
+
+func (a *adapter) Validate(instance *v1alpha1.DatadogSLOCorrection) error {
+	return v1alpha1.IsValidDatadogSLOCorrection(&instance.Spec)
+}
+
+// HashedSpec returns the spec and the ID of the referenced SLO.
+func (a *adapter) HashedSpec(instance *v1alpha1.DatadogSLOCorrection) interface{} {
+	return struct {
+		*v1alpha1.DatadogSLOCorrectionSpec
+		SLOID string `json:"sloID,omitempty"`
+	}{&instance.Spec, instance.Status.SLOID}
+}
+
+func (a *adapter) DeletionPolicy(instance *v1alpha1.DatadogSLOCorrection) v1alpha1.DeletionPolicy {
+	return instance.Spec.DeletionPolicy
+}
+
+// Resolve sets the Datadog ID of the DatadogSLO referenced by the SLO correction in the status of a copy.
+func (a *adapter) Resolve(ctx context.Context, instance *v1alpha1.DatadogSLOCorrection) (*v1alpha1.DatadogSLOCorrection, error) {
+	slo := &v1alpha1.DatadogSLO{}
+	if err := a.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.SLORef.Name}, slo); err != nil {
+		return nil, fmt.Errorf("unable to get DatadogSLO %s: %w", instance.Spec.SLORef.Name, err)
+	}
+	if slo.Status.ID == "" {
+		return nil, fmt.Errorf("DatadogSLO %s isn't created in Datadog yet", instance.Spec.SLORef.Name)
+	}
+
+	resolved := instance.DeepCopy()
+	resolved.Status.SLOID = slo.Status.ID
+	return resolved, nil
+}
+
+func (a *adapter) SetResolved(instance, desired *v1alpha1.DatadogSLOCorrection) {
+	instance.Status.SLOID = desired.Status.SLOID
+}
+
+// Replace returns true if the referenced SLO changed, the SLO of a correction can't be updated.
+func (a *adapter) Replace(instance, desired *v1alpha1.DatadogSLOCorrection) bool {
+	return instance.Status.SLOID != desired.Status.SLOID
+}
+
+func (a *adapter) Create(ctx context.Context, instance *v1alpha1.DatadogSLOCorrection) (datadogV1.SLOCorrection, error) {
+	return createSLOCorrection(a.datadogAuth, a.datadogClient, instance, instance.Status.SLOID)
+}
+
+func (a *adapter) Get(ctx context.Context, id string) (datadogV1.SLOCorrection, error) {
+	return getSLOCorrection(a.datadogAuth, a.datadogClient, id)
+}
+
+func (a *adapter) Update(ctx context.Context, instance *v1alpha1.DatadogSLOCorrection) (datadogV1.SLOCorrection, error) {
+	return updateSLOCorrection(a.datadogAuth, a.datadogClient, instance)
+}
+
+func (a *adapter) Delete(ctx context.Context, instance *v1alpha1.DatadogSLOCorrection) error {
+	return deleteSLOCorrection(a.datadogAuth, a.datadogClient, instance.Status.ID)
+}
+
+func (a *adapter) Info(correction datadogV1.SLOCorrection) apiresource.Info {
+	info := apiresource.Info{ID: correction.GetId()}
+	if attributes, ok := correction.GetAttributesOk(); ok {
+		creator := attributes.GetCreator()
+		info.Creator = creator.GetEmail()
+		if createdAt := attributes.CreatedAt.Get(); createdAt != nil {
+			info.Created = time.Unix(*createdAt, 0)
+		}
+		if modifiedAt := attributes.ModifiedAt.Get(); modifiedAt != nil {
+			info.Modified = time.Unix(*modifiedAt, 0)
+		}
+	}
+	return info
+}
@@ -0,0 +1,339 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslocorrection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	resourceNamespace = "default"
+	resourceName      = "correction"
+	sloName           = "slo"
+)
+
+// TestReconciler_Reconcile tests the Reconcile method of the Reconciler
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	testLogger := zap.New(zap.UseDevMode(true))
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogSLOCorrection{}, &v1alpha1.DatadogSLO{})
+
+	request := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+	}
+
+	type mockedFields struct {
+		k8sClient client.Client
+	}
+	tests := []struct {
+		name                 string
+		mockOn               func(t *testing.T, m *mockedFields)
+		datadogClientHandler func(t *testing.T) http.HandlerFunc
+		expectedResult       ctrl.Result
+		checkStatus          func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus)
+	}{
+		{
+			name: "Return empty result when SLO correction is not found",
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {}
+			},
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name: "Requeue when the referenced SLO isn't created in Datadog",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultSLOCorrection())
+				createSLO(t, m.k8sClient, "")
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected call to the Datadog API: %s %s", r.Method, r.URL.Path)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogSLOCorrectionSyncStatusSLORefError, status.SyncStatus)
+			},
+		},
+		{
+			name: "Create SLO correction for the referenced SLO",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultSLOCorrection())
+				createSLO(t, m.k8sClient, "slo-1")
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodPost, r.Method)
+					body, _ := io.ReadAll(r.Body)
+					req := datadogV1.SLOCorrectionCreateRequest{}
+					require.NoError(t, json.Unmarshal(body, &req))
+					assert.Equal(t, "slo-1", req.Data.Attributes.SloId)
+					assert.Equal(t, datadogV1.SLOCORRECTIONCATEGORY_DEPLOYMENT, req.Data.Attributes.Category)
+					writeSLOCorrectionResponse(w, "correction-1")
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus) {
+				assert.Equal(t, "correction-1", status.ID)
+				assert.Equal(t, "slo-1", status.SLOID)
+				assert.Equal(t, "foo@example.com", status.Creator)
+				assert.Equal(t, v1alpha1.DatadogSLOCorrectionSyncStatusOK, status.SyncStatus)
+				assert.NotEmpty(t, status.CurrentHash)
+			},
+		},
+		{
+			name: "Return Error and Requeue result when creating SLO correction is failed",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultSLOCorrection())
+				createSLO(t, m.k8sClient, "slo-1")
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					http.Error(w, "invalid data", http.StatusBadRequest)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogSLOCorrectionSyncStatusCreateError, status.SyncStatus)
+			},
+		},
+		{
+			name: "Update SLO correction when the spec changes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				correction := syncedSLOCorrection(t, time.Now())
+				correction.Spec.Category = v1alpha1.DatadogSLOCorrectionCategoryOther
+				_ = m.k8sClient.Create(context.TODO(), correction)
+				createSLO(t, m.k8sClient, "slo-1")
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodPatch, r.Method)
+					assert.Equal(t, "/api/v1/slo/correction/correction-1", r.URL.Path)
+					writeSLOCorrectionResponse(w, "correction-1")
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus) {
+				assert.Equal(t, "correction-1", status.ID)
+				assert.Equal(t, v1alpha1.DatadogSLOCorrectionSyncStatusOK, status.SyncStatus)
+			},
+		},
+		{
+			name: "Create the SLO correction again when the SLO ID changes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedSLOCorrection(t, time.Now()))
+				createSLO(t, m.k8sClient, "slo-2")
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodDelete {
+						assert.Equal(t, "/api/v1/slo/correction/correction-1", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+						return
+					}
+					assert.Equal(t, http.MethodPost, r.Method)
+					writeSLOCorrectionResponse(w, "correction-2")
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus) {
+				assert.Equal(t, "correction-2", status.ID)
+				assert.Equal(t, "slo-2", status.SLOID)
+			},
+		},
+		{
+			name: "Create the SLO correction again when it's deleted outside Kubernetes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedSLOCorrection(t, time.Now().Add(-2*defaultForceSyncPeriod)))
+				createSLO(t, m.k8sClient, "slo-1")
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodGet {
+						http.Error(w, `{"errors": ["SLO correction not found"]}`, http.StatusNotFound)
+						return
+					}
+					assert.Equal(t, http.MethodPost, r.Method)
+					writeSLOCorrectionResponse(w, "correction-2")
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus) {
+				assert.Equal(t, "correction-2", status.ID)
+			},
+		},
+		{
+			name: "Set validation error on invalid SLO correction",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				correction := defaultSLOCorrection()
+				correction.Spec.End = nil
+				_ = m.k8sClient.Create(context.TODO(), correction)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected call to the Datadog API: %s %s", r.Method, r.URL.Path)
+				}
+			},
+			expectedResult: ctrl.Result{},
+			checkStatus: func(t *testing.T, status v1alpha1.DatadogSLOCorrectionStatus) {
+				assert.Equal(t, v1alpha1.DatadogSLOCorrectionSyncStatusValidateError, status.SyncStatus)
+			},
+		},
+	}
+
+	// Iterate through test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(tt.datadogClientHandler(t))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV1.NewServiceLevelObjectiveCorrectionsApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			m := mockedFields{
+				k8sClient: fake.NewClientBuilder().WithStatusSubresource(&v1alpha1.DatadogSLOCorrection{}, &v1alpha1.DatadogSLO{}).Build(),
+			}
+			if tt.mockOn != nil {
+				tt.mockOn(t, &m)
+			}
+			recorder := record.NewFakeRecorder(5)
+			ddClient := datadogclient.DatadogSLOCorrectionClient{Client: client, Auth: testAuth}
+			r := NewReconciler(m.k8sClient, ddClient, &version.Info{}, testLogger, recorder, "")
+
+			res, _ := r.Reconcile(ctx, request)
+			assert.Equal(t, tt.expectedResult, res)
+
+			if tt.checkStatus != nil {
+				correction := &v1alpha1.DatadogSLOCorrection{}
+				require.NoError(t, m.k8sClient.Get(ctx, request.NamespacedName, correction))
+				tt.checkStatus(t, correction.Status)
+			}
+		})
+	}
+}
+
+func defaultSLOCorrection() *v1alpha1.DatadogSLOCorrection {
+	start := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC))
+	return &v1alpha1.DatadogSLOCorrection{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogSLOCorrection",
+			APIVersion: fmt.Sprintf("%s/%s", v1alpha1.GroupVersion.Group, v1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+		Spec: v1alpha1.DatadogSLOCorrectionSpec{
+			SLORef:   v1alpha1.DatadogSLOCorrectionSLOReference{Name: sloName},
+			Category: v1alpha1.DatadogSLOCorrectionCategoryDeployment,
+			Start:    start,
+			End:      &end,
+		},
+	}
+}
+
+// syncedSLOCorrection returns a DatadogSLOCorrection whose spec was synced with Datadog at the given time
+func syncedSLOCorrection(t *testing.T, lastSync time.Time) *v1alpha1.DatadogSLOCorrection {
+	correction := defaultSLOCorrection()
+	lastSyncTime := metav1.NewTime(lastSync)
+	correction.Status = v1alpha1.DatadogSLOCorrectionStatus{
+		ID:                "correction-1",
+		SLOID:             "slo-1",
+		LastForceSyncTime: &lastSyncTime,
+	}
+	hash, err := comparison.GenerateMD5ForSpec((&adapter{}).HashedSpec(correction))
+	require.NoError(t, err)
+	correction.Status.CurrentHash = hash
+	return correction
+}
+
+// createSLO creates the referenced DatadogSLO, with the given ID in its status
+func createSLO(t *testing.T, k8sClient client.Client, id string) {
+	slo := &v1alpha1.DatadogSLO{
+		ObjectMeta: metav1.ObjectMeta{Namespace: resourceNamespace, Name: sloName},
+	}
+	require.NoError(t, k8sClient.Create(context.TODO(), slo))
+	if id != "" {
+		slo.Status.ID = id
+		require.NoError(t, k8sClient.Status().Update(context.TODO(), slo))
+	}
+}
+
+func writeSLOCorrectionResponse(w http.ResponseWriter, id string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(datadogV1.SLOCorrectionResponse{
+		Data: &datadogV1.SLOCorrection{
+			Id:   &id,
+			Type: datadogV1.SLOCORRECTIONTYPE_CORRECTION.Ptr(),
+			Attributes: &datadogV1.SLOCorrectionResponseAttributes{
+				CreatedAt: *datadogapi.NewNullableInt64(datadogapi.PtrInt64(1714557600)),
+				Creator: &datadogV1.Creator{
+					Email: datadogapi.PtrString("foo@example.com"),
+				},
+			},
+		},
+	})
+}
+
+func setupTestAuth(apiURL string) context.Context {
+	testAuth := context.WithValue(
+		context.Background(),
+		datadogapi.ContextAPIKeys,
+		map[string]datadogapi.APIKey{
+			"apiKeyAuth": {
+				Key: "DUMMY_API_KEY",
+			},
+			"appKeyAuth": {
+				Key: "DUMMY_APP_KEY",
+			},
+		},
+	)
+	parsedAPIURL, _ := url.Parse(apiURL)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerIndex, 1)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerVariables, map[string]string{
+		"name":     parsedAPIURL.Host,
+		"protocol": parsedAPIURL.Scheme,
+	})
+
+	return testAuth
+}
@@ -0,0 +1,96 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslocorrection
+
+import (
+	"context"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+func buildSLOCorrectionCreateRequest(crdCorrection *v1alpha1.DatadogSLOCorrection, sloID string) *datadogV1.SLOCorrectionCreateRequest {
+	spec := &crdCorrection.Spec
+	attributes := datadogV1.NewSLOCorrectionCreateRequestAttributes(datadogV1.SLOCorrectionCategory(spec.Category), sloID, spec.Start.Unix())
+	attributes.Description = spec.Description
+	if spec.End != nil {
+		attributes.SetEnd(spec.End.Unix())
+	}
+	if spec.Duration != nil {
+		attributes.SetDuration(int64(spec.Duration.Seconds()))
+	}
+	if spec.Rrule != "" {
+		attributes.SetRrule(spec.Rrule)
+	}
+	if spec.Timezone != "" {
+		attributes.SetTimezone(spec.Timezone)
+	}
+
+	data := datadogV1.NewSLOCorrectionCreateData(datadogV1.SLOCORRECTIONTYPE_CORRECTION)
+	data.SetAttributes(*attributes)
+	return &datadogV1.SLOCorrectionCreateRequest{Data: data}
+}
+
+func buildSLOCorrectionUpdateRequest(crdCorrection *v1alpha1.DatadogSLOCorrection) *datadogV1.SLOCorrectionUpdateRequest {
+	spec := &crdCorrection.Spec
+	attributes := datadogV1.NewSLOCorrectionUpdateRequestAttributes()
+	attributes.SetCategory(datadogV1.SLOCorrectionCategory(spec.Category))
+	attributes.SetStart(spec.Start.Unix())
+	attributes.Description = spec.Description
+	if spec.End != nil {
+		attributes.SetEnd(spec.End.Unix())
+	}
+	if spec.Duration != nil {
+		attributes.SetDuration(int64(spec.Duration.Seconds()))
+	}
+	if spec.Rrule != "" {
+		attributes.SetRrule(spec.Rrule)
+	}
+	if spec.Timezone != "" {
+		attributes.SetTimezone(spec.Timezone)
+	}
+
+	data := datadogV1.NewSLOCorrectionUpdateData()
+	data.SetType(datadogV1.SLOCORRECTIONTYPE_CORRECTION)
+	data.SetAttributes(*attributes)
+	return &datadogV1.SLOCorrectionUpdateRequest{Data: data}
+}
+
+func createSLOCorrection(auth context.Context, client *datadogV1.ServiceLevelObjectiveCorrectionsApi, crdCorrection *v1alpha1.DatadogSLOCorrection, sloID string) (datadogV1.SLOCorrection, error) {
+	correction, _, err := client.CreateSLOCorrection(auth, *buildSLOCorrectionCreateRequest(crdCorrection, sloID))
+	if err != nil {
+		return datadogV1.SLOCorrection{}, datadogclient.TranslateClientError(err, "error creating SLO correction")
+	}
+
+	return correction.GetData(), nil
+}
+
+func getSLOCorrection(auth context.Context, client *datadogV1.ServiceLevelObjectiveCorrectionsApi, correctionID string) (datadogV1.SLOCorrection, error) {
+	correction, _, err := client.GetSLOCorrection(auth, correctionID)
+	if err != nil {
+		return datadogV1.SLOCorrection{}, datadogclient.TranslateClientError(err, "error getting SLO correction")
+	}
+
+	return correction.GetData(), nil
+}
+
+func updateSLOCorrection(auth context.Context, client *datadogV1.ServiceLevelObjectiveCorrectionsApi, crdCorrection *v1alpha1.DatadogSLOCorrection) (datadogV1.SLOCorrection, error) {
+	correction, _, err := client.UpdateSLOCorrection(auth, crdCorrection.Status.ID, *buildSLOCorrectionUpdateRequest(crdCorrection))
+	if err != nil {
+		return datadogV1.SLOCorrection{}, datadogclient.TranslateClientError(err, "error updating SLO correction")
+	}
+
+	return correction.GetData(), nil
+}
+
+func deleteSLOCorrection(auth context.Context, client *datadogV1.ServiceLevelObjectiveCorrectionsApi, correctionID string) error {
+	if _, err := client.DeleteSLOCorrection(auth, correctionID); err != nil {
+		return datadogclient.TranslateClientError(err, "error deleting SLO correction")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslocorrection
+
+import (
+	"testing"
+	"time"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func Test_buildSLOCorrectionCreateRequest(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name string
+		spec v1alpha1.DatadogSLOCorrectionSpec
+		want datadogV1.SLOCorrectionCreateRequestAttributes
+	}{
+		{
+			name: "one-off correction",
+			spec: v1alpha1.DatadogSLOCorrectionSpec{
+				Category:    v1alpha1.DatadogSLOCorrectionCategoryScheduledMaintenance,
+				Description: datadogapi.PtrString("database upgrade"),
+				Start:       start,
+				End:         &end,
+			},
+			want: datadogV1.SLOCorrectionCreateRequestAttributes{
+				Category:    datadogV1.SLOCORRECTIONCATEGORY_SCHEDULED_MAINTENANCE,
+				Description: datadogapi.PtrString("database upgrade"),
+				SloId:       "slo-1",
+				Start:       start.Unix(),
+				End:         datadogapi.PtrInt64(end.Unix()),
+			},
+		},
+		{
+			name: "recurring correction",
+			spec: v1alpha1.DatadogSLOCorrectionSpec{
+				Category: v1alpha1.DatadogSLOCorrectionCategoryOutsideBusinessHours,
+				Start:    start,
+				Duration: &metav1.Duration{Duration: 14 * time.Hour},
+				Rrule:    "FREQ=DAILY;INTERVAL=1",
+				Timezone: "Europe/Paris",
+			},
+			want: datadogV1.SLOCorrectionCreateRequestAttributes{
+				Category: datadogV1.SLOCORRECTIONCATEGORY_OUTSIDE_BUSINESS_HOURS,
+				SloId:    "slo-1",
+				Start:    start.Unix(),
+				Duration: datadogapi.PtrInt64(14 * 3600),
+				Rrule:    datadogapi.PtrString("FREQ=DAILY;INTERVAL=1"),
+				Timezone: datadogapi.PtrString("Europe/Paris"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := buildSLOCorrectionCreateRequest(&v1alpha1.DatadogSLOCorrection{Spec: tt.spec}, "slo-1")
+			assert.Equal(t, datadogV1.SLOCORRECTIONTYPE_CORRECTION, req.Data.GetType())
+			assert.Equal(t, tt.want, req.Data.GetAttributes())
+		})
+	}
+}
+
+func Test_buildSLOCorrectionUpdateRequest(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC))
+
+	crdCorrection := &v1alpha1.DatadogSLOCorrection{
+		Spec: v1alpha1.DatadogSLOCorrectionSpec{
+			Category: v1alpha1.DatadogSLOCorrectionCategoryDeployment,
+			Start:    start,
+			End:      &end,
+		},
+	}
+
+	req := buildSLOCorrectionUpdateRequest(crdCorrection)
+	attributes := req.Data.GetAttributes()
+	assert.Equal(t, datadogV1.SLOCORRECTIONTYPE_CORRECTION, req.Data.GetType())
+	assert.Equal(t, datadogV1.SLOCORRECTIONCATEGORY_DEPLOYMENT, attributes.GetCategory())
+	assert.Equal(t, start.Unix(), attributes.GetStart())
+	assert.Equal(t, end.Unix(), attributes.GetEnd())
+	assert.Nil(t, attributes.Rrule)
+	assert.Nil(t, attributes.Duration)
+}
@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+
+	"github.com/DataDog/datadog-operator/internal/controller/datadogslocorrection"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+type DatadogSLOCorrectionReconciler struct {
+	Client         client.Client
+	DDClient       datadogclient.DatadogSLOCorrectionClient
+	VersionInfo    *version.Info
+	Log            logr.Logger
+	Scheme         *runtime.Scheme
+	Recorder       record.EventRecorder
+	DeletionPolicy v1alpha1.DeletionPolicy
+	internal       *datadogslocorrection.Reconciler
+}
+
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogslocorrections,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogslocorrections/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogslocorrections/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogslos,verbs=get;list;watch
+
+// Reconcile loop for Datadog SLO Correction
+func (r *DatadogSLOCorrectionReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internal.Reconcile(ctx, req)
+}
+
+func (r *DatadogSLOCorrectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.internal = datadogslocorrection.NewReconciler(r.Client, r.DDClient, r.VersionInfo, r.Log, r.Recorder, r.DeletionPolicy)
+
+	// The corrections of a DatadogSLO are reconciled when the SLO ID changes, once created in Datadog
+	sloPredicate := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSLO, oldOK := e.ObjectOld.(*v1alpha1.DatadogSLO)
+			newSLO, newOK := e.ObjectNew.(*v1alpha1.DatadogSLO)
+			return !oldOK || !newOK || oldSLO.Status.ID != newSLO.Status.ID
+		},
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DatadogSLOCorrection{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(&v1alpha1.DatadogSLO{}, handler.EnqueueRequestsFromMapFunc(r.enqueueSLOCorrections), builder.WithPredicates(sloPredicate))
+
+	err := controllerBuilder.Complete(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// enqueueSLOCorrections enqueues the DatadogSLOCorrections referencing a DatadogSLO.
+func (r *DatadogSLOCorrectionReconciler) enqueueSLOCorrections(ctx context.Context, obj client.Object) []reconcile.Request {
+	correctionList := &v1alpha1.DatadogSLOCorrectionList{}
+	if err := r.Client.List(ctx, correctionList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "unable to list DatadogSLOCorrections", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, correction := range correctionList.Items {
+		if correction.Spec.SLORef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: correction.Namespace, Name: correction.Name},
+			})
+		}
+	}
+	return requests
+}
+
+var _ reconcile.Reconciler = (*DatadogSLOCorrectionReconciler)(nil)
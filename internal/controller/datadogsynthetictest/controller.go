@@ -0,0 +1,181 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/internal/controller/apiresource"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	defaultRequeuePeriod          = apiresource.DefaultRequeuePeriod
+	defaultErrRequeuePeriod       = apiresource.DefaultErrRequeuePeriod
+	defaultForceSyncPeriod        = apiresource.DefaultForceSyncPeriod
+	datadogSyntheticTestKind      = "DatadogSyntheticTest"
+	datadogSyntheticTestFinalizer = "finalizer.synthetictest.datadoghq.com"
+)
+
+// Reconciler reconciles DatadogSyntheticTests.
+type Reconciler = apiresource.Reconciler[*v1alpha1.DatadogSyntheticTest, datadogV1.SyntheticsAPITest]
+
+func NewReconciler(client client.Client, ddClient datadogclient.DatadogSyntheticTestClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder, deletionPolicy v1alpha1.DeletionPolicy, namespaceTags utils.NamespaceTags) *Reconciler {
+	// The adapter isn't a Credentialer, no credentials are read with the API reader
+	return apiresource.NewReconciler(client, client, &adapter{datadogClient: ddClient.Client, datadogAuth: ddClient.Auth}, versionInfo, log, recorder, deletionPolicy, namespaceTags)
+}
+
+// adapter adapts DatadogSyntheticTests to the apiresource.Reconciler.
+type adapter struct {
+	datadogClient *datadogV1.SyntheticsApi
+	datadogAuth   context.Context
+}
+
+var (
+	_ apiresource.Adapter[*v1alpha1.DatadogSyntheticTest, datadogV1.SyntheticsAPITest]  = (*adapter)(nil)
+	_ apiresource.Orphaner[*v1alpha1.DatadogSyntheticTest]                              = (*adapter)(nil)
+	_ apiresource.Tagger[*v1alpha1.DatadogSyntheticTest]                                = (*adapter)(nil)
+	_ apiresource.Observer[*v1alpha1.DatadogSyntheticTest, datadogV1.SyntheticsAPITest] = (*adapter)(nil)
+	_ apiresource.Refresher[*v1alpha1.DatadogSyntheticTest]                             = (*adapter)(nil)
+)
+
+func (a *adapter) Kind() string {
+	return datadogSyntheticTestKind
+}
+
+func (a *adapter) Finalizer() string {
+	return datadogSyntheticTestFinalizer
+}
+
+func (a *adapter) NewObject() *v1alpha1.DatadogSyntheticTest {
+	return &v1alpha1.DatadogSyntheticTest{}
+}
+
+func (a *adapter) Status(instance *v1alpha1.DatadogSyntheticTest) apiresource.Status {
+	return &syntheticTestStatus{
+		StatusFields: apiresource.StatusFields[v1alpha1.DatadogSyntheticTestSyncStatus]{
+			Conditions:        &instance.Status.Conditions,
+			ID:                &instance.Status.ID,
+			Created:           &instance.Status.Created,
+			SyncStatus:        &instance.Status.SyncStatus,
+			LastForceSyncTime: &instance.Status.LastForceSyncTime,
+			CurrentHash:       &instance.Status.CurrentHash,
+			SyncStatusOK:      v1alpha1.DatadogSyntheticTestSyncStatusOK,
+			SyncStatusErrors: map[apiresource.Step]v1alpha1.DatadogSyntheticTestSyncStatus{
+				apiresource.StepValidate: v1alpha1.DatadogSyntheticTestSyncStatusValidateError,
+				apiresource.StepCreate:   v1alpha1.DatadogSyntheticTestSyncStatusCreateError,
+				apiresource.StepGet:      v1alpha1.DatadogSyntheticTestSyncStatusGetError,
+				apiresource.StepUpdate:   v1alpha1.DatadogSyntheticTestSyncStatusUpdateError,
+			},
+		},
+		Status: &instance.Status,
+	}
+}
+
+// syntheticTestStatus clears the results of the previous test when a new test is created.
+type syntheticTestStatus struct {
+	apiresource.StatusFields[v1alpha1.DatadogSyntheticTestSyncStatus]
+	Status *v1alpha1.DatadogSyntheticTestStatus
+}
+
+func (s *syntheticTestStatus) SetCreated(creator string, created *metav1.Time) {
+	s.StatusFields.SetCreated(creator, created)
+	s.Status.LastResult = nil
+	s.Status.ResultLastUpdateTime = nil
+}
+
+func (a *adapter) Validate(instance *v1alpha1.DatadogSyntheticTest) error {
+	return v1alpha1.IsValidDatadogSyntheticTest(&instance.Spec)
+}
+
+func (a *adapter) HashedSpec(instance *v1alpha1.DatadogSyntheticTest) interface{} {
+	return &instance.Spec
+}
+
+func (a *adapter) DeletionPolicy(instance *v1alpha1.DatadogSyntheticTest) v1alpha1.DeletionPolicy {
+	return instance.Spec.DeletionPolicy
+}
+
+func (a *adapter) Tags(instance *v1alpha1.DatadogSyntheticTest) *[]string {
+	if instance.Spec.ControllerOptions != nil && apiutils.BoolValue(instance.Spec.ControllerOptions.DisableRequiredTags) {
+		return nil
+	}
+	return &instance.Spec.Tags
+}
+
+func (a *adapter) Create(ctx context.Context, instance *v1alpha1.DatadogSyntheticTest) (datadogV1.SyntheticsAPITest, error) {
+	return createSyntheticTest(a.datadogAuth, a.datadogClient, instance)
+}
+
+func (a *adapter) Get(ctx context.Context, id string) (datadogV1.SyntheticsAPITest, error) {
+	return getSyntheticTest(a.datadogAuth, a.datadogClient, id)
+}
+
+func (a *adapter) Update(ctx context.Context, instance *v1alpha1.DatadogSyntheticTest) (datadogV1.SyntheticsAPITest, error) {
+	updatedTest, err := updateSyntheticTest(a.datadogAuth, a.datadogClient, instance)
+	if err != nil {
+		return updatedTest, err
+	}
+
+	// The status of a test is only changed by a dedicated request
+	if pauseStatus := desiredPauseStatus(instance); updatedTest.GetStatus() != pauseStatus {
+		if err = updateSyntheticTestPauseStatus(a.datadogAuth, a.datadogClient, instance.Status.ID, pauseStatus); err != nil {
+			return updatedTest, err
+		}
+		updatedTest.SetStatus(pauseStatus)
+	}
+
+	return updatedTest, nil
+}
+
+func (a *adapter) Delete(ctx context.Context, instance *v1alpha1.DatadogSyntheticTest) error {
+	return deleteSyntheticTest(a.datadogAuth, a.datadogClient, instance.Status.ID)
+}
+
+func (a *adapter) Orphan(ctx context.Context, instance *v1alpha1.DatadogSyntheticTest) error {
+	return orphanSyntheticTest(a.datadogAuth, a.datadogClient, instance.Status.ID)
+}
+
+func (a *adapter) Info(test datadogV1.SyntheticsAPITest) apiresource.Info {
+	return apiresource.Info{ID: test.GetPublicId()}
+}
+
+// Observe sets the monitor and the status of the test in the status.
+func (a *adapter) Observe(instance *v1alpha1.DatadogSyntheticTest, test datadogV1.SyntheticsAPITest, now metav1.Time) {
+	instance.Status.MonitorID = test.GetMonitorId()
+	instance.Status.TestStatus = v1alpha1.DatadogSyntheticTestPauseStatus(test.GetStatus())
+}
+
+// Refresh sets the most recent result of the test in the status, at most once per requeue period. The previous
+// result is kept if the results can't be fetched.
+func (a *adapter) Refresh(ctx context.Context, logger logr.Logger, instance *v1alpha1.DatadogSyntheticTest, now metav1.Time) {
+	status := &instance.Status
+	if status.ResultLastUpdateTime != nil && now.Sub(status.ResultLastUpdateTime.Time) < defaultRequeuePeriod {
+		return
+	}
+
+	results, err := getSyntheticTestLatestResults(a.datadogAuth, a.datadogClient, status.ID)
+	if err != nil {
+		logger.Error(err, "error getting synthetic test latest results", "Test ID", status.ID)
+		return
+	}
+	status.ResultLastUpdateTime = &now
+
+	if last := latestResult(results); last != nil {
+		status.LastResult = last
+	}
+}
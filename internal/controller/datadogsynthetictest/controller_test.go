@@ -0,0 +1,351 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	resourceNamespace = "default"
+	resourceName      = "test"
+	testResultsPath   = "/api/v1/synthetics/tests/abc-def-ghi/results"
+)
+
+// TestReconciler_Reconcile tests the Reconcile method of the Reconciler
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	testLogger := zap.New(zap.UseDevMode(true))
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogSyntheticTest{})
+
+	request := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+	}
+
+	type mockedFields struct {
+		k8sClient client.Client
+	}
+	tests := []struct {
+		name                 string
+		mockOn               func(t *testing.T, m *mockedFields)
+		datadogClientHandler func(t *testing.T) http.HandlerFunc
+		expectedResult       ctrl.Result
+		checkTest            func(t *testing.T, test *v1alpha1.DatadogSyntheticTest)
+	}{
+		{
+			name: "Return empty result when synthetic test is not found",
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {}
+			},
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name: "Add required tags before creating the synthetic test",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				test := defaultSyntheticTest()
+				test.Spec.Tags = nil
+				_ = m.k8sClient.Create(context.TODO(), test)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected call to the Datadog API: %s %s", r.Method, r.URL.Path)
+				}
+			},
+			expectedResult: ctrl.Result{},
+			checkTest: func(t *testing.T, test *v1alpha1.DatadogSyntheticTest) {
+				assert.Empty(t, test.Status.ID)
+				assert.Contains(t, test.Spec.Tags, "generated:kubernetes")
+			},
+		},
+		{
+			name: "Create synthetic test and report its latest result",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultSyntheticTest())
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path == testResultsPath {
+						writeLatestResultsResponse(w)
+						return
+					}
+					assert.Equal(t, http.MethodPost, r.Method)
+					assert.Equal(t, "/api/v1/synthetics/tests/api", r.URL.Path)
+					body, _ := io.ReadAll(r.Body)
+					test := datadogV1.SyntheticsAPITest{}
+					require.NoError(t, json.Unmarshal(body, &test))
+					assert.Equal(t, datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_HTTP, test.GetSubtype())
+					assert.Equal(t, "https://www.example.com", test.Config.Request.GetUrl())
+					assert.Equal(t, float64(200), test.Config.Assertions[0].SyntheticsAssertionTarget.Target)
+					assert.Equal(t, datadogV1.SYNTHETICSTESTPAUSESTATUS_LIVE, test.GetStatus())
+					writeSyntheticTestResponse(w, "abc-def-ghi", datadogV1.SYNTHETICSTESTPAUSESTATUS_LIVE)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkTest: func(t *testing.T, test *v1alpha1.DatadogSyntheticTest) {
+				assert.Equal(t, "abc-def-ghi", test.Status.ID)
+				assert.Equal(t, int64(1234), test.Status.MonitorID)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestPauseStatusLive, test.Status.TestStatus)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestSyncStatusOK, test.Status.SyncStatus)
+				assert.NotEmpty(t, test.Status.CurrentHash)
+				require.NotNil(t, test.Status.LastResult)
+				assert.Equal(t, "result-2", test.Status.LastResult.ID)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestResultStatusFailed, test.Status.LastResult.Status)
+				assert.Equal(t, "aws:eu-west-1", test.Status.LastResult.Location)
+			},
+		},
+		{
+			name: "Return Error and Requeue result when creating synthetic test is failed",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultSyntheticTest())
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					http.Error(w, "invalid data", http.StatusBadRequest)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			checkTest: func(t *testing.T, test *v1alpha1.DatadogSyntheticTest) {
+				assert.Empty(t, test.Status.ID)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestSyncStatusCreateError, test.Status.SyncStatus)
+			},
+		},
+		{
+			name: "Update synthetic test and pause it when the spec changes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				test := syncedSyntheticTest(t, time.Now())
+				test.Spec.Paused = apiutils.NewBoolPointer(true)
+				_ = m.k8sClient.Create(context.TODO(), test)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Path {
+					case testResultsPath:
+						writeLatestResultsResponse(w)
+					case "/api/v1/synthetics/tests/api/abc-def-ghi":
+						assert.Equal(t, http.MethodPut, r.Method)
+						writeSyntheticTestResponse(w, "abc-def-ghi", datadogV1.SYNTHETICSTESTPAUSESTATUS_LIVE)
+					case "/api/v1/synthetics/tests/abc-def-ghi/status":
+						assert.Equal(t, http.MethodPut, r.Method)
+						body, _ := io.ReadAll(r.Body)
+						assert.Contains(t, string(body), `"new_status":"paused"`)
+						w.Header().Set("Content-Type", "application/json")
+						_, _ = w.Write([]byte("true"))
+					default:
+						t.Errorf("unexpected call to the Datadog API: %s %s", r.Method, r.URL.Path)
+					}
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkTest: func(t *testing.T, test *v1alpha1.DatadogSyntheticTest) {
+				assert.Equal(t, "abc-def-ghi", test.Status.ID)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestPauseStatusPaused, test.Status.TestStatus)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestSyncStatusOK, test.Status.SyncStatus)
+			},
+		},
+		{
+			name: "Create the synthetic test again when it's deleted outside Kubernetes",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), syncedSyntheticTest(t, time.Now().Add(-2*defaultForceSyncPeriod)))
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodGet && !strings.HasSuffix(r.URL.Path, "/results") {
+						http.Error(w, `{"errors": ["Synthetics test not found"]}`, http.StatusNotFound)
+						return
+					}
+					if r.Method == http.MethodGet {
+						writeLatestResultsResponse(w)
+						return
+					}
+					assert.Equal(t, http.MethodPost, r.Method)
+					writeSyntheticTestResponse(w, "jkl-mno-pqr", datadogV1.SYNTHETICSTESTPAUSESTATUS_LIVE)
+				}
+			},
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			checkTest: func(t *testing.T, test *v1alpha1.DatadogSyntheticTest) {
+				assert.Equal(t, "jkl-mno-pqr", test.Status.ID)
+			},
+		},
+		{
+			name: "Set validation error on invalid synthetic test",
+			mockOn: func(t *testing.T, m *mockedFields) {
+				test := defaultSyntheticTest()
+				test.Spec.Request = nil
+				_ = m.k8sClient.Create(context.TODO(), test)
+			},
+			datadogClientHandler: func(t *testing.T) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					t.Errorf("unexpected call to the Datadog API: %s %s", r.Method, r.URL.Path)
+				}
+			},
+			expectedResult: ctrl.Result{},
+			checkTest: func(t *testing.T, test *v1alpha1.DatadogSyntheticTest) {
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestSyncStatusValidateError, test.Status.SyncStatus)
+			},
+		},
+	}
+
+	// Iterate through test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(tt.datadogClientHandler(t))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV1.NewSyntheticsApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			m := mockedFields{
+				k8sClient: fake.NewClientBuilder().WithStatusSubresource(&v1alpha1.DatadogSyntheticTest{}).Build(),
+			}
+			if tt.mockOn != nil {
+				tt.mockOn(t, &m)
+			}
+			recorder := record.NewFakeRecorder(5)
+			ddClient := datadogclient.DatadogSyntheticTestClient{Client: client, Auth: testAuth}
+			r := NewReconciler(m.k8sClient, ddClient, &version.Info{}, testLogger, recorder, "", utils.NamespaceTags{})
+
+			res, _ := r.Reconcile(ctx, request)
+			assert.Equal(t, tt.expectedResult, res)
+
+			if tt.checkTest != nil {
+				test := &v1alpha1.DatadogSyntheticTest{}
+				require.NoError(t, m.k8sClient.Get(ctx, request.NamespacedName, test))
+				tt.checkTest(t, test)
+			}
+		})
+	}
+}
+
+func defaultSyntheticTest() *v1alpha1.DatadogSyntheticTest {
+	return &v1alpha1.DatadogSyntheticTest{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogSyntheticTest",
+			APIVersion: fmt.Sprintf("%s/%s", v1alpha1.GroupVersion.Group, v1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+		Spec: v1alpha1.DatadogSyntheticTestSpec{
+			Name:      "Example test",
+			Subtype:   v1alpha1.DatadogSyntheticTestSubtypeHTTP,
+			Locations: []string{"aws:eu-west-1"},
+			Tags:      []string{"generated:kubernetes"},
+			Request: &v1alpha1.DatadogSyntheticTestRequest{
+				Method: "GET",
+				URL:    "https://www.example.com",
+			},
+			Assertions: []v1alpha1.DatadogSyntheticTestAssertion{
+				{Type: "statusCode", Operator: "is", Target: "200"},
+			},
+		},
+	}
+}
+
+// syncedSyntheticTest returns a DatadogSyntheticTest whose spec was synced with Datadog at the given time
+func syncedSyntheticTest(t *testing.T, lastSync time.Time) *v1alpha1.DatadogSyntheticTest {
+	test := defaultSyntheticTest()
+	hash, err := comparison.GenerateMD5ForSpec(&test.Spec)
+	require.NoError(t, err)
+	lastSyncTime := metav1.NewTime(lastSync)
+	test.Status = v1alpha1.DatadogSyntheticTestStatus{
+		ID:                "abc-def-ghi",
+		MonitorID:         1234,
+		TestStatus:        v1alpha1.DatadogSyntheticTestPauseStatusLive,
+		CurrentHash:       hash,
+		LastForceSyncTime: &lastSyncTime,
+	}
+	return test
+}
+
+func writeSyntheticTestResponse(w http.ResponseWriter, publicID string, status datadogV1.SyntheticsTestPauseStatus) {
+	w.Header().Set("Content-Type", "application/json")
+	test := datadogV1.NewSyntheticsAPITest(datadogV1.SyntheticsAPITestConfig{}, []string{"aws:eu-west-1"}, "", "Example test", datadogV1.SyntheticsTestOptions{}, datadogV1.SYNTHETICSAPITESTTYPE_API)
+	test.SetPublicId(publicID)
+	test.SetMonitorId(1234)
+	test.SetStatus(status)
+	_ = json.NewEncoder(w).Encode(test)
+}
+
+func writeLatestResultsResponse(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(datadogV1.SyntheticsGetAPITestLatestResultsResponse{
+		Results: []datadogV1.SyntheticsAPITestResultShort{
+			{
+				CheckTime: datadogapi.PtrFloat64(1714557600000),
+				ProbeDc:   datadogapi.PtrString("aws:us-east-1"),
+				ResultId:  datadogapi.PtrString("result-1"),
+				Result:    &datadogV1.SyntheticsAPITestResultShortResult{Passed: datadogapi.PtrBool(true)},
+			},
+			{
+				CheckTime: datadogapi.PtrFloat64(1714557660000),
+				ProbeDc:   datadogapi.PtrString("aws:eu-west-1"),
+				ResultId:  datadogapi.PtrString("result-2"),
+				Result:    &datadogV1.SyntheticsAPITestResultShortResult{Passed: datadogapi.PtrBool(false)},
+			},
+		},
+	})
+}
+
+func setupTestAuth(apiURL string) context.Context {
+	testAuth := context.WithValue(
+		context.Background(),
+		datadogapi.ContextAPIKeys,
+		map[string]datadogapi.APIKey{
+			"apiKeyAuth": {
+				Key: "DUMMY_API_KEY",
+			},
+			"appKeyAuth": {
+				Key: "DUMMY_APP_KEY",
+			},
+		},
+	)
+	parsedAPIURL, _ := url.Parse(apiURL)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerIndex, 1)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerVariables, map[string]string{
+		"name":     parsedAPIURL.Host,
+		"protocol": parsedAPIURL.Scheme,
+	})
+
+	return testAuth
+}
@@ -0,0 +1,281 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/internal/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+// numericAssertionTypes are the assertion types whose target is sent to Datadog as a number.
+var numericAssertionTypes = map[string]bool{
+	string(datadogV1.SYNTHETICSASSERTIONTYPE_STATUS_CODE):            true,
+	string(datadogV1.SYNTHETICSASSERTIONTYPE_RESPONSE_TIME):          true,
+	string(datadogV1.SYNTHETICSASSERTIONTYPE_CERTIFICATE):            true,
+	string(datadogV1.SYNTHETICSASSERTIONTYPE_LATENCY):                true,
+	string(datadogV1.SYNTHETICSASSERTIONTYPE_PACKET_LOSS_PERCENTAGE): true,
+	string(datadogV1.SYNTHETICSASSERTIONTYPE_PACKETS_RECEIVED):       true,
+	string(datadogV1.SYNTHETICSASSERTIONTYPE_NETWORK_HOP):            true,
+}
+
+// buildSyntheticsAPITest builds the API test create and update request of a DatadogSyntheticTest.
+func buildSyntheticsAPITest(crdTest *v1alpha1.DatadogSyntheticTest) *datadogV1.SyntheticsAPITest {
+	spec := crdTest.Spec
+
+	config := datadogV1.SyntheticsAPITestConfig{}
+	if spec.Subtype == v1alpha1.DatadogSyntheticTestSubtypeMulti {
+		steps := make([]datadogV1.SyntheticsAPIStep, 0, len(spec.Steps))
+		for _, step := range spec.Steps {
+			apiStep := datadogV1.NewSyntheticsAPITestStep(buildAssertions(step.Assertions), step.Name, buildRequest(&step.Request), datadogV1.SYNTHETICSAPITESTSTEPSUBTYPE_HTTP)
+			if step.AllowFailure != nil {
+				apiStep.SetAllowFailure(*step.AllowFailure)
+			}
+			if step.IsCritical != nil {
+				apiStep.SetIsCritical(*step.IsCritical)
+			}
+			steps = append(steps, datadogV1.SyntheticsAPITestStepAsSyntheticsAPIStep(apiStep))
+		}
+		config.SetSteps(steps)
+	} else {
+		if spec.Request != nil {
+			config.SetRequest(buildRequest(spec.Request))
+		}
+		config.SetAssertions(buildAssertions(spec.Assertions))
+	}
+
+	test := datadogV1.NewSyntheticsAPITest(config, spec.Locations, spec.Message, spec.Name, buildOptions(spec.Options), datadogV1.SYNTHETICSAPITESTTYPE_API)
+	test.SetSubtype(datadogV1.SyntheticsTestDetailsSubType(spec.Subtype))
+	test.SetStatus(desiredPauseStatus(crdTest))
+	test.SetTags(spec.Tags)
+
+	return test
+}
+
+func buildRequest(request *v1alpha1.DatadogSyntheticTestRequest) datadogV1.SyntheticsTestRequest {
+	apiRequest := datadogV1.NewSyntheticsTestRequest()
+	if request.Method != "" {
+		apiRequest.SetMethod(request.Method)
+	}
+	if request.URL != "" {
+		apiRequest.SetUrl(request.URL)
+	}
+	if len(request.Headers) > 0 {
+		apiRequest.SetHeaders(request.Headers)
+	}
+	if request.Body != "" {
+		apiRequest.SetBody(request.Body)
+	}
+	if request.FollowRedirects != nil {
+		apiRequest.SetFollowRedirects(*request.FollowRedirects)
+	}
+	if request.Host != "" {
+		apiRequest.SetHost(request.Host)
+	}
+	if request.Port != nil {
+		apiRequest.SetPort(*request.Port)
+	}
+	if request.DNSServer != "" {
+		apiRequest.SetDnsServer(request.DNSServer)
+	}
+	if request.DNSServerPort != nil {
+		apiRequest.SetDnsServerPort(*request.DNSServerPort)
+	}
+	if request.Timeout != nil {
+		apiRequest.SetTimeout(float64(*request.Timeout))
+	}
+
+	return *apiRequest
+}
+
+// buildAssertions builds the assertions of a request, an assertion with a JSON path validates the value at this path
+// of the response body.
+func buildAssertions(assertions []v1alpha1.DatadogSyntheticTestAssertion) []datadogV1.SyntheticsAssertion {
+	apiAssertions := make([]datadogV1.SyntheticsAssertion, 0, len(assertions))
+	for _, assertion := range assertions {
+		assertionType := datadogV1.SyntheticsAssertionType(assertion.Type)
+
+		if assertion.JSONPath != "" {
+			target := datadogV1.SyntheticsAssertionJSONPathTargetTarget{}
+			target.SetJsonPath(assertion.JSONPath)
+			target.SetOperator(assertion.Operator)
+			target.TargetValue = assertionTarget(assertion.Type, assertion.Target)
+			apiAssertion := datadogV1.NewSyntheticsAssertionJSONPathTarget(datadogV1.SYNTHETICSASSERTIONJSONPATHOPERATOR_VALIDATES_JSON_PATH, assertionType)
+			apiAssertion.SetTarget(target)
+			if assertion.Property != "" {
+				apiAssertion.SetProperty(assertion.Property)
+			}
+			apiAssertions = append(apiAssertions, datadogV1.SyntheticsAssertionJSONPathTargetAsSyntheticsAssertion(apiAssertion))
+			continue
+		}
+
+		apiAssertion := datadogV1.NewSyntheticsAssertionTarget(datadogV1.SyntheticsAssertionOperator(assertion.Operator), assertionTarget(assertion.Type, assertion.Target), assertionType)
+		if assertion.Property != "" {
+			apiAssertion.SetProperty(assertion.Property)
+		}
+		apiAssertions = append(apiAssertions, datadogV1.SyntheticsAssertionTargetAsSyntheticsAssertion(apiAssertion))
+	}
+
+	return apiAssertions
+}
+
+// assertionTarget returns the target of an assertion, as a number for the assertion types with a numeric target.
+func assertionTarget(assertionType, target string) interface{} {
+	if numericAssertionTypes[assertionType] {
+		if value, err := strconv.ParseFloat(target, 64); err == nil {
+			return value
+		}
+	}
+
+	return target
+}
+
+func buildOptions(options *v1alpha1.DatadogSyntheticTestOptions) datadogV1.SyntheticsTestOptions {
+	apiOptions := datadogV1.SyntheticsTestOptions{}
+	if options == nil {
+		return apiOptions
+	}
+
+	if options.TickEvery != nil {
+		apiOptions.SetTickEvery(*options.TickEvery)
+	}
+	if options.MinFailureDuration != nil {
+		apiOptions.SetMinFailureDuration(*options.MinFailureDuration)
+	}
+	if options.MinLocationFailed != nil {
+		apiOptions.SetMinLocationFailed(*options.MinLocationFailed)
+	}
+	if options.RetryCount != nil || options.RetryInterval != nil {
+		retry := datadogV1.SyntheticsTestOptionsRetry{}
+		if options.RetryCount != nil {
+			retry.SetCount(*options.RetryCount)
+		}
+		if options.RetryInterval != nil {
+			retry.SetInterval(float64(*options.RetryInterval))
+		}
+		apiOptions.SetRetry(retry)
+	}
+	if options.AcceptSelfSigned != nil {
+		apiOptions.SetAcceptSelfSigned(*options.AcceptSelfSigned)
+	}
+	if options.MonitorPriority != nil {
+		apiOptions.SetMonitorPriority(*options.MonitorPriority)
+	}
+
+	return apiOptions
+}
+
+func desiredPauseStatus(crdTest *v1alpha1.DatadogSyntheticTest) datadogV1.SyntheticsTestPauseStatus {
+	if crdTest.Spec.Paused != nil && *crdTest.Spec.Paused {
+		return datadogV1.SYNTHETICSTESTPAUSESTATUS_PAUSED
+	}
+	return datadogV1.SYNTHETICSTESTPAUSESTATUS_LIVE
+}
+
+// latestResult returns the most recent of the latest results of a test, or nil if the test hasn't run yet.
+func latestResult(results []datadogV1.SyntheticsAPITestResultShort) *v1alpha1.DatadogSyntheticTestResult {
+	var latest *datadogV1.SyntheticsAPITestResultShort
+	for i := range results {
+		if latest == nil || results[i].GetCheckTime() > latest.GetCheckTime() {
+			latest = &results[i]
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+
+	status := v1alpha1.DatadogSyntheticTestResultStatusFailed
+	if result, ok := latest.GetResultOk(); ok && result.GetPassed() {
+		status = v1alpha1.DatadogSyntheticTestResultStatusPassed
+	}
+	checkTime := metav1.NewTime(time.UnixMilli(int64(latest.GetCheckTime())))
+
+	return &v1alpha1.DatadogSyntheticTestResult{
+		ID:        latest.GetResultId(),
+		Status:    status,
+		Location:  latest.GetProbeDc(),
+		CheckTime: &checkTime,
+	}
+}
+
+func createSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, crdTest *v1alpha1.DatadogSyntheticTest) (datadogV1.SyntheticsAPITest, error) {
+	test, _, err := client.CreateSyntheticsAPITest(auth, *buildSyntheticsAPITest(crdTest))
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, datadogclient.TranslateClientError(err, "error creating synthetic test")
+	}
+
+	return test, nil
+}
+
+func getSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, publicID string) (datadogV1.SyntheticsAPITest, error) {
+	test, _, err := client.GetAPITest(auth, publicID)
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, datadogclient.TranslateClientError(err, "error getting synthetic test")
+	}
+
+	return test, nil
+}
+
+func getSyntheticTestLatestResults(auth context.Context, client *datadogV1.SyntheticsApi, publicID string) ([]datadogV1.SyntheticsAPITestResultShort, error) {
+	results, _, err := client.GetAPITestLatestResults(auth, publicID)
+	if err != nil {
+		return nil, datadogclient.TranslateClientError(err, "error getting synthetic test latest results")
+	}
+
+	return results.GetResults(), nil
+}
+
+func updateSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, crdTest *v1alpha1.DatadogSyntheticTest) (datadogV1.SyntheticsAPITest, error) {
+	test, _, err := client.UpdateAPITest(auth, crdTest.Status.ID, *buildSyntheticsAPITest(crdTest))
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, datadogclient.TranslateClientError(err, "error updating synthetic test")
+	}
+
+	return test, nil
+}
+
+func updateSyntheticTestPauseStatus(auth context.Context, client *datadogV1.SyntheticsApi, publicID string, status datadogV1.SyntheticsTestPauseStatus) error {
+	payload := datadogV1.SyntheticsUpdateTestPauseStatusPayload{}
+	payload.SetNewStatus(status)
+	if _, _, err := client.UpdateTestPauseStatus(auth, publicID, payload); err != nil {
+		return datadogclient.TranslateClientError(err, "error updating synthetic test status")
+	}
+	return nil
+}
+
+// orphanSyntheticTest tags the test as no longer managed by the operator. The test is updated from its current state
+// in Datadog, so that it's left as it is otherwise.
+func orphanSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, publicID string) error {
+	test, err := getSyntheticTest(auth, client, publicID)
+	if err != nil {
+		return err
+	}
+	tags, changed := utils.AddOrphanedTag(test.GetTags())
+	if !changed {
+		return nil
+	}
+
+	test.SetTags(tags)
+	if _, _, err = client.UpdateAPITest(auth, publicID, test); err != nil {
+		return datadogclient.TranslateClientError(err, "error orphaning synthetic test")
+	}
+	return nil
+}
+
+func deleteSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, publicID string) error {
+	payload := datadogV1.SyntheticsDeleteTestsPayload{PublicIds: []string{publicID}}
+	if _, _, err := client.DeleteTests(auth, payload); err != nil {
+		return datadogclient.TranslateClientError(err, "error deleting synthetic test")
+	}
+	return nil
+}
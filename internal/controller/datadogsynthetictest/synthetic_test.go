@@ -0,0 +1,145 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+)
+
+func Test_buildSyntheticsAPITest(t *testing.T) {
+	port := int64(443)
+	tickEvery := int64(60)
+	retryCount := int64(2)
+
+	tests := []struct {
+		name  string
+		spec  v1alpha1.DatadogSyntheticTestSpec
+		check func(t *testing.T, test *datadogV1.SyntheticsAPITest)
+	}{
+		{
+			name: "ssl test with options",
+			spec: v1alpha1.DatadogSyntheticTestSpec{
+				Name:      "Certificate",
+				Subtype:   v1alpha1.DatadogSyntheticTestSubtypeSSL,
+				Locations: []string{"aws:eu-west-1"},
+				Paused:    apiutils.NewBoolPointer(true),
+				Request:   &v1alpha1.DatadogSyntheticTestRequest{Host: "example.com", Port: &port},
+				Assertions: []v1alpha1.DatadogSyntheticTestAssertion{
+					{Type: "certificate", Operator: "isInMoreThan", Target: "30"},
+				},
+				Options: &v1alpha1.DatadogSyntheticTestOptions{TickEvery: &tickEvery, RetryCount: &retryCount, AcceptSelfSigned: apiutils.NewBoolPointer(true)},
+			},
+			check: func(t *testing.T, test *datadogV1.SyntheticsAPITest) {
+				assert.Equal(t, datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_SSL, test.GetSubtype())
+				assert.Equal(t, datadogV1.SYNTHETICSTESTPAUSESTATUS_PAUSED, test.GetStatus())
+				assert.Equal(t, "example.com", test.Config.Request.GetHost())
+				assert.Equal(t, port, test.Config.Request.GetPort())
+				require.Len(t, test.Config.Assertions, 1)
+				assert.Equal(t, float64(30), test.Config.Assertions[0].SyntheticsAssertionTarget.Target)
+				assert.Equal(t, tickEvery, test.Options.GetTickEvery())
+				assert.Equal(t, retryCount, test.Options.Retry.GetCount())
+				assert.False(t, test.Options.Retry.HasInterval())
+				assert.True(t, test.Options.GetAcceptSelfSigned())
+			},
+		},
+		{
+			name: "http test with header and JSON path assertions",
+			spec: v1alpha1.DatadogSyntheticTestSpec{
+				Name:      "Health",
+				Subtype:   v1alpha1.DatadogSyntheticTestSubtypeHTTP,
+				Locations: []string{"aws:eu-west-1"},
+				Request:   &v1alpha1.DatadogSyntheticTestRequest{Method: "GET", URL: "https://example.com/health"},
+				Assertions: []v1alpha1.DatadogSyntheticTestAssertion{
+					{Type: "header", Property: "content-type", Operator: "contains", Target: "json"},
+					{Type: "body", JSONPath: "$.status", Operator: "is", Target: "ok"},
+				},
+			},
+			check: func(t *testing.T, test *datadogV1.SyntheticsAPITest) {
+				assert.Equal(t, datadogV1.SYNTHETICSTESTPAUSESTATUS_LIVE, test.GetStatus())
+				require.Len(t, test.Config.Assertions, 2)
+				header := test.Config.Assertions[0].SyntheticsAssertionTarget
+				require.NotNil(t, header)
+				assert.Equal(t, "content-type", header.GetProperty())
+				assert.Equal(t, "json", header.Target)
+				jsonPath := test.Config.Assertions[1].SyntheticsAssertionJSONPathTarget
+				require.NotNil(t, jsonPath)
+				assert.Equal(t, datadogV1.SYNTHETICSASSERTIONJSONPATHOPERATOR_VALIDATES_JSON_PATH, jsonPath.Operator)
+				assert.Equal(t, "$.status", jsonPath.Target.GetJsonPath())
+				assert.Equal(t, "is", jsonPath.Target.GetOperator())
+				assert.Equal(t, "ok", jsonPath.Target.TargetValue)
+			},
+		},
+		{
+			name: "multistep test",
+			spec: v1alpha1.DatadogSyntheticTestSpec{
+				Name:      "Checkout",
+				Subtype:   v1alpha1.DatadogSyntheticTestSubtypeMulti,
+				Locations: []string{"aws:eu-west-1"},
+				Steps: []v1alpha1.DatadogSyntheticTestStep{
+					{
+						Name:         "Login",
+						Request:      v1alpha1.DatadogSyntheticTestRequest{Method: "POST", URL: "https://example.com/login"},
+						Assertions:   []v1alpha1.DatadogSyntheticTestAssertion{{Type: "statusCode", Operator: "is", Target: "200"}},
+						AllowFailure: apiutils.NewBoolPointer(true),
+					},
+					{
+						Name:       "Cart",
+						Request:    v1alpha1.DatadogSyntheticTestRequest{Method: "GET", URL: "https://example.com/cart"},
+						Assertions: []v1alpha1.DatadogSyntheticTestAssertion{{Type: "responseTime", Operator: "lessThan", Target: "500"}},
+					},
+				},
+			},
+			check: func(t *testing.T, test *datadogV1.SyntheticsAPITest) {
+				assert.Equal(t, datadogV1.SYNTHETICSTESTDETAILSSUBTYPE_MULTI, test.GetSubtype())
+				assert.Nil(t, test.Config.Request)
+				require.Len(t, test.Config.Steps, 2)
+				login := test.Config.Steps[0].SyntheticsAPITestStep
+				require.NotNil(t, login)
+				assert.Equal(t, "Login", login.Name)
+				assert.Equal(t, datadogV1.SYNTHETICSAPITESTSTEPSUBTYPE_HTTP, login.Subtype)
+				assert.Equal(t, "https://example.com/login", login.Request.GetUrl())
+				assert.True(t, login.GetAllowFailure())
+				assert.Equal(t, "Cart", test.Config.Steps[1].SyntheticsAPITestStep.Name)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiTest := buildSyntheticsAPITest(&v1alpha1.DatadogSyntheticTest{Spec: test.spec})
+			assert.Equal(t, test.spec.Name, apiTest.Name)
+			assert.Equal(t, datadogV1.SYNTHETICSAPITESTTYPE_API, apiTest.Type)
+			test.check(t, apiTest)
+		})
+	}
+}
+
+func Test_latestResult(t *testing.T) {
+	assert.Nil(t, latestResult(nil))
+
+	passed := true
+	checkTime := float64(1714557600000)
+	result := latestResult([]datadogV1.SyntheticsAPITestResultShort{
+		{
+			CheckTime: &checkTime,
+			ProbeDc:   apiutils.NewStringPointer("aws:eu-west-1"),
+			ResultId:  apiutils.NewStringPointer("result-1"),
+			Result:    &datadogV1.SyntheticsAPITestResultShortResult{Passed: &passed},
+		},
+	})
+	require.NotNil(t, result)
+	assert.Equal(t, "result-1", result.ID)
+	assert.Equal(t, v1alpha1.DatadogSyntheticTestResultStatusPassed, result.Status)
+	assert.Equal(t, "aws:eu-west-1", result.Location)
+	assert.Equal(t, int64(1714557600), result.CheckTime.Unix())
+}
@@ -8,9 +8,18 @@ package metrics
 const (
 	datadogAgentSubsystem        = "datadogagent"
 	datadogAgentProfileSubsystem = "datadogagentprofile"
+	datadogResourceSubsystem     = "datadogresource"
 
 	TrueValue  = 1.0
 	FalseValue = 0.0
 
 	datadogAgentProfileLabelKey = "datadogagentprofile"
+	kindLabelKey                = "kind"
+	namespaceLabelKey           = "namespace"
+	nameLabelKey                = "name"
+	operationLabelKey           = "operation"
+	resultLabelKey              = "result"
+
+	SuccessResult = "success"
+	ErrorResult   = "error"
 )
@@ -0,0 +1,70 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// Datadog API requests made to sync the custom resources backed by Datadog resources
+	DatadogResourceRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: datadogResourceSubsystem,
+			Name:      "api_requests_total",
+			Help:      "Number of Datadog API requests made to sync the Datadog resources, by kind, operation and result",
+		},
+		[]string{
+			kindLabelKey,
+			operationLabelKey,
+			resultLabelKey,
+		},
+	)
+
+	// custom resource in sync with Datadog
+	DatadogResourceSynced = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: datadogResourceSubsystem,
+			Name:      "synced",
+			Help:      "1 if the custom resource is in sync with Datadog. 0 if its last sync failed",
+		},
+		[]string{
+			kindLabelKey,
+			namespaceLabelKey,
+			nameLabelKey,
+		},
+	)
+
+	// custom resource differing from the Datadog resource
+	DatadogResourceDrifted = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: datadogResourceSubsystem,
+			Name:      "drifted",
+			Help:      "1 if the Datadog resource was changed outside of Kubernetes and differs from the custom resource. 0 otherwise",
+		},
+		[]string{
+			kindLabelKey,
+			namespaceLabelKey,
+			nameLabelKey,
+		},
+	)
+)
+
+func init() {
+	// Register custom metrics with the global prometheus registry
+	metrics.Registry.MustRegister(DatadogResourceRequests)
+	metrics.Registry.MustRegister(DatadogResourceSynced)
+	metrics.Registry.MustRegister(DatadogResourceDrifted)
+}
+
+// CleanupMetricsByDatadogResource deletes the prometheus metrics of a custom resource backed by a Datadog resource
+func CleanupMetricsByDatadogResource(kind string, obj client.Object) {
+	labels := prometheus.Labels{kindLabelKey: kind, namespaceLabelKey: obj.GetNamespace(), nameLabelKey: obj.GetName()}
+	DatadogResourceSynced.Delete(labels)
+	DatadogResourceDrifted.Delete(labels)
+}
@@ -6,15 +6,20 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
 	"github.com/DataDog/datadog-operator/internal/controller/datadogagent"
 	componentagent "github.com/DataDog/datadog-operator/internal/controller/datadogagent/component/agent"
+	ctrlutils "github.com/DataDog/datadog-operator/internal/controller/utils"
 
 	"github.com/DataDog/datadog-operator/pkg/config"
 	"github.com/DataDog/datadog-operator/pkg/datadogclient"
@@ -28,11 +33,15 @@ import (
 )
 
 const (
-	agentControllerName     = "DatadogAgent"
-	monitorControllerName   = "DatadogMonitor"
-	sloControllerName       = "DatadogSLO"
-	profileControllerName   = "DatadogAgentProfile"
-	dashboardControllerName = "DatadogDashboard"
+	agentControllerName           = "DatadogAgent"
+	monitorControllerName         = "DatadogMonitor"
+	sloControllerName             = "DatadogSLO"
+	sloCorrectionControllerName   = "DatadogSLOCorrection"
+	syntheticTestControllerName   = "DatadogSyntheticTest"
+	profileControllerName         = "DatadogAgentProfile"
+	dashboardControllerName       = "DatadogDashboard"
+	downtimeControllerName        = "DatadogDowntime"
+	monitorTemplateControllerName = "DatadogMonitorTemplate"
 )
 
 // SetupOptions defines options for setting up controllers to ease testing
@@ -50,6 +59,14 @@ type SetupOptions struct {
 	ProcessChecksInCoreAgentEnabled bool
 	OtelAgentEnabled                bool
 	DatadogDashboardEnabled         bool
+	DatadogSLOCorrectionEnabled     bool
+	DatadogSyntheticTestEnabled     bool
+	DatadogDowntimeEnabled          bool
+	DatadogMonitorTemplateEnabled   bool
+	ClusterName                     string
+	DatadogDeletionPolicy           v1alpha1.DeletionPolicy
+	NamespaceLabelsAsTags           map[string]string
+	NamespaceAnnotationsAsTags      map[string]string
 }
 
 // ExtendedDaemonsetOptions defines ExtendedDaemonset options
@@ -70,11 +87,15 @@ type ExtendedDaemonsetOptions struct {
 type starterFunc func(logr.Logger, manager.Manager, *version.Info, kubernetes.PlatformInfo, SetupOptions) error
 
 var controllerStarters = map[string]starterFunc{
-	agentControllerName:     startDatadogAgent,
-	monitorControllerName:   startDatadogMonitor,
-	sloControllerName:       startDatadogSLO,
-	profileControllerName:   startDatadogAgentProfiles,
-	dashboardControllerName: startDatadogDashboard,
+	agentControllerName:           startDatadogAgent,
+	monitorControllerName:         startDatadogMonitor,
+	sloControllerName:             startDatadogSLO,
+	sloCorrectionControllerName:   startDatadogSLOCorrection,
+	syntheticTestControllerName:   startDatadogSyntheticTest,
+	profileControllerName:         startDatadogAgentProfiles,
+	dashboardControllerName:       startDatadogDashboard,
+	downtimeControllerName:        startDatadogDowntime,
+	monitorTemplateControllerName: startDatadogMonitorTemplate,
 }
 
 // SetupControllers starts all controllers (also used by e2e tests)
@@ -105,6 +126,8 @@ func SetupControllers(logger logr.Logger, mgr manager.Manager, options SetupOpti
 		return fmt.Errorf("unable to get API resource versions: %w", err)
 	}
 	platformInfo := kubernetes.NewPlatformInfo(versionInfo, groups, resources)
+	platformInfo.SetDistribution(kubernetes.DetectDistribution(versionInfo, groups, getDistributionNodes(logger, mgr)))
+	logger.Info("Detected Kubernetes distribution", "distribution", platformInfo.GetDistribution())
 
 	for controller, starter := range controllerStarters {
 		if err := starter(logger, mgr, versionInfo, platformInfo, options); err != nil {
@@ -126,6 +149,17 @@ func getServerGroupsAndResources(log logr.Logger, discoveryClient *discovery.Dis
 	return groups, resources, nil
 }
 
+// getDistributionNodes returns a sample of nodes used to detect the Kubernetes distribution.
+// Failing to list nodes isn't fatal, the detection then relies on discovery data only.
+func getDistributionNodes(log logr.Logger, mgr manager.Manager) []corev1.Node {
+	nodeList := &corev1.NodeList{}
+	if err := mgr.GetAPIReader().List(context.TODO(), nodeList, client.Limit(1)); err != nil {
+		log.V(1).Info("Unable to list nodes to detect the Kubernetes distribution", "err", err)
+		return nil
+	}
+	return nodeList.Items
+}
+
 func startDatadogAgent(logger logr.Logger, mgr manager.Manager, vInfo *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
 	if !options.DatadogAgentEnabled {
 		logger.Info("Feature disabled, not starting the controller", "controller", agentControllerName)
@@ -163,6 +197,14 @@ func startDatadogAgent(logger logr.Logger, mgr manager.Manager, vInfo *version.I
 	}).SetupWithManager(mgr)
 }
 
+// namespaceTags returns the mapping of the namespace labels and annotations to the tags of the Datadog objects.
+func namespaceTags(options SetupOptions) ctrlutils.NamespaceTags {
+	return ctrlutils.NamespaceTags{
+		LabelsAsTags:      options.NamespaceLabelsAsTags,
+		AnnotationsAsTags: options.NamespaceAnnotationsAsTags,
+	}
+}
+
 func startDatadogMonitor(logger logr.Logger, mgr manager.Manager, vInfo *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
 	if !options.DatadogMonitorEnabled {
 		logger.Info("Feature disabled, not starting the controller", "controller", monitorControllerName)
@@ -176,12 +218,15 @@ func startDatadogMonitor(logger logr.Logger, mgr manager.Manager, vInfo *version
 	}
 
 	return (&DatadogMonitorReconciler{
-		Client:      mgr.GetClient(),
-		DDClient:    ddClient,
-		VersionInfo: vInfo,
-		Log:         ctrl.Log.WithName("controllers").WithName(monitorControllerName),
-		Scheme:      mgr.GetScheme(),
-		Recorder:    mgr.GetEventRecorderFor(monitorControllerName),
+		Client:         mgr.GetClient(),
+		APIReader:      mgr.GetAPIReader(),
+		DDClient:       ddClient,
+		VersionInfo:    vInfo,
+		Log:            ctrl.Log.WithName("controllers").WithName(monitorControllerName),
+		Scheme:         mgr.GetScheme(),
+		Recorder:       mgr.GetEventRecorderFor(monitorControllerName),
+		DeletionPolicy: options.DatadogDeletionPolicy,
+		NamespaceTags:  namespaceTags(options),
 	}).SetupWithManager(mgr)
 }
 
@@ -197,12 +242,16 @@ func startDatadogDashboard(logger logr.Logger, mgr manager.Manager, vInfo *versi
 	}
 
 	return (&DatadogDashboardReconciler{
-		Client:      mgr.GetClient(),
-		DDClient:    ddClient,
-		VersionInfo: vInfo,
-		Log:         ctrl.Log.WithName("controllers").WithName(dashboardControllerName),
-		Scheme:      mgr.GetScheme(),
-		Recorder:    mgr.GetEventRecorderFor(dashboardControllerName),
+		Client:         mgr.GetClient(),
+		APIReader:      mgr.GetAPIReader(),
+		DDClient:       ddClient,
+		VersionInfo:    vInfo,
+		Log:            ctrl.Log.WithName("controllers").WithName(dashboardControllerName),
+		Scheme:         mgr.GetScheme(),
+		Recorder:       mgr.GetEventRecorderFor(dashboardControllerName),
+		ClusterName:    options.ClusterName,
+		DeletionPolicy: options.DatadogDeletionPolicy,
+		NamespaceTags:  namespaceTags(options),
 	}).SetupWithManager(mgr)
 }
 
@@ -218,17 +267,110 @@ func startDatadogSLO(logger logr.Logger, mgr manager.Manager, info *version.Info
 	}
 
 	controller := &DatadogSLOReconciler{
-		Client:      mgr.GetClient(),
-		DDClient:    ddClient,
-		VersionInfo: info,
-		Log:         ctrl.Log.WithName("controllers").WithName(sloControllerName),
-		Scheme:      mgr.GetScheme(),
-		Recorder:    mgr.GetEventRecorderFor(sloControllerName),
+		Client:         mgr.GetClient(),
+		APIReader:      mgr.GetAPIReader(),
+		DDClient:       ddClient,
+		VersionInfo:    info,
+		Log:            ctrl.Log.WithName("controllers").WithName(sloControllerName),
+		Scheme:         mgr.GetScheme(),
+		Recorder:       mgr.GetEventRecorderFor(sloControllerName),
+		DeletionPolicy: options.DatadogDeletionPolicy,
+		NamespaceTags:  namespaceTags(options),
 	}
 
 	return controller.SetupWithManager(mgr)
 }
 
+func startDatadogSLOCorrection(logger logr.Logger, mgr manager.Manager, info *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
+	if !options.DatadogSLOCorrectionEnabled {
+		logger.Info("Feature disabled, not starting the controller", "controller", sloCorrectionControllerName)
+		return nil
+	}
+
+	ddClient, err := datadogclient.InitDatadogSLOCorrectionClient(logger, options.Creds)
+	if err != nil {
+		return fmt.Errorf("unable to create Datadog API Client: %w", err)
+	}
+
+	controller := &DatadogSLOCorrectionReconciler{
+		Client:         mgr.GetClient(),
+		DDClient:       ddClient,
+		VersionInfo:    info,
+		Log:            ctrl.Log.WithName("controllers").WithName(sloCorrectionControllerName),
+		Scheme:         mgr.GetScheme(),
+		Recorder:       mgr.GetEventRecorderFor(sloCorrectionControllerName),
+		DeletionPolicy: options.DatadogDeletionPolicy,
+	}
+
+	return controller.SetupWithManager(mgr)
+}
+
+func startDatadogSyntheticTest(logger logr.Logger, mgr manager.Manager, info *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
+	if !options.DatadogSyntheticTestEnabled {
+		logger.Info("Feature disabled, not starting the controller", "controller", syntheticTestControllerName)
+		return nil
+	}
+
+	ddClient, err := datadogclient.InitDatadogSyntheticTestClient(logger, options.Creds)
+	if err != nil {
+		return fmt.Errorf("unable to create Datadog API Client: %w", err)
+	}
+
+	controller := &DatadogSyntheticTestReconciler{
+		Client:         mgr.GetClient(),
+		DDClient:       ddClient,
+		VersionInfo:    info,
+		Log:            ctrl.Log.WithName("controllers").WithName(syntheticTestControllerName),
+		Scheme:         mgr.GetScheme(),
+		Recorder:       mgr.GetEventRecorderFor(syntheticTestControllerName),
+		DeletionPolicy: options.DatadogDeletionPolicy,
+		NamespaceTags:  namespaceTags(options),
+	}
+
+	return controller.SetupWithManager(mgr)
+}
+
+func startDatadogDowntime(logger logr.Logger, mgr manager.Manager, info *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
+	if !options.DatadogDowntimeEnabled {
+		logger.Info("Feature disabled, not starting the controller", "controller", downtimeControllerName)
+		return nil
+	}
+
+	ddClient, err := datadogclient.InitDatadogDowntimeClient(logger, options.Creds)
+	if err != nil {
+		return fmt.Errorf("unable to create Datadog API Client: %w", err)
+	}
+
+	controller := &DatadogDowntimeReconciler{
+		Client:         mgr.GetClient(),
+		DDClient:       ddClient,
+		VersionInfo:    info,
+		Log:            ctrl.Log.WithName("controllers").WithName(downtimeControllerName),
+		Scheme:         mgr.GetScheme(),
+		Recorder:       mgr.GetEventRecorderFor(downtimeControllerName),
+		DeletionPolicy: options.DatadogDeletionPolicy,
+	}
+
+	return controller.SetupWithManager(mgr)
+}
+
+func startDatadogMonitorTemplate(logger logr.Logger, mgr manager.Manager, info *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
+	if !options.DatadogMonitorTemplateEnabled {
+		logger.Info("Feature disabled, not starting the controller", "controller", monitorTemplateControllerName)
+		return nil
+	}
+	if !options.DatadogMonitorEnabled {
+		logger.Info("The DatadogMonitor controller is disabled, the DatadogMonitors created from templates won't be synced with Datadog", "controller", monitorTemplateControllerName)
+	}
+
+	return (&DatadogMonitorTemplateReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName(monitorTemplateControllerName),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor(monitorTemplateControllerName),
+	}).SetupWithManager(mgr)
+}
+
 func startDatadogAgentProfiles(logger logr.Logger, mgr manager.Manager, vInfo *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
 	if !options.DatadogAgentProfileEnabled {
 		logger.Info("Feature disabled, not starting the controller", "controller", profileControllerName)
@@ -14,6 +14,7 @@ package controller
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/DataDog/datadog-operator/internal/controller/testutils"
 
 	"github.com/DataDog/datadog-operator/pkg/config"
+	"github.com/DataDog/datadog-operator/pkg/testutils/fakedatadog"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -49,6 +51,8 @@ var (
 	k8sClient client.Client
 	testEnv   *envtest.Environment
 	mgrCancel context.CancelFunc
+	// fakeDatadog is the fake Datadog API the Datadog API resource controllers run against.
+	fakeDatadog *fakedatadog.Server
 )
 
 func TestAPIs(t *testing.T) {
@@ -96,6 +100,10 @@ var _ = BeforeSuite(func() {
 	node2 := testutils.NewNode("node2", nil)
 	Expect(k8sClient.Create(context.Background(), node2)).Should(Succeed())
 
+	// Start the fake Datadog API before the controllers, so that their Datadog clients use it
+	fakeDatadog = fakedatadog.NewServer()
+	Expect(os.Setenv(config.DDURLEnvVar, fakeDatadog.URL)).To(Succeed())
+
 	// Start controllers
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme: scheme.Scheme,
@@ -108,6 +116,8 @@ var _ = BeforeSuite(func() {
 		Creds:                      config.Creds{APIKey: "dummy_api_key", AppKey: "dummy_app_key"},
 		DatadogAgentEnabled:        true,
 		DatadogMonitorEnabled:      true,
+		DatadogSLOEnabled:          true,
+		DatadogDashboardEnabled:    true,
 		DatadogAgentProfileEnabled: true,
 		V2APIEnabled:               true,
 	}
@@ -131,4 +141,7 @@ var _ = AfterSuite(func() {
 	}
 	err := testEnv.Stop()
 	Expect(err).ToNot(HaveOccurred())
+	if fakeDatadog != nil {
+		fakeDatadog.Close()
+	}
 })
@@ -5,7 +5,19 @@
 
 package utils
 
-const requiredTag = "generated:kubernetes"
+import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	requiredTag = "generated:kubernetes"
+	// orphanedTag marks the Datadog objects left in Datadog when their custom resource is deleted, that are no longer
+	// managed by the operator.
+	orphanedTag = "orphaned:kubernetes"
+)
 
 func GetRequiredTags() []string {
 	return []string{requiredTag}
@@ -28,3 +40,95 @@ func GetTagsToAdd(tags []string) []string {
 	}
 	return tagsToAdd
 }
+
+// AddOrphanedTag returns the tags with the tag marking a Datadog object as no longer managed by the operator, in place
+// of the tag of the objects it manages, and whether they changed.
+func AddOrphanedTag(tags []string) ([]string, bool) {
+	orphaned := make([]string, 0, len(tags)+1)
+	changed, found := false, false
+	for _, t := range tags {
+		switch t {
+		case requiredTag:
+			changed = true
+			continue
+		case orphanedTag:
+			found = true
+		}
+		orphaned = append(orphaned, t)
+	}
+	if !found {
+		orphaned = append(orphaned, orphanedTag)
+		changed = true
+	}
+	if !changed {
+		return tags, false
+	}
+	return orphaned, true
+}
+
+// AddOrphanedMarker returns the text ending with the orphaned tag, for the Datadog objects that don't accept it as a
+// tag, like the description of dashboards, and whether it was missing.
+func AddOrphanedMarker(text string) (string, bool) {
+	if strings.HasSuffix(text, orphanedTag) {
+		return text, false
+	}
+	if text == "" {
+		return orphanedTag, true
+	}
+	return text + "\n\n" + orphanedTag, true
+}
+
+// NamespaceTags maps the labels and annotations of namespaces to tags added to the Datadog objects of the namespace,
+// like the namespaceLabelsAsTags and namespaceAnnotationsAsTags options of the Agent.
+type NamespaceTags struct {
+	// LabelsAsTags maps namespace labels to tag keys.
+	LabelsAsTags map[string]string
+	// AnnotationsAsTags maps namespace annotations to tag keys.
+	AnnotationsAsTags map[string]string
+}
+
+// IsEmpty returns true if no label or annotation is mapped to a tag.
+func (n NamespaceTags) IsEmpty() bool {
+	return len(n.LabelsAsTags) == 0 && len(n.AnnotationsAsTags) == 0
+}
+
+// Tags returns the sorted tags of the mapped labels and annotations of the namespace.
+func (n NamespaceTags) Tags(namespace *corev1.Namespace) []string {
+	tags := []string{}
+	for label, key := range n.LabelsAsTags {
+		if value, found := namespace.Labels[label]; found {
+			tags = append(tags, key+":"+value)
+		}
+	}
+	for annotation, key := range n.AnnotationsAsTags {
+		if value, found := namespace.Annotations[annotation]; found {
+			tags = append(tags, key+":"+value)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// GetTagsWithNewKeys returns the tags of candidates whose keys aren't used by tags, so that the tags set explicitly
+// take precedence.
+func GetTagsWithNewKeys(tags, candidates []string) []string {
+	keys := map[string]bool{}
+	for _, t := range tags {
+		keys[TagKey(t)] = true
+	}
+
+	newTags := []string{}
+	for _, c := range candidates {
+		if !keys[TagKey(c)] {
+			keys[TagKey(c)] = true
+			newTags = append(newTags, c)
+		}
+	}
+	return newTags
+}
+
+// TagKey returns the key of a key:value tag, or the tag itself if it has no value.
+func TagKey(tag string) string {
+	key, _, _ := strings.Cut(tag, ":")
+	return key
+}
@@ -0,0 +1,175 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAddOrphanedTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        []string
+		wantTags    []string
+		wantChanged bool
+	}{
+		{
+			name:        "no tags",
+			tags:        nil,
+			wantTags:    []string{"orphaned:kubernetes"},
+			wantChanged: true,
+		},
+		{
+			name:        "tag missing",
+			tags:        []string{"env:prod"},
+			wantTags:    []string{"env:prod", "orphaned:kubernetes"},
+			wantChanged: true,
+		},
+		{
+			name:        "generated tag replaced",
+			tags:        []string{"generated:kubernetes", "env:prod"},
+			wantTags:    []string{"env:prod", "orphaned:kubernetes"},
+			wantChanged: true,
+		},
+		{
+			name:        "generated tag left with the orphaned tag",
+			tags:        []string{"generated:kubernetes", "orphaned:kubernetes"},
+			wantTags:    []string{"orphaned:kubernetes"},
+			wantChanged: true,
+		},
+		{
+			name:        "tag present",
+			tags:        []string{"orphaned:kubernetes", "env:prod"},
+			wantTags:    []string{"orphaned:kubernetes", "env:prod"},
+			wantChanged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, changed := AddOrphanedTag(tt.tags)
+			assert.Equal(t, tt.wantTags, tags)
+			assert.Equal(t, tt.wantChanged, changed)
+		})
+	}
+}
+
+func TestAddOrphanedMarker(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		wantText    string
+		wantMissing bool
+	}{
+		{
+			name:        "empty text",
+			text:        "",
+			wantText:    "orphaned:kubernetes",
+			wantMissing: true,
+		},
+		{
+			name:        "marker missing",
+			text:        "Checkout service",
+			wantText:    "Checkout service\n\norphaned:kubernetes",
+			wantMissing: true,
+		},
+		{
+			name:        "marker present",
+			text:        "Checkout service\n\norphaned:kubernetes",
+			wantText:    "Checkout service\n\norphaned:kubernetes",
+			wantMissing: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, missing := AddOrphanedMarker(tt.text)
+			assert.Equal(t, tt.wantText, text)
+			assert.Equal(t, tt.wantMissing, missing)
+		})
+	}
+}
+
+func TestNamespaceTags_Tags(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "checkout",
+			Labels:      map[string]string{"team": "payments", "kubernetes.io/metadata.name": "checkout"},
+			Annotations: map[string]string{"example.com/service": "checkout-api"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		namespaceTags NamespaceTags
+		want          []string
+	}{
+		{
+			name:          "no mapping",
+			namespaceTags: NamespaceTags{},
+			want:          []string{},
+		},
+		{
+			name: "labels and annotations mapped",
+			namespaceTags: NamespaceTags{
+				LabelsAsTags:      map[string]string{"team": "team"},
+				AnnotationsAsTags: map[string]string{"example.com/service": "service"},
+			},
+			want: []string{"service:checkout-api", "team:payments"},
+		},
+		{
+			name: "missing label ignored",
+			namespaceTags: NamespaceTags{
+				LabelsAsTags: map[string]string{"team": "owner", "cost-center": "cost_center"},
+			},
+			want: []string{"owner:payments"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.namespaceTags.Tags(namespace))
+		})
+	}
+}
+
+func TestGetTagsWithNewKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		tags       []string
+		candidates []string
+		want       []string
+	}{
+		{
+			name:       "new keys",
+			tags:       []string{"generated:kubernetes"},
+			candidates: []string{"service:checkout", "team:payments"},
+			want:       []string{"service:checkout", "team:payments"},
+		},
+		{
+			name:       "keys set explicitly",
+			tags:       []string{"generated:kubernetes", "team:billing"},
+			candidates: []string{"service:checkout", "team:payments"},
+			want:       []string{"service:checkout"},
+		},
+		{
+			name:       "tags without value",
+			tags:       []string{"critical"},
+			candidates: []string{"critical:true", "team:payments"},
+			want:       []string{"team:payments"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetTagsWithNewKeys(tt.tags, tt.candidates))
+		})
+	}
+}
@@ -31,8 +31,16 @@ const (
 	agentWatchNamespaceEnvVar = "DD_AGENT_WATCH_NAMESPACE"
 	// SLOWatchNamespaceEnvVar is a comma-separated list of namespaces watched by the DatadogSLO controller.
 	sloWatchNamespaceEnvVar = "DD_SLO_WATCH_NAMESPACE"
+	// SLOCorrectionWatchNamespaceEnvVar is a comma-separated list of namespaces watched by the DatadogSLOCorrection controller.
+	sloCorrectionWatchNamespaceEnvVar = "DD_SLO_CORRECTION_WATCH_NAMESPACE"
+	// SyntheticTestWatchNamespaceEnvVar is a comma-separated list of namespaces watched by the DatadogSyntheticTest controller.
+	syntheticTestWatchNamespaceEnvVar = "DD_SYNTHETIC_TEST_WATCH_NAMESPACE"
+	// DowntimeWatchNamespaceEnvVar is a comma-separated list of namespaces watched by the DatadogDowntime controller.
+	downtimeWatchNamespaceEnvVar = "DD_DOWNTIME_WATCH_NAMESPACE"
 	// MonitorWatchNamespaceEnvVar is a comma-separated list of namespaces watched by the DatadogMonitor controller.
 	monitorWatchNamespaceEnvVar = "DD_MONITOR_WATCH_NAMESPACE"
+	// MonitorTemplateWatchNamespaceEnvVar is a comma-separated list of namespaces watched by the DatadogMonitorTemplate controller.
+	monitorTemplateWatchNamespaceEnvVar = "DD_MONITOR_TEMPLATE_WATCH_NAMESPACE"
 	// ProfilesWatchNamespaceEnvVar is a comma-separated list of namespaces watched by the DatadogAgentProfile controller.
 	profileWatchNamespaceEnvVar = "DD_AGENT_PROFILE_WATCH_NAMESPACE"
 	// WatchNamespaceEnvVar is a comma-separated list of namespaces watched by all controllers, unless a controller-specific configuration is provided.
@@ -51,20 +59,28 @@ const (
 )
 
 var (
-	agentObj   = &datadoghqv2alpha1.DatadogAgent{}
-	monitorObj = &datadoghqv1alpha1.DatadogMonitor{}
-	sloObj     = &datadoghqv1alpha1.DatadogSLO{}
-	profileObj = &datadoghqv1alpha1.DatadogAgentProfile{}
-	podObj     = &corev1.Pod{}
-	nodeObj    = &corev1.Node{}
+	agentObj           = &datadoghqv2alpha1.DatadogAgent{}
+	monitorObj         = &datadoghqv1alpha1.DatadogMonitor{}
+	monitorTemplateObj = &datadoghqv1alpha1.DatadogMonitorTemplate{}
+	sloObj             = &datadoghqv1alpha1.DatadogSLO{}
+	sloCorrectionObj   = &datadoghqv1alpha1.DatadogSLOCorrection{}
+	syntheticTestObj   = &datadoghqv1alpha1.DatadogSyntheticTest{}
+	downtimeObj        = &datadoghqv1alpha1.DatadogDowntime{}
+	profileObj         = &datadoghqv1alpha1.DatadogAgentProfile{}
+	podObj             = &corev1.Pod{}
+	nodeObj            = &corev1.Node{}
 )
 
 type WatchOptions struct {
-	DatadogAgentEnabled        bool
-	DatadogMonitorEnabled      bool
-	DatadogSLOEnabled          bool
-	DatadogAgentProfileEnabled bool
-	IntrospectionEnabled       bool
+	DatadogAgentEnabled           bool
+	DatadogMonitorEnabled         bool
+	DatadogSLOEnabled             bool
+	DatadogSLOCorrectionEnabled   bool
+	DatadogSyntheticTestEnabled   bool
+	DatadogDowntimeEnabled        bool
+	DatadogMonitorTemplateEnabled bool
+	DatadogAgentProfileEnabled    bool
+	IntrospectionEnabled          bool
 }
 
 // CacheOptions function configures Controller Runtime cache options on a resource level (supported in v0.16+).
@@ -96,6 +112,38 @@ func CacheOptions(logger logr.Logger, opts WatchOptions) cache.Options {
 		}
 	}
 
+	if opts.DatadogSLOCorrectionEnabled {
+		sloCorrectionNamespaces := getWatchNamespacesFromEnv(logger, sloCorrectionWatchNamespaceEnvVar)
+		logger.Info("DatadogSLOCorrection Enabled", "watching namespaces", maps.Keys(sloCorrectionNamespaces))
+		byObject[sloCorrectionObj] = cache.ByObject{
+			Namespaces: sloCorrectionNamespaces,
+		}
+	}
+
+	if opts.DatadogSyntheticTestEnabled {
+		syntheticTestNamespaces := getWatchNamespacesFromEnv(logger, syntheticTestWatchNamespaceEnvVar)
+		logger.Info("DatadogSyntheticTest Enabled", "watching namespaces", maps.Keys(syntheticTestNamespaces))
+		byObject[syntheticTestObj] = cache.ByObject{
+			Namespaces: syntheticTestNamespaces,
+		}
+	}
+
+	if opts.DatadogDowntimeEnabled {
+		downtimeNamespaces := getWatchNamespacesFromEnv(logger, downtimeWatchNamespaceEnvVar)
+		logger.Info("DatadogDowntime Enabled", "watching namespaces", maps.Keys(downtimeNamespaces))
+		byObject[downtimeObj] = cache.ByObject{
+			Namespaces: downtimeNamespaces,
+		}
+	}
+
+	if opts.DatadogMonitorTemplateEnabled {
+		monitorTemplateNamespaces := getWatchNamespacesFromEnv(logger, monitorTemplateWatchNamespaceEnvVar)
+		logger.Info("DatadogMonitorTemplate Enabled", "watching namespaces", maps.Keys(monitorTemplateNamespaces))
+		byObject[monitorTemplateObj] = cache.ByObject{
+			Namespaces: monitorTemplateNamespaces,
+		}
+	}
+
 	if opts.DatadogAgentProfileEnabled {
 		agentProfileNamespaces := getWatchNamespacesFromEnv(logger, profileWatchNamespaceEnvVar)
 		logger.Info("DatadogAgentProfile Enabled", "watching namespace", maps.Keys(agentProfileNamespaces))
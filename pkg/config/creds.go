@@ -17,6 +17,9 @@ import (
 	"k8s.io/client-go/util/retry"
 )
 
+// ErrEmptyCreds is returned when the API key or the app key isn't configured.
+var ErrEmptyCreds = errors.New("empty API key and/or App key")
+
 // Creds holds the api and app keys.
 type Creds struct {
 	APIKey string
@@ -57,7 +60,7 @@ func (cm *CredentialManager) GetCredentials() (Creds, error) {
 	appKey := os.Getenv(DDAppKeyEnvVar)
 
 	if apiKey == "" || appKey == "" {
-		return Creds{}, errors.New("empty API key and/or App key")
+		return Creds{}, ErrEmptyCreds
 	}
 
 	var encrypted []string
@@ -0,0 +1,74 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package comparison
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// SpecDrift returns the JSON paths of the fields set in the desired spec that have a different value in the actual spec,
+// typically the spec exported from the object in Datadog. The fields that aren't set in the desired spec are ignored,
+// as they are left to the Datadog defaults.
+func SpecDrift(desired, actual interface{}) []string {
+	drift := []string{}
+	specDrift(reflect.ValueOf(desired), reflect.ValueOf(actual), "", &drift)
+
+	return drift
+}
+
+func specDrift(desired, actual reflect.Value, path string, drift *[]string) {
+	if desired.Kind() == reflect.Ptr {
+		if desired.IsNil() {
+			return
+		}
+		if actual.IsNil() {
+			*drift = append(*drift, path)
+			return
+		}
+		specDrift(desired.Elem(), actual.Elem(), path, drift)
+		return
+	}
+
+	// Types with their own JSON representation, like resource.Quantity, are compared as a whole
+	if desired.Kind() == reflect.Struct && !desired.Type().Implements(jsonMarshalerType) && !reflect.PtrTo(desired.Type()).Implements(jsonMarshalerType) {
+		for i := 0; i < desired.NumField(); i++ {
+			field := desired.Type().Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			specDrift(desired.Field(i), actual.Field(i), name, drift)
+		}
+		return
+	}
+
+	if desired.IsZero() || (desired.Kind() == reflect.Slice && desired.Len() == 0) {
+		return
+	}
+	if !apiequality.Semantic.DeepEqual(desired.Interface(), actual.Interface()) {
+		*drift = append(*drift, path)
+	}
+}
+
+// ModifiedSince returns true if an object was modified in Datadog after the last time it was synced. It compares
+// seconds, as the sync time loses its sub-second precision when it's stored in the status.
+func ModifiedSince(modified time.Time, lastSync *metav1.Time) bool {
+	return lastSync == nil || modified.Unix() > lastSync.Unix()
+}
@@ -0,0 +1,115 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package comparison
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
+)
+
+func TestSpecDrift(t *testing.T) {
+	warning := resource.MustParse("99.95")
+	actualWarning := resource.MustParse("99.99")
+	tests := []struct {
+		name    string
+		desired interface{}
+		actual  interface{}
+		want    []string
+	}{
+		{
+			name: "no drift",
+			desired: v1alpha1.DatadogMonitorSpec{
+				Name:  "foo",
+				Query: "avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.5",
+				Options: v1alpha1.DatadogMonitorOptions{
+					Thresholds: &v1alpha1.DatadogMonitorOptionsThresholds{Critical: apiutils.NewStringPointer("0.5")},
+				},
+			},
+			actual: v1alpha1.DatadogMonitorSpec{
+				Name:  "foo",
+				Query: "avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.5",
+				Options: v1alpha1.DatadogMonitorOptions{
+					Thresholds: &v1alpha1.DatadogMonitorOptionsThresholds{Critical: apiutils.NewStringPointer("0.5")},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "fields not set in the desired spec are ignored",
+			desired: v1alpha1.DatadogMonitorSpec{
+				Name: "foo",
+			},
+			actual: v1alpha1.DatadogMonitorSpec{
+				Name:     "foo",
+				Priority: 2,
+				Tags:     []string{"team:foo"},
+				Options: v1alpha1.DatadogMonitorOptions{
+					EvaluationDelay: apiutils.NewInt64Pointer(300),
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "nested fields",
+			desired: v1alpha1.DatadogMonitorSpec{
+				Name: "foo",
+				Tags: []string{"team:foo"},
+				Options: v1alpha1.DatadogMonitorOptions{
+					NotifyNoData: apiutils.NewBoolPointer(true),
+					Thresholds: &v1alpha1.DatadogMonitorOptionsThresholds{
+						Critical: apiutils.NewStringPointer("0.5"),
+						Warning:  apiutils.NewStringPointer("0.3"),
+					},
+				},
+			},
+			actual: v1alpha1.DatadogMonitorSpec{
+				Name: "bar",
+				Tags: []string{"team:bar"},
+				Options: v1alpha1.DatadogMonitorOptions{
+					Thresholds: &v1alpha1.DatadogMonitorOptionsThresholds{
+						Critical: apiutils.NewStringPointer("0.5"),
+						Warning:  apiutils.NewStringPointer("0.4"),
+					},
+				},
+			},
+			want: []string{"name", "tags", "options.notifyNoData", "options.thresholds.warning"},
+		},
+		{
+			name: "quantities",
+			desired: v1alpha1.DatadogSLOSpec{
+				TargetThreshold:  resource.MustParse("99.9"),
+				WarningThreshold: &warning,
+			},
+			actual: v1alpha1.DatadogSLOSpec{
+				TargetThreshold:  resource.MustParse("99.90"),
+				WarningThreshold: &actualWarning,
+			},
+			want: []string{"warningThreshold"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SpecDrift(tt.desired, tt.actual))
+		})
+	}
+}
+
+func TestModifiedSince(t *testing.T) {
+	lastSync := metav1.NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	assert.True(t, ModifiedSince(lastSync.Add(time.Second), &lastSync))
+	assert.True(t, ModifiedSince(lastSync.Time, nil))
+	assert.False(t, ModifiedSince(lastSync.Add(500*time.Millisecond), &lastSync))
+	assert.False(t, ModifiedSince(time.Time{}, &lastSync))
+}
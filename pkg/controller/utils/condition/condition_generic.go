@@ -21,6 +21,8 @@ const (
 	DatadogConditionTypeUpdated Type = "Updated"
 	// DatadogConditionTypeError means the  Datadog CRD has error
 	DatadogConditionTypeError Type = "Error"
+	// DatadogConditionTypeDrifted means the Datadog object differs from the Datadog CRD spec
+	DatadogConditionTypeDrifted Type = "Drifted"
 )
 
 // UpdateFailureStatusConditions is a generic method to update the failure StatusConditions.
@@ -25,6 +25,10 @@ const (
 	UpdateEvent EventType = "Update"
 	// DeletionEvent should be used for resource deletion events
 	DeletionEvent EventType = "Delete"
+	// DriftEvent should be used for resource drift events
+	DriftEvent EventType = "Drift"
+	// OrphanEvent should be used for resources left in Datadog when their custom resource is deleted
+	OrphanEvent EventType = "Orphan"
 )
 
 // crDetected returns the detection event of a CR
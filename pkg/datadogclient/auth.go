@@ -0,0 +1,99 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	"github.com/DataDog/datadog-operator/pkg/config"
+)
+
+// Credentials are the credentials of a Datadog organization, and the site it belongs to.
+type Credentials struct {
+	config.Creds
+	// Site is the Datadog site of the organization, e.g. datadoghq.eu, or the URL of its API. The site configured in
+	// the operator environment is used when empty.
+	Site string
+}
+
+// AuthCache caches the authentication contexts of the Datadog API requests by set of credentials, so that the custom
+// resources managed with the same credentials share them.
+type AuthCache struct {
+	logger logr.Logger
+
+	mu    sync.Mutex
+	auths map[string]context.Context
+}
+
+// NewAuthCache returns a new AuthCache.
+func NewAuthCache(logger logr.Logger) *AuthCache {
+	return &AuthCache{
+		logger: logger,
+		auths:  map[string]context.Context{},
+	}
+}
+
+// Get returns the authentication context of the credentials.
+func (c *AuthCache) Get(creds Credentials) (context.Context, error) {
+	if creds.APIKey == "" || creds.AppKey == "" {
+		return nil, errors.New("error obtaining API key and/or app key")
+	}
+
+	key := creds.key()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if auth, found := c.auths[key]; found {
+		return auth, nil
+	}
+
+	auth, err := newAuth(c.logger, creds.Creds, creds.apiURL())
+	if err != nil {
+		return nil, err
+	}
+	c.auths[key] = auth
+
+	return auth, nil
+}
+
+// key returns the key of the credentials in the cache, so that the cache doesn't hold the keys in clear.
+func (creds Credentials) key() string {
+	hash := sha256.Sum256([]byte(creds.APIKey + "\n" + creds.AppKey + "\n" + creds.Site))
+	return hex.EncodeToString(hash[:])
+}
+
+func (creds Credentials) apiURL() string {
+	site := strings.TrimSpace(creds.Site)
+	switch {
+	case site == "":
+		return operatorAPIURL()
+	case strings.Contains(site, "://"):
+		return site
+	default:
+		return prefix + site
+	}
+}
+
+type authContextKey struct{}
+
+// WithAuth returns a copy of ctx carrying the authentication context of the Datadog API requests made with it.
+func WithAuth(ctx, auth context.Context) context.Context {
+	return context.WithValue(ctx, authContextKey{}, auth)
+}
+
+// AuthFromContext returns the authentication context carried by ctx, or defaultAuth if ctx doesn't carry any.
+func AuthFromContext(ctx, defaultAuth context.Context) context.Context {
+	if auth, ok := ctx.Value(authContextKey{}).(context.Context); ok {
+		return auth
+	}
+	return defaultAuth
+}
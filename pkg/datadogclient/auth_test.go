@@ -0,0 +1,84 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"context"
+	"testing"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-operator/pkg/config"
+)
+
+func TestAuthCache_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		creds          Credentials
+		wantErr        bool
+		wantServerName string
+	}{
+		{
+			name:  "default site",
+			creds: Credentials{Creds: config.Creds{APIKey: "foo", AppKey: "bar"}},
+		},
+		{
+			name:           "site",
+			creds:          Credentials{Creds: config.Creds{APIKey: "foo", AppKey: "bar"}, Site: "datadoghq.eu"},
+			wantServerName: "api.datadoghq.eu",
+		},
+		{
+			name:           "API URL",
+			creds:          Credentials{Creds: config.Creds{APIKey: "foo", AppKey: "bar"}, Site: "http://localhost:8080"},
+			wantServerName: "localhost:8080",
+		},
+		{
+			name:    "missing app key",
+			creds:   Credentials{Creds: config.Creds{APIKey: "foo"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewAuthCache(logr.Discard())
+			auth, err := cache.Get(tt.creds)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			keys := auth.Value(datadogapi.ContextAPIKeys).(map[string]datadogapi.APIKey)
+			assert.Equal(t, tt.creds.APIKey, keys["apiKeyAuth"].Key)
+			assert.Equal(t, tt.creds.AppKey, keys["appKeyAuth"].Key)
+			if tt.wantServerName == "" {
+				assert.Nil(t, auth.Value(datadogapi.ContextServerVariables))
+			} else {
+				assert.Equal(t, tt.wantServerName, auth.Value(datadogapi.ContextServerVariables).(map[string]string)["name"])
+			}
+
+			// The authentication context is cached by set of credentials
+			cached, err := cache.Get(tt.creds)
+			require.NoError(t, err)
+			assert.Equal(t, auth, cached)
+			other, err := cache.Get(Credentials{Creds: config.Creds{APIKey: "other", AppKey: "bar"}, Site: tt.creds.Site})
+			require.NoError(t, err)
+			assert.NotEqual(t, auth, other)
+		})
+	}
+}
+
+func TestAuthFromContext(t *testing.T) {
+	defaultAuth := context.WithValue(context.Background(), datadogapi.ContextAPIKeys, "default")
+	auth := context.WithValue(context.Background(), datadogapi.ContextAPIKeys, "custom")
+
+	assert.Equal(t, defaultAuth, AuthFromContext(context.Background(), defaultAuth))
+	assert.Equal(t, auth, AuthFromContext(WithAuth(context.Background(), auth), defaultAuth))
+}
@@ -19,25 +19,32 @@ import (
 
 	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadogV2 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
 )
 
-const prefix = "https://api."
+const (
+	prefix = "https://api."
 
-// DatadogMonitorClient contains the Datadog Monitor API Client and Authentication context.
+	missingCredsMessage = "Datadog API key and/or app key not set, the custom resources must reference their own Datadog credentials"
+)
+
+// DatadogMonitorClient contains the Datadog Monitor API Client and Authentication context, and the Downtime API
+// Client muting the monitors during rollouts.
 type DatadogMonitorClient struct {
-	Client *datadogV1.MonitorsApi
-	Auth   context.Context
+	Client         *datadogV1.MonitorsApi
+	DowntimeClient *datadogV2.DowntimesApi
+	Auth           context.Context
 }
 
 // InitDatadogMonitorClient initializes the Datadog Monitor API Client and establishes credentials.
+// The credentials are optional, as the DatadogMonitors can reference their own.
 func InitDatadogMonitorClient(logger logr.Logger, creds config.Creds) (DatadogMonitorClient, error) {
 	if creds.APIKey == "" || creds.AppKey == "" {
-		return DatadogMonitorClient{}, errors.New("error obtaining API key and/or app key")
+		logger.Info(missingCredsMessage)
 	}
 
-	configV1 := datadogapi.NewConfiguration()
-	apiClient := datadogapi.NewAPIClient(configV1)
+	apiClient := newAPIClient()
 	client := datadogV1.NewMonitorsApi(apiClient)
 
 	authV1, err := setupAuth(logger, creds)
@@ -45,7 +52,7 @@ func InitDatadogMonitorClient(logger logr.Logger, creds config.Creds) (DatadogMo
 		return DatadogMonitorClient{}, err
 	}
 
-	return DatadogMonitorClient{Client: client, Auth: authV1}, nil
+	return DatadogMonitorClient{Client: client, DowntimeClient: datadogV2.NewDowntimesApi(apiClient), Auth: authV1}, nil
 }
 
 // DatadogSLOClient contains the Datadog Monitor API Client and Authentication context.
@@ -55,13 +62,13 @@ type DatadogSLOClient struct {
 }
 
 // InitDatadogSLOClient initializes the Datadog SLO API Client and establishes credentials.
+// The credentials are optional, as the DatadogSLOs can reference their own.
 func InitDatadogSLOClient(logger logr.Logger, creds config.Creds) (DatadogSLOClient, error) {
 	if creds.APIKey == "" || creds.AppKey == "" {
-		return DatadogSLOClient{}, errors.New("error obtaining API key and/or app key")
+		logger.Info(missingCredsMessage)
 	}
 
-	configV1 := datadogapi.NewConfiguration()
-	apiClient := datadogapi.NewAPIClient(configV1)
+	apiClient := newAPIClient()
 	client := datadogV1.NewServiceLevelObjectivesApi(apiClient)
 
 	authV1, err := setupAuth(logger, creds)
@@ -78,13 +85,13 @@ type DatadogDashboardClient struct {
 }
 
 // InitDatadogDashboardClient initializes the Datadog Dashboard API Client and establishes credentials.
+// The credentials are optional, as the DatadogDashboards can reference their own.
 func InitDatadogDashboardClient(logger logr.Logger, creds config.Creds) (DatadogDashboardClient, error) {
 	if creds.APIKey == "" || creds.AppKey == "" {
-		return DatadogDashboardClient{}, errors.New("error obtaining API key and/or app key")
+		logger.Info(missingCredsMessage)
 	}
 
-	configV1 := datadogapi.NewConfiguration()
-	apiClient := datadogapi.NewAPIClient(configV1)
+	apiClient := newAPIClient()
 	client := datadogV1.NewDashboardsApi(apiClient)
 
 	authV1, err := setupAuth(logger, creds)
@@ -95,7 +102,97 @@ func InitDatadogDashboardClient(logger logr.Logger, creds config.Creds) (Datadog
 	return DatadogDashboardClient{Client: client, Auth: authV1}, nil
 }
 
+// DatadogSLOCorrectionClient contains the Datadog SLO Correction API Client and Authentication context.
+type DatadogSLOCorrectionClient struct {
+	Client *datadogV1.ServiceLevelObjectiveCorrectionsApi
+	Auth   context.Context
+}
+
+// InitDatadogSLOCorrectionClient initializes the Datadog SLO Correction API Client and establishes credentials.
+func InitDatadogSLOCorrectionClient(logger logr.Logger, creds config.Creds) (DatadogSLOCorrectionClient, error) {
+	if creds.APIKey == "" || creds.AppKey == "" {
+		return DatadogSLOCorrectionClient{}, errors.New("error obtaining API key and/or app key")
+	}
+
+	apiClient := newAPIClient()
+	client := datadogV1.NewServiceLevelObjectiveCorrectionsApi(apiClient)
+
+	authV1, err := setupAuth(logger, creds)
+	if err != nil {
+		return DatadogSLOCorrectionClient{}, err
+	}
+
+	return DatadogSLOCorrectionClient{Client: client, Auth: authV1}, nil
+}
+
+// DatadogSyntheticTestClient contains the Datadog Synthetics API Client and Authentication context.
+type DatadogSyntheticTestClient struct {
+	Client *datadogV1.SyntheticsApi
+	Auth   context.Context
+}
+
+// InitDatadogSyntheticTestClient initializes the Datadog Synthetics API Client and establishes credentials.
+func InitDatadogSyntheticTestClient(logger logr.Logger, creds config.Creds) (DatadogSyntheticTestClient, error) {
+	if creds.APIKey == "" || creds.AppKey == "" {
+		return DatadogSyntheticTestClient{}, errors.New("error obtaining API key and/or app key")
+	}
+
+	apiClient := newAPIClient()
+	client := datadogV1.NewSyntheticsApi(apiClient)
+
+	authV1, err := setupAuth(logger, creds)
+	if err != nil {
+		return DatadogSyntheticTestClient{}, err
+	}
+
+	return DatadogSyntheticTestClient{Client: client, Auth: authV1}, nil
+}
+
+// DatadogDowntimeClient contains the Datadog Downtime API Client and Authentication context.
+type DatadogDowntimeClient struct {
+	Client *datadogV2.DowntimesApi
+	Auth   context.Context
+}
+
+// InitDatadogDowntimeClient initializes the Datadog Downtime API Client and establishes credentials.
+func InitDatadogDowntimeClient(logger logr.Logger, creds config.Creds) (DatadogDowntimeClient, error) {
+	if creds.APIKey == "" || creds.AppKey == "" {
+		return DatadogDowntimeClient{}, errors.New("error obtaining API key and/or app key")
+	}
+
+	apiClient := newAPIClient()
+	client := datadogV2.NewDowntimesApi(apiClient)
+
+	authV2, err := setupAuth(logger, creds)
+	if err != nil {
+		return DatadogDowntimeClient{}, err
+	}
+
+	return DatadogDowntimeClient{Client: client, Auth: authV2}, nil
+}
+
+// newAPIClient returns a Datadog API client whose requests are rate limited and retried by the shared transport.
+func newAPIClient() *datadogapi.APIClient {
+	configuration := datadogapi.NewConfiguration()
+	configuration.HTTPClient = newHTTPClient()
+	return datadogapi.NewAPIClient(configuration)
+}
+
 func setupAuth(logger logr.Logger, creds config.Creds) (context.Context, error) {
+	return newAuth(logger, creds, operatorAPIURL())
+}
+
+// operatorAPIURL returns the URL of the Datadog API configured in the operator environment, empty for the default site.
+func operatorAPIURL() string {
+	if os.Getenv(config.DDURLEnvVar) != "" {
+		return os.Getenv(config.DDURLEnvVar)
+	} else if site := os.Getenv(apicommon.DDSite); site != "" {
+		return prefix + strings.TrimSpace(site)
+	}
+	return ""
+}
+
+func newAuth(logger logr.Logger, creds config.Creds, apiURL string) (context.Context, error) {
 	// Initialize the official Datadog V1 API client.
 	authV1 := context.WithValue(
 		context.Background(),
@@ -110,13 +207,6 @@ func setupAuth(logger logr.Logger, creds config.Creds) (context.Context, error)
 		},
 	)
 
-	apiURL := ""
-	if os.Getenv(config.DDURLEnvVar) != "" {
-		apiURL = os.Getenv(config.DDURLEnvVar)
-	} else if site := os.Getenv(apicommon.DDSite); site != "" {
-		apiURL = prefix + strings.TrimSpace(site)
-	}
-
 	if apiURL != "" {
 		logger.Info("Got API URL for DatadogOperator controller", "URL", apiURL)
 		parsedAPIURL, parseErr := url.Parse(apiURL)
@@ -0,0 +1,48 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+)
+
+// TranslateClientError wraps an error returned by the Datadog API client with a message, and the body of the API
+// response if any.
+func TranslateClientError(err error, msg string) error {
+	if msg == "" {
+		msg = "an error occurred"
+	}
+
+	var apiErr datadogapi.GenericOpenAPIError
+	var errURL *url.Error
+	if errors.As(err, &apiErr) {
+		return fmt.Errorf(msg+": %w: %s", err, apiErr.Body())
+	}
+
+	if errors.As(err, &errURL) {
+		return fmt.Errorf(msg+" (url.Error): %w", errURL)
+	}
+
+	return fmt.Errorf(msg+": %w", err)
+}
+
+// IsNotFound returns true if err wraps a Datadog API error response with the 404 status code.
+func IsNotFound(err error) bool {
+	var apiErr datadogapi.GenericOpenAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	// The message of the API errors is the status of the HTTP response, for example "404 Not Found"
+	code, _, _ := strings.Cut(apiErr.ErrorMessage, " ")
+	return code == strconv.Itoa(http.StatusNotFound)
+}
@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateClientError(t *testing.T) {
+	var ErrGeneric = errors.New("generic error")
+
+	testCases := []struct {
+		name                   string
+		error                  error
+		message                string
+		expectedErrorType      error
+		expectedError          error
+		expectedErrorInterface interface{}
+	}{
+		{
+			name:              "no message, generic error",
+			error:             ErrGeneric,
+			message:           "",
+			expectedErrorType: ErrGeneric,
+		},
+		{
+			name:              "generic message, generic error",
+			error:             ErrGeneric,
+			message:           "generic message",
+			expectedErrorType: ErrGeneric,
+		},
+		{
+			name:                   "generic message, error type datadogV1.GenericOpenAPIError",
+			error:                  datadogapi.GenericOpenAPIError{},
+			message:                "generic message",
+			expectedErrorInterface: &datadogapi.GenericOpenAPIError{},
+		},
+		{
+			name:          "generic message, error type *url.Error",
+			error:         &url.Error{Err: fmt.Errorf("generic url error")},
+			message:       "generic message",
+			expectedError: fmt.Errorf("generic message (url.Error): %w", &url.Error{Err: fmt.Errorf("generic url error")}),
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			result := TranslateClientError(test.error, test.message)
+
+			if test.expectedErrorType != nil {
+				assert.True(t, errors.Is(result, test.expectedErrorType))
+			}
+
+			if test.expectedErrorInterface != nil {
+				assert.True(t, errors.As(result, test.expectedErrorInterface))
+			}
+
+			if test.expectedError != nil {
+				assert.Equal(t, test.expectedError, result)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "not found API error",
+			err:  TranslateClientError(datadogapi.GenericOpenAPIError{ErrorMessage: "404 Not Found"}, "error getting monitor"),
+			want: true,
+		},
+		{
+			name: "other API error",
+			err:  TranslateClientError(datadogapi.GenericOpenAPIError{ErrorMessage: "400 Bad Request", ErrorBody: []byte("404 Not Found")}, "error getting monitor"),
+			want: false,
+		},
+		{
+			name: "not an API error",
+			err:  fmt.Errorf("error getting monitor: 404 Not Found"),
+			want: false,
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, IsNotFound(test.err))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	datadogAPISubsystem = "datadog_api"
+
+	endpointLabelKey = "endpoint"
+	codeLabelKey     = "code"
+)
+
+var (
+	// Datadog API responses
+	requests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: datadogAPISubsystem,
+			Name:      "requests_total",
+			Help:      "Number of Datadog API responses, by endpoint and status code",
+		},
+		[]string{
+			endpointLabelKey,
+			codeLabelKey,
+		},
+	)
+
+	// Datadog API requests retried
+	retries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: datadogAPISubsystem,
+			Name:      "retries_total",
+			Help:      "Number of Datadog API requests retried after a rate limit or a server error, by endpoint",
+		},
+		[]string{
+			endpointLabelKey,
+		},
+	)
+
+	// Datadog API requests rate limited
+	rateLimited = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: datadogAPISubsystem,
+			Name:      "rate_limited_total",
+			Help:      "Number of Datadog API requests rejected because of the rate limit of their endpoint, by endpoint",
+		},
+		[]string{
+			endpointLabelKey,
+		},
+	)
+
+	// Datadog API requests left before the rate limit
+	rateLimitRemaining = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: datadogAPISubsystem,
+			Name:      "rate_limit_remaining",
+			Help:      "Number of Datadog API requests left in the current rate limit period, by endpoint",
+		},
+		[]string{
+			endpointLabelKey,
+		},
+	)
+)
+
+func init() {
+	// Register custom metrics with the global prometheus registry
+	metrics.Registry.MustRegister(requests, retries, rateLimited, rateLimitRemaining)
+}
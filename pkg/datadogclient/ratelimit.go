@@ -0,0 +1,295 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	rateLimitLimitHeader     = "X-RateLimit-Limit"
+	rateLimitPeriodHeader    = "X-RateLimit-Period"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+
+	apiKeyHeader = "DD-API-KEY"
+
+	defaultMaxRetries   = 3
+	defaultBackoffBase  = 500 * time.Millisecond
+	defaultBackoffMax   = 10 * time.Second
+	defaultMaxRetryWait = 10 * time.Second
+)
+
+// RateLimitError is returned when a Datadog API endpoint is rate limited, and the request can't be retried before
+// the rate limit is reset.
+type RateLimitError struct {
+	// Endpoint is the rate limited endpoint.
+	Endpoint string
+	// RetryAfter is the delay before the rate limit is reset.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit of the Datadog API endpoint %s exceeded, retry in %s", e.Endpoint, e.RetryAfter)
+}
+
+// RetryAfter returns the delay before retrying a request that failed because of a rate limit, and false if the error
+// isn't caused by a rate limit.
+func RetryAfter(err error) (time.Duration, bool) {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return rateLimitErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// rateLimitTransport is an http.RoundTripper that keeps the requests to each Datadog API endpoint under its rate
+// limit. The limit of an endpoint is learned from the X-RateLimit headers of its responses, and applied with a token
+// bucket shared by all the clients using the same site and API key, as the Datadog API rate limits apply by
+// organization. Rate limited and failed requests are retried with a jittered exponential backoff,
+// unless the rate limit is reset too late: a RateLimitError is returned then, so that the caller retries later.
+type rateLimitTransport struct {
+	next         http.RoundTripper
+	maxRetries   int
+	backoffBase  time.Duration
+	backoffMax   time.Duration
+	maxRetryWait time.Duration
+
+	mu     sync.Mutex
+	limits map[string]*endpointLimit
+}
+
+// endpointLimit is the rate limit of an endpoint for a site and an API key.
+type endpointLimit struct {
+	limiter      *rate.Limiter
+	blockedUntil time.Time
+}
+
+var (
+	sharedTransport     http.RoundTripper
+	sharedTransportOnce sync.Once
+)
+
+// newHTTPClient returns an HTTP client sharing the rate limits of the Datadog API endpoints with the other clients.
+func newHTTPClient() *http.Client {
+	sharedTransportOnce.Do(func() {
+		sharedTransport = newRateLimitTransport(http.DefaultTransport)
+	})
+	return &http.Client{Transport: sharedTransport}
+}
+
+func newRateLimitTransport(next http.RoundTripper) *rateLimitTransport {
+	return &rateLimitTransport{
+		next:         next,
+		maxRetries:   defaultMaxRetries,
+		backoffBase:  defaultBackoffBase,
+		backoffMax:   defaultBackoffMax,
+		maxRetryWait: defaultMaxRetryWait,
+		limits:       map[string]*endpointLimit{},
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := endpointName(req)
+	limit := t.limit(limitKey(req, endpoint))
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		if err := t.wait(req, endpoint, limit); err != nil {
+			return nil, err
+		}
+		if attempt > 0 {
+			retries.WithLabelValues(endpoint).Inc()
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+		requests.WithLabelValues(endpoint, strconv.Itoa(resp.StatusCode)).Inc()
+		reset := t.update(endpoint, limit, resp)
+
+		if !isRetryable(req, resp) || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		delay := t.backoff(attempt)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			rateLimited.WithLabelValues(endpoint).Inc()
+			if reset > delay {
+				delay = jitter(reset)
+			}
+			if attempt >= t.maxRetries || delay > t.maxRetryWait {
+				drain(resp)
+				return nil, &RateLimitError{Endpoint: endpoint, RetryAfter: delay}
+			}
+		} else if attempt >= t.maxRetries {
+			return resp, nil
+		}
+		drain(resp)
+
+		if err := sleep(req, delay); err != nil {
+			return nil, err
+		}
+		if attemptReq, err = retryRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// wait blocks until the request can be sent without exceeding the rate limit of the endpoint.
+func (t *rateLimitTransport) wait(req *http.Request, endpoint string, limit *endpointLimit) error {
+	t.mu.Lock()
+	blocked := time.Until(limit.blockedUntil)
+	t.mu.Unlock()
+	if blocked > t.maxRetryWait {
+		return &RateLimitError{Endpoint: endpoint, RetryAfter: jitter(blocked)}
+	}
+	if blocked > 0 {
+		if err := sleep(req, blocked); err != nil {
+			return err
+		}
+	}
+
+	return limit.limiter.Wait(req.Context())
+}
+
+// update adjusts the rate limit of the endpoint from the X-RateLimit headers of the response, and returns the delay
+// before the rate limit is reset, if it's exhausted.
+func (t *rateLimitTransport) update(endpoint string, limit *endpointLimit, resp *http.Response) time.Duration {
+	header := resp.Header
+
+	if remaining, err := strconv.Atoi(header.Get(rateLimitRemainingHeader)); err == nil {
+		rateLimitRemaining.WithLabelValues(endpoint).Set(float64(remaining))
+	}
+	if max, err := strconv.Atoi(header.Get(rateLimitLimitHeader)); err == nil && max > 0 {
+		if period, err := strconv.Atoi(header.Get(rateLimitPeriodHeader)); err == nil && period > 0 {
+			limit.limiter.SetLimit(rate.Limit(float64(max) / float64(period)))
+			limit.limiter.SetBurst(max)
+		}
+	}
+
+	reset, err := strconv.Atoi(header.Get(rateLimitResetHeader))
+	if err != nil || reset <= 0 {
+		return 0
+	}
+	if resp.StatusCode != http.StatusTooManyRequests && header.Get(rateLimitRemainingHeader) != "0" {
+		return 0
+	}
+
+	resetDelay := time.Duration(reset) * time.Second
+	t.mu.Lock()
+	limit.blockedUntil = time.Now().Add(resetDelay)
+	t.mu.Unlock()
+
+	return resetDelay
+}
+
+// limit returns the rate limit of the given key, which is unlimited until its first response.
+func (t *rateLimitTransport) limit(key string) *endpointLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	limit, found := t.limits[key]
+	if !found {
+		limit = &endpointLimit{limiter: rate.NewLimiter(rate.Inf, 1)}
+		t.limits[key] = limit
+	}
+	return limit
+}
+
+// limitKey returns the key of the rate limit of the request, made of the site, a hash of the API key and the
+// endpoint, so that the rate limit of an organization doesn't delay the requests of the others. The API key is hashed
+// so that it isn't kept in memory in clear.
+func limitKey(req *http.Request, endpoint string) string {
+	apiKey := sha256.Sum256([]byte(req.Header.Get(apiKeyHeader)))
+	return req.URL.Host + "/" + hex.EncodeToString(apiKey[:8]) + "/" + endpoint
+}
+
+// backoff returns the jittered delay before the retry of the given attempt.
+func (t *rateLimitTransport) backoff(attempt int) time.Duration {
+	delay := t.backoffMax
+	if attempt < 16 {
+		if d := t.backoffBase << attempt; d < delay {
+			delay = d
+		}
+	}
+	return jitter(delay)
+}
+
+// endpointName returns the name of the endpoint of the request, made of the API version and the resource, such as
+// v1/monitor. The Datadog API rate limits apply by resource, whatever the ID in the rest of the path.
+func endpointName(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) >= 3 && segments[0] == "api" {
+		return segments[1] + "/" + segments[2]
+	}
+	return strings.Join(segments, "/")
+}
+
+// isRetryable returns true if the request should be retried: rate limited requests weren't processed, and the
+// server errors are only retried for the idempotent methods, so that a resource isn't created twice.
+func isRetryable(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.StatusCode < http.StatusInternalServerError {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// jitter returns a random delay between d and 1.5 * d, so that the clients rate limited together don't retry
+// together.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	return d + time.Duration(rand.Int63n(int64(d)/2+1))
+}
+
+func sleep(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryRequest returns a copy of the request to send it again, with a new body.
+func retryRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.Body != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
+
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
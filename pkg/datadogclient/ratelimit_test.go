@@ -0,0 +1,278 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// testResponse is a response of the test server.
+type testResponse struct {
+	code    int
+	headers map[string]string
+}
+
+func newTestTransport() *rateLimitTransport {
+	transport := newRateLimitTransport(http.DefaultTransport)
+	transport.backoffBase = time.Millisecond
+	transport.backoffMax = 10 * time.Millisecond
+	return transport
+}
+
+func Test_rateLimitTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		responses    []testResponse
+		wantCode     int
+		wantRequests int
+		wantErr      bool
+		wantRetry    time.Duration
+	}{
+		{
+			name:         "success",
+			method:       http.MethodGet,
+			responses:    []testResponse{{code: http.StatusOK}},
+			wantCode:     http.StatusOK,
+			wantRequests: 1,
+		},
+		{
+			name:   "rate limited request retried",
+			method: http.MethodPost,
+			responses: []testResponse{
+				{code: http.StatusTooManyRequests},
+				{code: http.StatusOK},
+			},
+			wantCode:     http.StatusOK,
+			wantRequests: 2,
+		},
+		{
+			name:   "rate limit reset too late",
+			method: http.MethodGet,
+			responses: []testResponse{
+				{code: http.StatusTooManyRequests, headers: map[string]string{rateLimitResetHeader: "60"}},
+			},
+			wantErr:      true,
+			wantRetry:    time.Minute,
+			wantRequests: 1,
+		},
+		{
+			name:   "rate limited after all retries",
+			method: http.MethodGet,
+			responses: []testResponse{
+				{code: http.StatusTooManyRequests},
+				{code: http.StatusTooManyRequests},
+				{code: http.StatusTooManyRequests},
+				{code: http.StatusTooManyRequests},
+			},
+			wantErr:      true,
+			wantRequests: 4,
+		},
+		{
+			name:   "server error retried",
+			method: http.MethodPut,
+			responses: []testResponse{
+				{code: http.StatusBadGateway},
+				{code: http.StatusOK},
+			},
+			wantCode:     http.StatusOK,
+			wantRequests: 2,
+		},
+		{
+			name:   "server error of a creation not retried",
+			method: http.MethodPost,
+			responses: []testResponse{
+				{code: http.StatusBadGateway},
+				{code: http.StatusOK},
+			},
+			wantCode:     http.StatusBadGateway,
+			wantRequests: 1,
+		},
+		{
+			name:   "server error after all retries",
+			method: http.MethodDelete,
+			responses: []testResponse{
+				{code: http.StatusInternalServerError},
+				{code: http.StatusInternalServerError},
+				{code: http.StatusInternalServerError},
+				{code: http.StatusInternalServerError},
+			},
+			wantCode:     http.StatusInternalServerError,
+			wantRequests: 4,
+		},
+		{
+			name:   "client error not retried",
+			method: http.MethodGet,
+			responses: []testResponse{
+				{code: http.StatusNotFound},
+				{code: http.StatusOK},
+			},
+			wantCode:     http.StatusNotFound,
+			wantRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			bodies := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				response := tt.responses[requests]
+				requests++
+				for key, value := range response.headers {
+					w.Header().Set(key, value)
+				}
+				w.WriteHeader(response.code)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newTestTransport()}
+			req, err := http.NewRequest(tt.method, server.URL+"/api/v1/monitor/1234", strings.NewReader(`{"name":"test"}`))
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+
+			assert.Equal(t, tt.wantRequests, requests)
+			for _, body := range bodies {
+				// The body is sent again with every retry
+				assert.Equal(t, `{"name":"test"}`, body)
+			}
+			if tt.wantErr {
+				require.Error(t, err)
+				retryAfter, rateLimited := RetryAfter(fmt.Errorf("error getting monitor: %w", err))
+				assert.True(t, rateLimited)
+				assert.GreaterOrEqual(t, retryAfter, tt.wantRetry)
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+		})
+	}
+}
+
+func Test_rateLimitTransport_limits(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(rateLimitLimitHeader, "100")
+		w.Header().Set(rateLimitPeriodHeader, "10")
+		w.Header().Set(rateLimitRemainingHeader, "0")
+		w.Header().Set(rateLimitResetHeader, "30")
+	}))
+	defer server.Close()
+
+	transport := newTestTransport()
+	client := &http.Client{Transport: transport}
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/monitor/1234", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// The token bucket of the endpoint follows its rate limit
+	limit := transport.limit(limitKey(req, "v1/monitor"))
+	assert.Equal(t, rate.Limit(10), limit.limiter.Limit())
+	assert.Equal(t, 100, limit.limiter.Burst())
+
+	// The endpoint is blocked until the rate limit is reset, without sending the request
+	_, err = client.Get(server.URL + "/api/v1/monitor/5678")
+	retryAfter, rateLimited := RetryAfter(err)
+	assert.True(t, rateLimited)
+	assert.Greater(t, retryAfter, 20*time.Second)
+	assert.Equal(t, 1, requests)
+
+	// The other endpoints aren't
+	resp, err = client.Get(server.URL + "/api/v1/slo")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 2, requests)
+}
+
+func Test_rateLimitTransport_limitsByAPIKey(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey := r.Header.Get(apiKeyHeader)
+		requests[apiKey]++
+		if apiKey == "rate-limited" {
+			w.Header().Set(rateLimitResetHeader, "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	transport := newTestTransport()
+	client := &http.Client{Transport: transport}
+	get := func(apiKey string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/monitor/1234", nil)
+		require.NoError(t, err)
+		req.Header.Set(apiKeyHeader, apiKey)
+		return client.Do(req)
+	}
+
+	// The organization of the first API key is rate limited until the rate limit is reset
+	_, err := get("rate-limited")
+	_, rateLimited := RetryAfter(err)
+	assert.True(t, rateLimited)
+	_, err = get("rate-limited")
+	_, rateLimited = RetryAfter(err)
+	assert.True(t, rateLimited)
+	assert.Equal(t, 1, requests["rate-limited"])
+
+	// The requests with another API key are sent right away
+	start := time.Now()
+	resp, err := get("other")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, requests["other"])
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func Test_limitKey(t *testing.T) {
+	newRequest := func(url, apiKey string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set(apiKeyHeader, apiKey)
+		return req
+	}
+	key := limitKey(newRequest("https://api.datadoghq.com/api/v1/monitor", "key"), "v1/monitor")
+
+	assert.Equal(t, key, limitKey(newRequest("https://api.datadoghq.com/api/v1/monitor/1234", "key"), "v1/monitor"))
+	assert.NotEqual(t, key, limitKey(newRequest("https://api.datadoghq.com/api/v1/monitor", "other"), "v1/monitor"))
+	assert.NotEqual(t, key, limitKey(newRequest("https://api.datadoghq.eu/api/v1/monitor", "key"), "v1/monitor"))
+	assert.NotContains(t, key, "key")
+}
+
+func Test_endpointName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/api/v1/monitor", want: "v1/monitor"},
+		{path: "/api/v1/monitor/1234", want: "v1/monitor"},
+		{path: "/api/v1/dashboard/abc-def-ghi", want: "v1/dashboard"},
+		{path: "/api/v2/downtime/00e000000-0000-1234-0000-000000000000/cancel", want: "v2/downtime"},
+		{path: "/other", want: "other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			assert.Equal(t, tt.want, endpointName(req))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package kubernetes
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// Distribution is the Kubernetes distribution the operator runs on
+type Distribution string
+
+const (
+	// UnknownDistribution is used when the distribution couldn't be detected (e.g. vanilla Kubernetes)
+	UnknownDistribution Distribution = ""
+	// OpenShiftDistribution Red Hat OpenShift
+	OpenShiftDistribution Distribution = "openshift"
+	// EKSDistribution Amazon Elastic Kubernetes Service
+	EKSDistribution Distribution = "eks"
+	// GKEDistribution Google Kubernetes Engine (Standard)
+	GKEDistribution Distribution = "gke"
+	// GKEAutopilotDistribution Google Kubernetes Engine Autopilot
+	GKEAutopilotDistribution Distribution = "gke-autopilot"
+	// AKSDistribution Azure Kubernetes Service
+	AKSDistribution Distribution = "aks"
+	// K3sDistribution Rancher k3s
+	K3sDistribution Distribution = "k3s"
+	// RKE2Distribution Rancher Kubernetes Engine 2
+	RKE2Distribution Distribution = "rke2"
+	// KindDistribution Kubernetes in Docker
+	KindDistribution Distribution = "kind"
+
+	// openShiftConfigAPIGroup is only served by OpenShift clusters
+	openShiftConfigAPIGroup = "config.openshift.io"
+	// gkeAutopilotAPIGroup is only served by GKE Autopilot clusters (workload allowlists)
+	gkeAutopilotAPIGroup = "auto.gke.io"
+
+	// server version markers, e.g. `v1.28.3-eks-4f4795d`, `v1.28.3-gke.1203001`, `v1.28.3+k3s2`, `v1.28.3+rke2r1`
+	eksVersionMarker  = "-eks-"
+	gkeVersionMarker  = "-gke."
+	k3sVersionMarker  = "+k3s"
+	rke2VersionMarker = "+rke2"
+
+	// node labels and provider IDs set by the distributions
+	eksNodeLabelPrefix = "eks.amazonaws.com/"
+	gkeNodeLabelPrefix = "cloud.google.com/gke-"
+	aksNodeLabel       = "kubernetes.azure.com/cluster"
+	kindProviderID     = "kind://"
+)
+
+// DetectDistribution detects the Kubernetes distribution from the server version, the API groups
+// served by the API server and the nodes. Nodes are optional, as their labels and provider IDs only
+// help to detect distributions whose server version isn't specific (AKS, kind).
+func DetectDistribution(versionInfo *version.Info, groups []*metav1.APIGroup, nodes []corev1.Node) Distribution {
+	// API groups are the most reliable source, as they can't be set by users on nodes
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		switch group.Name {
+		case openShiftConfigAPIGroup:
+			return OpenShiftDistribution
+		case gkeAutopilotAPIGroup:
+			return GKEAutopilotDistribution
+		}
+	}
+
+	if versionInfo != nil {
+		gitVersion := versionInfo.GitVersion
+		switch {
+		case strings.Contains(gitVersion, eksVersionMarker):
+			return EKSDistribution
+		case strings.Contains(gitVersion, gkeVersionMarker):
+			return GKEDistribution
+		case strings.Contains(gitVersion, k3sVersionMarker):
+			return K3sDistribution
+		case strings.Contains(gitVersion, rke2VersionMarker):
+			return RKE2Distribution
+		}
+	}
+
+	for _, node := range nodes {
+		if strings.HasPrefix(node.Spec.ProviderID, kindProviderID) {
+			return KindDistribution
+		}
+		if _, ok := node.Labels[aksNodeLabel]; ok {
+			return AKSDistribution
+		}
+		for label := range node.Labels {
+			if strings.HasPrefix(label, eksNodeLabelPrefix) {
+				return EKSDistribution
+			}
+			if strings.HasPrefix(label, gkeNodeLabelPrefix) {
+				return GKEDistribution
+			}
+		}
+	}
+
+	return UnknownDistribution
+}
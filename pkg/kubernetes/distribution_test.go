@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func Test_DetectDistribution(t *testing.T) {
+	tests := []struct {
+		name         string
+		gitVersion   string
+		groups       []string
+		nodes        []corev1.Node
+		distribution Distribution
+	}{
+		{
+			name:         "vanilla kubernetes",
+			gitVersion:   "v1.30.2",
+			groups:       []string{"apps", "policy"},
+			nodes:        []corev1.Node{newDistributionNode("", map[string]string{"foo": "bar"})},
+			distribution: UnknownDistribution,
+		},
+		{
+			name:         "openshift api group",
+			gitVersion:   "v1.29.6+aba1e8d",
+			groups:       []string{"apps", "config.openshift.io", "security.openshift.io"},
+			distribution: OpenShiftDistribution,
+		},
+		{
+			name:         "gke autopilot api group wins over gke version",
+			gitVersion:   "v1.30.3-gke.1639000",
+			groups:       []string{"apps", "auto.gke.io"},
+			distribution: GKEAutopilotDistribution,
+		},
+		{
+			name:         "gke version",
+			gitVersion:   "v1.30.3-gke.1639000",
+			distribution: GKEDistribution,
+		},
+		{
+			name:         "eks version",
+			gitVersion:   "v1.30.4-eks-a737599",
+			distribution: EKSDistribution,
+		},
+		{
+			name:         "k3s version",
+			gitVersion:   "v1.30.4+k3s1",
+			distribution: K3sDistribution,
+		},
+		{
+			name:         "rke2 version",
+			gitVersion:   "v1.30.4+rke2r1",
+			distribution: RKE2Distribution,
+		},
+		{
+			name:         "aks node label",
+			gitVersion:   "v1.30.3",
+			nodes:        []corev1.Node{newDistributionNode("azure:///subscriptions/foo", map[string]string{"kubernetes.azure.com/cluster": "MC_foo"})},
+			distribution: AKSDistribution,
+		},
+		{
+			name:         "kind provider id",
+			gitVersion:   "v1.30.0",
+			nodes:        []corev1.Node{newDistributionNode("kind://docker/kind/kind-control-plane", nil)},
+			distribution: KindDistribution,
+		},
+		{
+			name:         "eks node label",
+			gitVersion:   "v1.30.0",
+			nodes:        []corev1.Node{newDistributionNode("", map[string]string{"eks.amazonaws.com/nodegroup": "default"})},
+			distribution: EKSDistribution,
+		},
+		{
+			name:         "gke node label",
+			gitVersion:   "v1.30.0",
+			nodes:        []corev1.Node{newDistributionNode("", map[string]string{"cloud.google.com/gke-nodepool": "default-pool"})},
+			distribution: GKEDistribution,
+		},
+		{
+			name:         "no discovery data",
+			distribution: UnknownDistribution,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var versionInfo *version.Info
+			if tt.gitVersion != "" {
+				versionInfo = &version.Info{GitVersion: tt.gitVersion}
+			}
+			groups := make([]*metav1.APIGroup, 0, len(tt.groups))
+			for _, name := range tt.groups {
+				groups = append(groups, &metav1.APIGroup{Name: name})
+			}
+
+			assert.Equal(t, tt.distribution, DetectDistribution(versionInfo, groups, tt.nodes))
+		})
+	}
+}
+
+func Test_PlatformInfoDistribution(t *testing.T) {
+	platformInfo := NewPlatformInfoFromVersionMaps(nil, nil, nil)
+	assert.Equal(t, UnknownDistribution, platformInfo.GetDistribution())
+
+	platformInfo.SetDistribution(AKSDistribution)
+	assert.Equal(t, AKSDistribution, platformInfo.GetDistribution())
+}
+
+func newDistributionNode(providerID string, labels map[string]string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node",
+			Labels: labels,
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: providerID,
+		},
+	}
+}
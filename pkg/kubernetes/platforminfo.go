@@ -12,6 +12,7 @@ type PlatformInfo struct {
 	versionInfo          *version.Info
 	apiPreferredVersions map[string]string
 	apiOtherVersions     map[string]string
+	distribution         Distribution
 }
 
 func NewPlatformInfo(versionInfo *version.Info, groups []*v1.APIGroup, resources []*v1.APIResourceList) PlatformInfo {
@@ -120,3 +121,16 @@ func (platformInfo *PlatformInfo) GetApiVersions(name string) (preferred string,
 	other = platformInfo.apiOtherVersions[name]
 	return preferred, other
 }
+
+// GetDistribution returns the detected Kubernetes distribution, empty if unknown
+func (platformInfo *PlatformInfo) GetDistribution() Distribution {
+	if platformInfo == nil {
+		return UnknownDistribution
+	}
+	return platformInfo.distribution
+}
+
+// SetDistribution sets the detected Kubernetes distribution
+func (platformInfo *PlatformInfo) SetDistribution(distribution Distribution) {
+	platformInfo.distribution = distribution
+}
@@ -6,6 +6,7 @@
 package kubernetes
 
 import (
+	"path/filepath"
 	"sort"
 	"strings"
 
@@ -31,25 +32,208 @@ const (
 
 	// GKEProviderLabel is the GKE node label used to determine the node's provider
 	GKEProviderLabel = "cloud.google.com/gke-os-distribution"
+
+	// AKS provider types: https://learn.microsoft.com/en-us/azure/aks/cluster-configuration#os-configuration
+	// AKSUbuntuType is the Ubuntu node image offered by AKS
+	AKSUbuntuType = "Ubuntu"
+	// AKSAzureLinuxType is the Azure Linux node image offered by AKS
+	AKSAzureLinuxType = "AzureLinux"
+
+	// AKSCloudProvider AKS CloudProvider name
+	AKSCloudProvider = "aks"
+
+	// AKSProviderLabel is the AKS node label used to determine the node's provider
+	AKSProviderLabel = "kubernetes.azure.com/os-sku"
+
+	// OpenShift provider types
+	// OpenShiftRHCOSType is the Red Hat Enterprise Linux CoreOS node image used by OpenShift
+	OpenShiftRHCOSType = "rhcos"
+
+	// OpenShiftCloudProvider OpenShift CloudProvider name
+	OpenShiftCloudProvider = "openshift"
+
+	// OpenShiftProviderLabel is the OpenShift node label used to determine the node's provider
+	OpenShiftProviderLabel = "node.openshift.io/os_id"
+
+	// OS provider types, matching the `ID` field of the node's /etc/os-release
+	// BottlerocketOSType is the Bottlerocket OS node image offered by EKS
+	BottlerocketOSType = "bottlerocket"
+	// AmazonLinuxOSType is the Amazon Linux (AL2023) node image offered by EKS
+	AmazonLinuxOSType = "amzn"
+	// FlatcarOSType is the Flatcar Container Linux node image
+	FlatcarOSType = "flatcar"
+	// TalosOSType is the Talos Linux node image
+	TalosOSType = "talos"
+
+	// OSCloudProvider is the provider name used for nodes detected through their OS
+	OSCloudProvider = "os"
+
+	// OSProviderLabel is the Node Feature Discovery node label used to determine the node's OS.
+	// Bottlerocket, Amazon Linux, Flatcar and Talos nodes don't carry a distribution label on their own.
+	// See https://kubernetes-sigs.github.io/node-feature-discovery/stable/usage/features.html#system
+	OSProviderLabel = "feature.node.kubernetes.io/system-os_release.ID"
+
+	// Container runtime provider types, matching the runtime reported in the node's `status.nodeInfo.containerRuntimeVersion`
+	// ContainerdRuntimeType is the containerd container runtime
+	ContainerdRuntimeType = "containerd"
+	// CRIORuntimeType is the CRI-O container runtime
+	CRIORuntimeType = "cri-o"
+	// DockerRuntimeType is the Docker container runtime
+	DockerRuntimeType = "docker"
+
+	// RuntimeCloudProvider is the provider name used for nodes detected through their container runtime
+	RuntimeCloudProvider = "runtime"
+
+	// runtimeProviderSeparator separates the provider of a node from its container runtime in the provider name,
+	// e.g. `gke-cos_runtime-docker`
+	runtimeProviderSeparator = "_"
+
+	// RuntimeProviderLabel is the node label set by the operator with the node's container runtime.
+	// It is only set when the nodes of the cluster use different container runtimes.
+	RuntimeProviderLabel = "agent.datadoghq.com/container-runtime"
+
+	// containerdSocketPath is the default containerd socket path on the host
+	containerdSocketPath = "/var/run/containerd/containerd.sock"
+	// crioSocketPath is the default CRI-O socket path on the host
+	crioSocketPath = "/var/run/crio/crio.sock"
+	// bottlerocketSocketPath is the containerd socket path on Bottlerocket hosts
+	bottlerocketSocketPath = "/var/run/dockershim.sock"
+	// dockerSocketPath is the default Docker socket path on the host
+	dockerSocketPath = "/var/run/docker.sock"
 )
 
-// ProviderValue allowlist
-var providerValueAllowlist = map[string]struct{}{
-	GKECosType: {},
+// providerLabels maps each cloud provider to the node label used to detect it.
+// The order matters: the first label found on a node determines its provider, except for the container runtime label
+// which is added to it.
+var providerLabels = []struct {
+	cloudProvider string
+	label         string
+}{
+	{cloudProvider: GKECloudProvider, label: GKEProviderLabel},
+	{cloudProvider: AKSCloudProvider, label: AKSProviderLabel},
+	{cloudProvider: OpenShiftCloudProvider, label: OpenShiftProviderLabel},
+	{cloudProvider: OSCloudProvider, label: OSProviderLabel},
+	{cloudProvider: RuntimeCloudProvider, label: RuntimeProviderLabel},
+}
+
+// ProviderValue allowlist, by cloud provider
+var providerValueAllowlist = map[string]map[string]struct{}{
+	GKECloudProvider: {
+		GKECosType: {},
+	},
+	AKSCloudProvider: {
+		AKSUbuntuType:     {},
+		AKSAzureLinuxType: {},
+	},
+	OpenShiftCloudProvider: {
+		OpenShiftRHCOSType: {},
+	},
+	OSCloudProvider: {
+		BottlerocketOSType: {},
+		AmazonLinuxOSType:  {},
+		FlatcarOSType:      {},
+		TalosOSType:        {},
+	},
+	RuntimeCloudProvider: {
+		ContainerdRuntimeType: {},
+		CRIORuntimeType:       {},
+		DockerRuntimeType:     {},
+	},
+}
+
+// providerDefaults holds the node Agent settings that differ from the operator defaults on a provider
+type providerDefaults struct {
+	// criSocketPath is the container runtime socket path on the host. It must be located under
+	// the runtime socket directory (`/var/run`) which is always mounted in the Agent containers.
+	criSocketPath string
+	// dockerSocketPath is the Docker socket path on the host, with the same constraints as criSocketPath.
+	dockerSocketPath string
+	// unavailableHostPaths lists the host paths that don't exist (or are empty) on immutable OSes,
+	// and that should not be mounted in the Agent containers.
+	unavailableHostPaths []string
+	// runtime is the container runtime of the socket defaults. The socket defaults of another container runtime
+	// detected on the node take precedence over them.
+	runtime string
+}
+
+// providerDefaultsMapping maps a provider value to its defaults
+var providerDefaultsMapping = map[string]providerDefaults{
+	GKECosType: {
+		unavailableHostPaths: []string{apicommon.SrcVolumePath},
+	},
+	AKSUbuntuType: {
+		criSocketPath: containerdSocketPath,
+		runtime:       ContainerdRuntimeType,
+	},
+	AKSAzureLinuxType: {
+		criSocketPath: containerdSocketPath,
+		runtime:       ContainerdRuntimeType,
+	},
+	OpenShiftRHCOSType: {
+		criSocketPath: crioSocketPath,
+		runtime:       CRIORuntimeType,
+	},
+	BottlerocketOSType: {
+		criSocketPath:        bottlerocketSocketPath,
+		unavailableHostPaths: []string{apicommon.SrcVolumePath},
+		runtime:              ContainerdRuntimeType,
+	},
+	AmazonLinuxOSType: {
+		criSocketPath: containerdSocketPath,
+		runtime:       ContainerdRuntimeType,
+	},
+	FlatcarOSType: {
+		criSocketPath:        containerdSocketPath,
+		unavailableHostPaths: []string{apicommon.SrcVolumePath},
+		runtime:              ContainerdRuntimeType,
+	},
+	TalosOSType: {
+		criSocketPath:        containerdSocketPath,
+		unavailableHostPaths: []string{apicommon.SrcVolumePath},
+		runtime:              ContainerdRuntimeType,
+	},
+	ContainerdRuntimeType: {
+		criSocketPath: containerdSocketPath,
+	},
+	CRIORuntimeType: {
+		criSocketPath: crioSocketPath,
+	},
+	DockerRuntimeType: {
+		dockerSocketPath: dockerSocketPath,
+	},
 }
 
-// determineProvider creates a Provider based on a map of labels
+// determineProvider creates a Provider based on a map of labels. The container runtime is added to the provider
+// detected through the other labels, so that the nodes of a provider running different runtimes are split.
 func determineProvider(labels map[string]string) string {
-	if len(labels) > 0 {
-		// GKE
-		if val, ok := labels[GKEProviderLabel]; ok {
-			if provider := generateValidProviderName(GKECloudProvider, val); provider != "" {
-				return provider
-			}
+	var parts []string
+	for _, pl := range providerLabels {
+		if pl.cloudProvider == RuntimeCloudProvider {
+			continue
+		}
+		if provider := generateValidProviderName(pl.cloudProvider, labels[pl.label]); provider != "" {
+			parts = append(parts, provider)
+			break
 		}
 	}
+	if provider := generateValidProviderName(RuntimeCloudProvider, labels[RuntimeProviderLabel]); provider != "" {
+		parts = append(parts, provider)
+	}
+
+	if len(parts) == 0 {
+		return DefaultProvider
+	}
+	return strings.Join(parts, runtimeProviderSeparator)
+}
 
-	return DefaultProvider
+// splitProvider splits a provider into the provider detected through the labels other than the container runtime
+// one, and the container runtime provider. Either can be empty.
+func splitProvider(provider string) (string, string) {
+	base, runtime, _ := strings.Cut(provider, runtimeProviderSeparator)
+	if cp, _ := splitProviderSuffix(base); cp == RuntimeCloudProvider {
+		return "", base
+	}
+	return base, runtime
 }
 
 // getProviderNodeAffinity creates NodeSelectorTerms based on the provider
@@ -62,35 +246,49 @@ func getProviderNodeAffinity(provider string, providerList map[string]struct{})
 		return nil
 	}
 
-	// default provider has NodeAffinity to NOT match provider-specific labels
-	// build NodeSelectorRequirement list with negative (`OpNotIn`) operator
+	// a provider matches the labels of its parts, and not the ones of the other providers that its nodes could also
+	// carry: the default provider matches none of them, a provider without container runtime matches none of the
+	// runtimes of the other providers, and a runtime-only provider none of the providers of the other runtimes.
 	nsrList := []corev1.NodeSelectorRequirement{}
-	if provider == DefaultProvider {
-		// sort providers to get consistently ordered affinity
-		sortedProviders := sortProviders(providerList)
-		for _, providerDef := range sortedProviders {
-			key, value := GetProviderLabelKeyValue(providerDef)
-			if key != "" && value != "" {
+	base, runtime := splitProvider(provider)
+	if provider != DefaultProvider {
+		for _, part := range []string{base, runtime} {
+			if key, value := GetProviderLabelKeyValue(part); key != "" && value != "" {
 				nsrList = append(nsrList, corev1.NodeSelectorRequirement{
 					Key:      key,
-					Operator: corev1.NodeSelectorOpNotIn,
-					Values: []string{
-						value,
-					},
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{value},
 				})
 			}
 		}
-	} else {
-		// create provider-specific NodeSelectorTerm for NodeAffinity
-		key, value := GetProviderLabelKeyValue(provider)
-		if key != "" && value != "" {
-			nsrList = append(nsrList, corev1.NodeSelectorRequirement{
-				Key:      key,
-				Operator: corev1.NodeSelectorOpIn,
-				Values: []string{
-					value,
-				},
-			})
+	}
+
+	excluded := map[string]struct{}{}
+	// sort providers to get consistently ordered affinity
+	for _, other := range sortProviders(providerList) {
+		if other == provider {
+			continue
+		}
+		otherBase, otherRuntime := splitProvider(other)
+		var parts []string
+		if base == "" || provider == DefaultProvider {
+			parts = append(parts, otherBase)
+		}
+		if runtime == "" {
+			parts = append(parts, otherRuntime)
+		}
+		for _, part := range parts {
+			if _, found := excluded[part]; found {
+				continue
+			}
+			if key, value := GetProviderLabelKeyValue(part); key != "" && value != "" {
+				excluded[part] = struct{}{}
+				nsrList = append(nsrList, corev1.NodeSelectorRequirement{
+					Key:      key,
+					Operator: corev1.NodeSelectorOpNotIn,
+					Values:   []string{value},
+				})
+			}
 		}
 	}
 
@@ -109,33 +307,31 @@ func getProviderNodeAffinity(provider string, providerList map[string]struct{})
 
 // generateValidProviderName creates a provider name from the cloud provider
 // and provider value. NOTE: this should not be used to create a resource name
-// as it may contain underscores
+// as it may contain underscores or uppercase letters
 func generateValidProviderName(cloudProvider, providerValue string) string {
-	if isProviderValueAllowed(providerValue) {
+	if isProviderValueAllowed(cloudProvider, providerValue) {
 		return cloudProvider + "-" + providerValue
 	}
 	return ""
 }
 
 // isProviderValueAllowed returns whether the value of a provider is present
-// in the allowlist
-func isProviderValueAllowed(value string) bool {
-	if _, ok := providerValueAllowlist[value]; ok {
+// in the allowlist of its cloud provider
+func isProviderValueAllowed(cloudProvider, value string) bool {
+	if _, ok := providerValueAllowlist[cloudProvider][value]; ok {
 		return true
 	}
 	return false
 }
 
 // GetProviderLabelKeyValue gets the corresponding cloud provider label key and value from a provider name
+// without a runtime part (see splitProvider)
 func GetProviderLabelKeyValue(provider string) (string, string) {
-	// cloud provider to label mapping
-	providerMapping := map[string]string{
-		GKECloudProvider: GKEProviderLabel,
-	}
-
 	cp, value := splitProviderSuffix(provider)
-	if label, ok := providerMapping[cp]; ok {
-		return label, value
+	for _, pl := range providerLabels {
+		if pl.cloudProvider == cp {
+			return pl.label, value
+		}
 	}
 	return "", ""
 }
@@ -171,10 +367,73 @@ func ComponentOverrideFromProvider(overrideName, provider string, providerList m
 	}
 }
 
+// DefaultComponentOverrideFromProvider generates a componentOverride with the provider-specific
+// defaults, such as the container runtime socket. It returns nil if the provider doesn't have any.
+// It should be applied before the user overrides, so they keep precedence over the provider defaults.
+func DefaultComponentOverrideFromProvider(provider string, config *v2alpha1.GlobalConfig) *v2alpha1.DatadogAgentComponentOverride {
+	defaults, ok := getProviderDefaults(provider)
+	if !ok {
+		return nil
+	}
+
+	// a runtime socket configured in the GlobalConfig takes precedence over the provider one
+	userDefinedSocket := config != nil && (config.DockerSocketPath != nil || config.CriSocketPath != nil)
+	if userDefinedSocket {
+		return nil
+	}
+
+	var envVars []corev1.EnvVar
+	if defaults.dockerSocketPath != "" {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  apicommon.DockerHost,
+			Value: "unix://" + filepath.Join(apicommon.HostCriSocketPathPrefix, defaults.dockerSocketPath),
+		})
+	} else if defaults.criSocketPath != "" {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  apicommon.DDCriSocketPath,
+			Value: filepath.Join(apicommon.HostCriSocketPathPrefix, defaults.criSocketPath),
+		})
+	}
+	if len(envVars) == 0 {
+		return nil
+	}
+
+	return &v2alpha1.DatadogAgentComponentOverride{
+		Env: envVars,
+	}
+}
+
+// getProviderDefaults returns the defaults of a provider, and false if it doesn't have any. The socket defaults of its
+// container runtime take precedence over the ones of the rest of the provider, unless they are for the same runtime.
+func getProviderDefaults(provider string) (providerDefaults, bool) {
+	base, runtime := splitProvider(provider)
+	_, baseValue := GetProviderLabelKeyValue(base)
+	_, runtimeValue := GetProviderLabelKeyValue(runtime)
+	defaults, baseFound := providerDefaultsMapping[baseValue]
+	runtimeDefaults, runtimeFound := providerDefaultsMapping[runtimeValue]
+	if runtimeFound && defaults.runtime != runtimeValue {
+		defaults.criSocketPath = runtimeDefaults.criSocketPath
+		defaults.dockerSocketPath = runtimeDefaults.dockerSocketPath
+	}
+	return defaults, baseFound || runtimeFound
+}
+
+// IsHostPathAvailable returns whether a host path can be mounted in the Agent containers on a provider
+func IsHostPathAvailable(provider, hostPath string) bool {
+	defaults, _ := getProviderDefaults(provider)
+	for _, path := range defaults.unavailableHostPaths {
+		if path == hostPath {
+			return false
+		}
+	}
+	return true
+}
+
 // GetAgentNameWithProvider returns the agent name based on the ds name and provider
 func GetAgentNameWithProvider(overrideDSName, provider string) string {
 	if provider != "" && overrideDSName != "" {
-		return overrideDSName + "-" + strings.Replace(provider, "_", "-", -1)
+		// provider values can contain uppercase letters and underscores, which are not valid in a resource name
+		return overrideDSName + "-" + strings.ToLower(strings.Replace(provider, "_", "-", -1))
 	}
 	return overrideDSName
 }
@@ -191,3 +450,38 @@ func GetProviderListFromNodeList(nodeList []corev1.Node, logger logr.Logger) map
 	}
 	return providerList
 }
+
+// GetContainerRuntimeFromNode returns the container runtime of a node, based on its
+// `status.nodeInfo.containerRuntimeVersion` (e.g. `containerd` for `containerd://1.7.2`)
+func GetContainerRuntimeFromNode(node *corev1.Node) string {
+	runtime, _, found := strings.Cut(node.Status.NodeInfo.ContainerRuntimeVersion, "://")
+	if !found {
+		return ""
+	}
+	return runtime
+}
+
+// GetContainerRuntimeLabelByNode returns the expected value of the container runtime label for each node.
+// Nodes are only labeled when the cluster runs more than one supported container runtime, otherwise
+// a single DaemonSet is enough and the value is empty.
+func GetContainerRuntimeLabelByNode(nodeList []corev1.Node) map[string]string {
+	runtimeByNode := make(map[string]string, len(nodeList))
+	runtimes := make(map[string]struct{})
+	for i := range nodeList {
+		runtime := GetContainerRuntimeFromNode(&nodeList[i])
+		if !isProviderValueAllowed(RuntimeCloudProvider, runtime) {
+			runtime = ""
+		}
+		runtimeByNode[nodeList[i].Name] = runtime
+		if runtime != "" {
+			runtimes[runtime] = struct{}{}
+		}
+	}
+
+	if len(runtimes) < 2 {
+		for nodeName := range runtimeByNode {
+			runtimeByNode[nodeName] = ""
+		}
+	}
+	return runtimeByNode
+}
@@ -6,15 +6,28 @@
 package kubernetes
 
 import (
+	"github.com/go-logr/logr"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
+	"github.com/DataDog/datadog-operator/api/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/api/utils"
 )
 
 var (
-	defaultProvider = DefaultProvider
-	gkeCosProvider  = generateValidProviderName(GKECloudProvider, GKECosType)
+	defaultProvider       = DefaultProvider
+	gkeCosProvider        = generateValidProviderName(GKECloudProvider, GKECosType)
+	aksAzureLinuxProvider = generateValidProviderName(AKSCloudProvider, AKSAzureLinuxType)
+	openShiftProvider     = generateValidProviderName(OpenShiftCloudProvider, OpenShiftRHCOSType)
+	bottlerocketProvider  = generateValidProviderName(OSCloudProvider, BottlerocketOSType)
+	talosProvider         = generateValidProviderName(OSCloudProvider, TalosOSType)
+	gkeCosContainerd      = gkeCosProvider + "_runtime-containerd"
+	gkeCosDocker          = gkeCosProvider + "_runtime-docker"
+	runtimeDocker         = generateValidProviderName(RuntimeCloudProvider, DockerRuntimeType)
 )
 
 func Test_determineProvider(t *testing.T) {
@@ -43,6 +56,81 @@ func Test_determineProvider(t *testing.T) {
 			},
 			provider: generateValidProviderName(GKECloudProvider, GKECosType),
 		},
+		{
+			name: "aks provider",
+			labels: map[string]string{
+				"foo":            "bar",
+				AKSProviderLabel: AKSUbuntuType,
+			},
+			provider: "aks-Ubuntu",
+		},
+		{
+			name: "aks provider, unknown os sku",
+			labels: map[string]string{
+				AKSProviderLabel: "Windows2022",
+			},
+			provider: defaultProvider,
+		},
+		{
+			name: "openshift provider",
+			labels: map[string]string{
+				OpenShiftProviderLabel: OpenShiftRHCOSType,
+			},
+			provider: openShiftProvider,
+		},
+		{
+			name: "bottlerocket provider",
+			labels: map[string]string{
+				OSProviderLabel: BottlerocketOSType,
+			},
+			provider: bottlerocketProvider,
+		},
+		{
+			name: "container runtime provider",
+			labels: map[string]string{
+				RuntimeProviderLabel: CRIORuntimeType,
+			},
+			provider: "runtime-cri-o",
+		},
+		{
+			name: "container runtime label added to os label",
+			labels: map[string]string{
+				OSProviderLabel:      FlatcarOSType,
+				RuntimeProviderLabel: CRIORuntimeType,
+			},
+			provider: "os-flatcar_runtime-cri-o",
+		},
+		{
+			name: "container runtime label added to gke label",
+			labels: map[string]string{
+				GKEProviderLabel:     GKECosType,
+				RuntimeProviderLabel: DockerRuntimeType,
+			},
+			provider: gkeCosDocker,
+		},
+		{
+			name: "unknown container runtime",
+			labels: map[string]string{
+				GKEProviderLabel:     GKECosType,
+				RuntimeProviderLabel: "foo",
+			},
+			provider: gkeCosProvider,
+		},
+		{
+			name: "gke label takes precedence over os label",
+			labels: map[string]string{
+				GKEProviderLabel: GKECosType,
+				OSProviderLabel:  "cos",
+			},
+			provider: gkeCosProvider,
+		},
+		{
+			name: "value allowed for another cloud provider",
+			labels: map[string]string{
+				GKEProviderLabel: TalosOSType,
+			},
+			provider: defaultProvider,
+		},
 	}
 
 	for _, tt := range tests {
@@ -55,30 +143,52 @@ func Test_determineProvider(t *testing.T) {
 
 func Test_isProviderValueAllowed(t *testing.T) {
 	tests := []struct {
-		name  string
-		value string
-		want  bool
+		name          string
+		cloudProvider string
+		value         string
+		want          bool
 	}{
 		{
-			name:  "valid value",
-			value: GKECosType,
-			want:  true,
+			name:          "valid value",
+			cloudProvider: GKECloudProvider,
+			value:         GKECosType,
+			want:          true,
+		},
+		{
+			name:          "valid os value",
+			cloudProvider: OSCloudProvider,
+			value:         FlatcarOSType,
+			want:          true,
+		},
+		{
+			name:          "value of another cloud provider",
+			cloudProvider: AKSCloudProvider,
+			value:         GKECosType,
+			want:          false,
+		},
+		{
+			name:          "unknown cloud provider",
+			cloudProvider: "foo",
+			value:         GKECosType,
+			want:          false,
 		},
 		{
-			name:  "invalid value",
-			value: "foo",
-			want:  false,
+			name:          "invalid value",
+			cloudProvider: GKECloudProvider,
+			value:         "foo",
+			want:          false,
 		},
 		{
-			name:  "empty value",
-			value: "",
-			want:  false,
+			name:          "empty value",
+			cloudProvider: GKECloudProvider,
+			value:         "",
+			want:          false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			allowed := isProviderValueAllowed(tt.value)
+			allowed := isProviderValueAllowed(tt.cloudProvider, tt.value)
 			assert.Equal(t, tt.want, allowed)
 		})
 	}
@@ -314,6 +424,98 @@ func Test_getProviderNodeAffinity(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "gke providers with different runtimes",
+			existingProviders: map[string]struct{}{
+				gkeCosContainerd: {},
+				gkeCosDocker:     {},
+			},
+			provider: gkeCosDocker,
+			wantAffinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: GKEProviderLabel, Operator: corev1.NodeSelectorOpIn, Values: []string{GKECosType}},
+									{Key: RuntimeProviderLabel, Operator: corev1.NodeSelectorOpIn, Values: []string{DockerRuntimeType}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "provider without runtime, other providers with runtimes",
+			existingProviders: map[string]struct{}{
+				gkeCosProvider:   {},
+				gkeCosContainerd: {},
+				runtimeDocker:    {},
+			},
+			provider: gkeCosProvider,
+			wantAffinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: GKEProviderLabel, Operator: corev1.NodeSelectorOpIn, Values: []string{GKECosType}},
+									{Key: RuntimeProviderLabel, Operator: corev1.NodeSelectorOpNotIn, Values: []string{ContainerdRuntimeType}},
+									{Key: RuntimeProviderLabel, Operator: corev1.NodeSelectorOpNotIn, Values: []string{DockerRuntimeType}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "runtime provider, other providers with runtimes",
+			existingProviders: map[string]struct{}{
+				gkeCosDocker:  {},
+				runtimeDocker: {},
+			},
+			provider: runtimeDocker,
+			wantAffinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: RuntimeProviderLabel, Operator: corev1.NodeSelectorOpIn, Values: []string{DockerRuntimeType}},
+									{Key: GKEProviderLabel, Operator: corev1.NodeSelectorOpNotIn, Values: []string{GKECosType}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "default provider, other providers with runtimes",
+			existingProviders: map[string]struct{}{
+				defaultProvider:  {},
+				gkeCosContainerd: {},
+				gkeCosDocker:     {},
+			},
+			provider: defaultProvider,
+			wantAffinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: GKEProviderLabel, Operator: corev1.NodeSelectorOpNotIn, Values: []string{GKECosType}},
+									{Key: RuntimeProviderLabel, Operator: corev1.NodeSelectorOpNotIn, Values: []string{ContainerdRuntimeType}},
+									{Key: RuntimeProviderLabel, Operator: corev1.NodeSelectorOpNotIn, Values: []string{DockerRuntimeType}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -367,6 +569,18 @@ func Test_GetProviderLabelKeyValue(t *testing.T) {
 			wantLabel: GKEProviderLabel,
 			wantValue: GKECosType,
 		},
+		{
+			name:      "aks azure linux provider",
+			provider:  aksAzureLinuxProvider,
+			wantLabel: AKSProviderLabel,
+			wantValue: AKSAzureLinuxType,
+		},
+		{
+			name:      "talos provider",
+			provider:  talosProvider,
+			wantLabel: OSProviderLabel,
+			wantValue: TalosOSType,
+		},
 	}
 
 	for _, tt := range tests {
@@ -408,6 +622,12 @@ func Test_GetAgentNameWithProvider(t *testing.T) {
 			provider:     "",
 			want:         "",
 		},
+		{
+			name:         "override name set, provider with uppercase letters",
+			overrideName: "foo",
+			provider:     aksAzureLinuxProvider,
+			want:         "foo-aks-azurelinux",
+		},
 	}
 
 	for _, tt := range tests {
@@ -417,3 +637,282 @@ func Test_GetAgentNameWithProvider(t *testing.T) {
 		})
 	}
 }
+
+func Test_DefaultComponentOverrideFromProvider(t *testing.T) {
+	tests := []struct {
+		name         string
+		provider     string
+		config       *v2alpha1.GlobalConfig
+		wantOverride *v2alpha1.DatadogAgentComponentOverride
+	}{
+		{
+			name:         "default provider",
+			provider:     defaultProvider,
+			wantOverride: nil,
+		},
+		{
+			name:         "provider without runtime socket default",
+			provider:     gkeCosProvider,
+			wantOverride: nil,
+		},
+		{
+			name:     "provider without runtime socket default, with runtime",
+			provider: gkeCosDocker,
+			wantOverride: &v2alpha1.DatadogAgentComponentOverride{
+				Env: []corev1.EnvVar{
+					{
+						Name:  apicommon.DockerHost,
+						Value: "unix:///host/var/run/docker.sock",
+					},
+				},
+			},
+		},
+		{
+			name:     "provider with the socket default of its runtime",
+			provider: bottlerocketProvider + "_runtime-containerd",
+			wantOverride: &v2alpha1.DatadogAgentComponentOverride{
+				Env: []corev1.EnvVar{
+					{
+						Name:  apicommon.DDCriSocketPath,
+						Value: "/host/var/run/dockershim.sock",
+					},
+				},
+			},
+		},
+		{
+			name:     "openshift provider",
+			provider: openShiftProvider,
+			wantOverride: &v2alpha1.DatadogAgentComponentOverride{
+				Env: []corev1.EnvVar{
+					{
+						Name:  apicommon.DDCriSocketPath,
+						Value: "/host/var/run/crio/crio.sock",
+					},
+				},
+			},
+		},
+		{
+			name:     "bottlerocket provider",
+			provider: bottlerocketProvider,
+			config:   &v2alpha1.GlobalConfig{},
+			wantOverride: &v2alpha1.DatadogAgentComponentOverride{
+				Env: []corev1.EnvVar{
+					{
+						Name:  apicommon.DDCriSocketPath,
+						Value: "/host/var/run/dockershim.sock",
+					},
+				},
+			},
+		},
+		{
+			name:     "docker runtime provider",
+			provider: generateValidProviderName(RuntimeCloudProvider, DockerRuntimeType),
+			wantOverride: &v2alpha1.DatadogAgentComponentOverride{
+				Env: []corev1.EnvVar{
+					{
+						Name:  apicommon.DockerHost,
+						Value: "unix:///host/var/run/docker.sock",
+					},
+				},
+			},
+		},
+		{
+			name:     "bottlerocket provider, runtime socket set in global config",
+			provider: bottlerocketProvider,
+			config: &v2alpha1.GlobalConfig{
+				CriSocketPath: apiutils.NewStringPointer("/run/containerd/containerd.sock"),
+			},
+			wantOverride: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			override := DefaultComponentOverrideFromProvider(tt.provider, tt.config)
+			assert.Equal(t, tt.wantOverride, override)
+		})
+	}
+}
+
+func Test_IsHostPathAvailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		hostPath string
+		want     bool
+	}{
+		{
+			name:     "legacy provider",
+			provider: LegacyProvider,
+			hostPath: apicommon.SrcVolumePath,
+			want:     true,
+		},
+		{
+			name:     "default provider",
+			provider: defaultProvider,
+			hostPath: apicommon.SrcVolumePath,
+			want:     true,
+		},
+		{
+			name:     "cos provider, src path",
+			provider: gkeCosProvider,
+			hostPath: apicommon.SrcVolumePath,
+			want:     false,
+		},
+		{
+			name:     "talos provider, src path",
+			provider: talosProvider,
+			hostPath: apicommon.SrcVolumePath,
+			want:     false,
+		},
+		{
+			name:     "talos provider, other path",
+			provider: talosProvider,
+			hostPath: apicommon.ModulesVolumePath,
+			want:     true,
+		},
+		{
+			name:     "cos provider with runtime, src path",
+			provider: gkeCosContainerd,
+			hostPath: apicommon.SrcVolumePath,
+			want:     false,
+		},
+		{
+			name:     "openshift provider, src path",
+			provider: openShiftProvider,
+			hostPath: apicommon.SrcVolumePath,
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsHostPathAvailable(tt.provider, tt.hostPath))
+		})
+	}
+}
+
+func Test_GetContainerRuntimeFromNode(t *testing.T) {
+	tests := []struct {
+		name           string
+		runtimeVersion string
+		want           string
+	}{
+		{
+			name:           "containerd",
+			runtimeVersion: "containerd://1.7.2",
+			want:           ContainerdRuntimeType,
+		},
+		{
+			name:           "cri-o",
+			runtimeVersion: "cri-o://1.28.1",
+			want:           CRIORuntimeType,
+		},
+		{
+			name:           "empty runtime version",
+			runtimeVersion: "",
+			want:           "",
+		},
+		{
+			name:           "invalid runtime version",
+			runtimeVersion: "containerd",
+			want:           "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				Status: corev1.NodeStatus{
+					NodeInfo: corev1.NodeSystemInfo{
+						ContainerRuntimeVersion: tt.runtimeVersion,
+					},
+				},
+			}
+			assert.Equal(t, tt.want, GetContainerRuntimeFromNode(node))
+		})
+	}
+}
+
+func Test_GetContainerRuntimeLabelByNode(t *testing.T) {
+	newNode := func(name, runtimeVersion string) corev1.Node {
+		return corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: corev1.NodeStatus{
+				NodeInfo: corev1.NodeSystemInfo{
+					ContainerRuntimeVersion: runtimeVersion,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		nodeList []corev1.Node
+		want     map[string]string
+	}{
+		{
+			name:     "no nodes",
+			nodeList: nil,
+			want:     map[string]string{},
+		},
+		{
+			name: "single runtime",
+			nodeList: []corev1.Node{
+				newNode("node-1", "containerd://1.7.2"),
+				newNode("node-2", "containerd://1.6.0"),
+			},
+			want: map[string]string{
+				"node-1": "",
+				"node-2": "",
+			},
+		},
+		{
+			name: "single supported runtime",
+			nodeList: []corev1.Node{
+				newNode("node-1", "containerd://1.7.2"),
+				newNode("node-2", "foo://1.0.0"),
+			},
+			want: map[string]string{
+				"node-1": "",
+				"node-2": "",
+			},
+		},
+		{
+			name: "mixed runtimes",
+			nodeList: []corev1.Node{
+				newNode("node-1", "containerd://1.7.2"),
+				newNode("node-2", "cri-o://1.28.1"),
+				newNode("node-3", "foo://1.0.0"),
+			},
+			want: map[string]string{
+				"node-1": ContainerdRuntimeType,
+				"node-2": CRIORuntimeType,
+				"node-3": "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetContainerRuntimeLabelByNode(tt.nodeList))
+		})
+	}
+}
+
+func Test_GetProviderListFromNodeList(t *testing.T) {
+	newNode := func(name string, labels map[string]string) corev1.Node {
+		return corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	nodes := []corev1.Node{
+		newNode("gke-containerd-1", map[string]string{GKEProviderLabel: GKECosType, RuntimeProviderLabel: ContainerdRuntimeType}),
+		newNode("gke-containerd-2", map[string]string{GKEProviderLabel: GKECosType, RuntimeProviderLabel: ContainerdRuntimeType}),
+		newNode("gke-docker", map[string]string{GKEProviderLabel: GKECosType, RuntimeProviderLabel: DockerRuntimeType}),
+	}
+
+	providers := GetProviderListFromNodeList(nodes, logr.Discard())
+	assert.Equal(t, map[string]struct{}{gkeCosContainerd: {}, gkeCosDocker: {}}, providers)
+	assert.Equal(t, "datadog-agent-gke-cos-runtime-docker", GetAgentNameWithProvider("datadog-agent", gkeCosDocker))
+}
@@ -10,25 +10,26 @@ const (
 	Wildcard = "*"
 
 	// API Groups
-	CoreAPIGroup             = ""
-	ExtensionsAPIGroup       = "extensions"
-	OpenShiftQuotaAPIGroup   = "quota.openshift.io"
-	RbacAPIGroup             = "rbac.authorization.k8s.io"
-	AutoscalingAPIGroup      = "autoscaling"
-	CertificatesAPIGroup     = "certificates.k8s.io"
-	StorageAPIGroup          = "storage.k8s.io"
-	CoordinationAPIGroup     = "coordination.k8s.io"
-	DatadogAPIGroup          = "datadoghq.com"
-	AdmissionAPIGroup        = "admissionregistration.k8s.io"
-	AppsAPIGroup             = "apps"
-	BatchAPIGroup            = "batch"
-	PolicyAPIGroup           = "policy"
-	NetworkingAPIGroup       = "networking.k8s.io"
-	AutoscalingK8sIoAPIGroup = "autoscaling.k8s.io"
-	AuthorizationAPIGroup    = "authorization.k8s.io"
-	ExternalMetricsAPIGroup  = "external.metrics.k8s.io"
-	RegistrationAPIGroup     = "apiregistration.k8s.io"
-	APIExtensionsAPIGroup    = "apiextensions.k8s.io"
+	CoreAPIGroup              = ""
+	ExtensionsAPIGroup        = "extensions"
+	OpenShiftQuotaAPIGroup    = "quota.openshift.io"
+	OpenShiftSecurityAPIGroup = "security.openshift.io"
+	RbacAPIGroup              = "rbac.authorization.k8s.io"
+	AutoscalingAPIGroup       = "autoscaling"
+	CertificatesAPIGroup      = "certificates.k8s.io"
+	StorageAPIGroup           = "storage.k8s.io"
+	CoordinationAPIGroup      = "coordination.k8s.io"
+	DatadogAPIGroup           = "datadoghq.com"
+	AdmissionAPIGroup         = "admissionregistration.k8s.io"
+	AppsAPIGroup              = "apps"
+	BatchAPIGroup             = "batch"
+	PolicyAPIGroup            = "policy"
+	NetworkingAPIGroup        = "networking.k8s.io"
+	AutoscalingK8sIoAPIGroup  = "autoscaling.k8s.io"
+	AuthorizationAPIGroup     = "authorization.k8s.io"
+	ExternalMetricsAPIGroup   = "external.metrics.k8s.io"
+	RegistrationAPIGroup      = "apiregistration.k8s.io"
+	APIExtensionsAPIGroup     = "apiextensions.k8s.io"
 
 	// Resources
 
@@ -84,6 +85,7 @@ const (
 	SubjectAccessReviewResource         = "subjectaccessreviews"
 	ClusterRoleResource                 = "clusterroles"
 	RoleResource                        = "roles"
+	SecurityContextConstraintsResource  = "securitycontextconstraints"
 
 	// Non resource URLs
 
@@ -101,10 +103,19 @@ const (
 	PatchVerb  = "patch"
 	CreateVerb = "create"
 	DeleteVerb = "delete"
+	UseVerb    = "use"
 
 	// Rbac resource kinds
 
 	ClusterRoleKind    = "ClusterRole"
 	RoleKind           = "Role"
 	ServiceAccountKind = "ServiceAccount"
+
+	// OpenShift SecurityContextConstraints
+
+	HostAccessSCC = "hostaccess"
+	PrivilegedSCC = "privileged"
+
+	// OpenShiftSCCClusterRolePrefix prefixes the ClusterRoles OpenShift provides to use each SCC
+	OpenShiftSCCClusterRolePrefix = "system:openshift:scc:"
 )
@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package common
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+
+	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
+)
+
+const defaultDatadogSite = "datadoghq.com"
+
+// DatadogAppURL returns the URL of the Datadog application of a Datadog site, like `datadoghq.eu` or
+// `us3.datadoghq.com`. The site defaults to the DD_SITE environment variable, and then to datadoghq.com.
+func DatadogAppURL(site string) string {
+	if site == "" {
+		site = os.Getenv(apicommon.DDSite)
+	}
+	if site == "" {
+		site = defaultDatadogSite
+	}
+	// The sites with a subdomain, like us3.datadoghq.com, are served on it
+	if strings.Count(site, ".") > 1 {
+		return "https://" + site
+	}
+	return "https://app." + site
+}
+
+// NewTable returns a table printed like the kubectl tables, without borders.
+func NewTable(out io.Writer, header ...string) *tablewriter.Table {
+	table := tablewriter.NewWriter(out)
+	table.SetHeader(header)
+	table.SetBorders(tablewriter.Border{Left: false, Top: false, Right: false, Bottom: false})
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetRowLine(false)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(false)
+	table.SetAutoWrapText(false)
+	table.SetAutoFormatHeaders(false)
+	return table
+}
+
+// PrintField prints a field of an object, aligned with the other fields.
+func PrintField(out io.Writer, name, value string) {
+	fmt.Fprintf(out, "%-18s%s\n", name+":", value)
+}
+
+// OpenBrowser opens a URL in the default browser.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("unable to open the browser, use the printed link instead: %w", err)
+	}
+
+	return nil
+}
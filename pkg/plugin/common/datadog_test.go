@@ -0,0 +1,56 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apicommon "github.com/DataDog/datadog-operator/api/datadoghq/common"
+)
+
+func TestDatadogAppURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		site    string
+		envSite string
+		want    string
+	}{
+		{
+			name: "default site",
+			want: "https://app.datadoghq.com",
+		},
+		{
+			name: "site",
+			site: "datadoghq.eu",
+			want: "https://app.datadoghq.eu",
+		},
+		{
+			name: "site with a subdomain",
+			site: "us3.datadoghq.com",
+			want: "https://us3.datadoghq.com",
+		},
+		{
+			name:    "site from the environment",
+			envSite: "ap1.datadoghq.com",
+			want:    "https://ap1.datadoghq.com",
+		},
+		{
+			name:    "site overriding the environment",
+			site:    "ddog-gov.com",
+			envSite: "ap1.datadoghq.com",
+			want:    "https://app.ddog-gov.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(apicommon.DDSite, tt.envSite)
+			assert.Equal(t, tt.want, DatadogAppURL(tt.site))
+		})
+	}
+}
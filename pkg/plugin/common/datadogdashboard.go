@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// GetDatadogDashboard gets a DatadogDashboard, with an error naming it if it doesn't exist.
+func GetDatadogDashboard(ctx context.Context, c client.Client, namespace, name string) (*v1alpha1.DatadogDashboard, error) {
+	dashboard := &v1alpha1.DatadogDashboard{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, dashboard); err != nil && apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("DatadogDashboard %s/%s not found", namespace, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get DatadogDashboard: %w", err)
+	}
+
+	return dashboard, nil
+}
+
+// DatadogDashboardURL returns the link to the dashboard of a DatadogDashboard in Datadog, empty until it's created.
+func DatadogDashboardURL(appURL string, dashboard *v1alpha1.DatadogDashboard) string {
+	if dashboard.Status.ID == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/dashboard/%s", appURL, dashboard.Status.ID)
+}
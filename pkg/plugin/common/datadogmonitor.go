@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// GetDatadogMonitor gets a DatadogMonitor, with an error naming it if it doesn't exist.
+func GetDatadogMonitor(ctx context.Context, c client.Client, namespace, name string) (*v1alpha1.DatadogMonitor, error) {
+	dm := &v1alpha1.DatadogMonitor{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, dm); err != nil && apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("DatadogMonitor %s/%s not found", namespace, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get DatadogMonitor: %w", err)
+	}
+
+	return dm, nil
+}
+
+// DatadogMonitorURL returns the link to the monitor of a DatadogMonitor in Datadog, empty until it's created.
+func DatadogMonitorURL(appURL string, dm *v1alpha1.DatadogMonitor) string {
+	if dm.Status.ID == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/monitors/%d", appURL, dm.Status.ID)
+}
+
+// IsDatadogMonitorMuted returns whether a DatadogMonitor is muted by a downtime.
+func IsDatadogMonitorMuted(dm *v1alpha1.DatadogMonitor) bool {
+	return dm.Status.DowntimeStatus.IsDowntimed || dm.Status.DowntimeStatus.RolloutDowntimeID != ""
+}
+
+// RequestForceSync sets the force sync annotation of an object to the time of the request, so that its controller
+// syncs it with Datadog on its next reconcile.
+func RequestForceSync(ctx context.Context, c client.Client, obj client.Object, now time.Time) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{v1alpha1.ForceSyncAnnotationKey: now.UTC().Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch))
+}
@@ -0,0 +1,71 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func TestDatadogMonitorURL(t *testing.T) {
+	dm := &v1alpha1.DatadogMonitor{}
+	assert.Empty(t, DatadogMonitorURL("https://app.datadoghq.eu", dm))
+	dm.Status.ID = 12345
+	assert.Equal(t, "https://app.datadoghq.eu/monitors/12345", DatadogMonitorURL("https://app.datadoghq.eu", dm))
+}
+
+func TestIsDatadogMonitorMuted(t *testing.T) {
+	assert.False(t, IsDatadogMonitorMuted(&v1alpha1.DatadogMonitor{}))
+	assert.True(t, IsDatadogMonitorMuted(&v1alpha1.DatadogMonitor{Status: v1alpha1.DatadogMonitorStatus{
+		DowntimeStatus: v1alpha1.DatadogMonitorDowntimeStatus{IsDowntimed: true},
+	}}))
+	assert.True(t, IsDatadogMonitorMuted(&v1alpha1.DatadogMonitor{Status: v1alpha1.DatadogMonitorStatus{
+		DowntimeStatus: v1alpha1.DatadogMonitorDowntimeStatus{RolloutDowntimeID: "abc"},
+	}}))
+}
+
+func TestGetDatadogMonitor(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	dm := &v1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo"}}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(dm).Build()
+
+	got, err := GetDatadogMonitor(context.TODO(), c, "bar", "foo")
+	require.NoError(t, err)
+	assert.Equal(t, "foo", got.Name)
+
+	_, err = GetDatadogMonitor(context.TODO(), c, "bar", "missing")
+	assert.EqualError(t, err, "DatadogMonitor bar/missing not found")
+}
+
+func TestRequestForceSync(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	dm := &v1alpha1.DatadogMonitor{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "bar",
+		Name:        "foo",
+		Annotations: map[string]string{"foo": "bar", v1alpha1.ForceSyncAnnotationKey: "2021-02-02T05:41:35Z"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(dm).Build()
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, RequestForceSync(context.TODO(), c, dm, now))
+
+	got := &v1alpha1.DatadogMonitor{}
+	require.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(dm), got))
+	assert.Equal(t, "bar", got.Annotations["foo"])
+	assert.Equal(t, "2024-01-02T03:04:05Z", got.Annotations[v1alpha1.ForceSyncAnnotationKey])
+}
@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+// GetDatadogSLO gets a DatadogSLO, with an error naming it if it doesn't exist.
+func GetDatadogSLO(ctx context.Context, c client.Client, namespace, name string) (*v1alpha1.DatadogSLO, error) {
+	slo := &v1alpha1.DatadogSLO{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, slo); err != nil && apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("DatadogSLO %s/%s not found", namespace, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get DatadogSLO: %w", err)
+	}
+
+	return slo, nil
+}
+
+// DatadogSLOURL returns the link to the SLO of a DatadogSLO in Datadog, empty until it's created.
+func DatadogSLOURL(appURL string, slo *v1alpha1.DatadogSLO) string {
+	if slo.Status.ID == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/slo?slo_id=%s", appURL, slo.Status.ID)
+}
@@ -0,0 +1,40 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/DataDog/datadog-operator/api/datadoghq/v1alpha1"
+)
+
+func TestDatadogSLOURL(t *testing.T) {
+	slo := &v1alpha1.DatadogSLO{}
+	assert.Empty(t, DatadogSLOURL("https://app.datadoghq.eu", slo))
+	slo.Status.ID = "abc123"
+	assert.Equal(t, "https://app.datadoghq.eu/slo?slo_id=abc123", DatadogSLOURL("https://app.datadoghq.eu", slo))
+}
+
+func TestGetDatadogSLO(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	slo := &v1alpha1.DatadogSLO{ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo"}}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(slo).Build()
+
+	got, err := GetDatadogSLO(context.TODO(), c, "bar", "foo")
+	require.NoError(t, err)
+	assert.Equal(t, "foo", got.Name)
+
+	_, err = GetDatadogSLO(context.TODO(), c, "bar", "missing")
+	assert.EqualError(t, err, "DatadogSLO bar/missing not found")
+}
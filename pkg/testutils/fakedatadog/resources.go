@@ -0,0 +1,594 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package fakedatadog
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadogV2 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+)
+
+// defaultSLIValue is the SLI value of the SLO history, until it's set with SetSLIValue.
+const defaultSLIValue = 100.0
+
+func (a *API) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v1/monitor", a.listMonitors)
+	mux.HandleFunc("POST /api/v1/monitor", a.createMonitor)
+	mux.HandleFunc("POST /api/v1/monitor/validate", a.validateMonitor)
+	mux.HandleFunc("GET /api/v1/monitor/{id}", a.getMonitor)
+	mux.HandleFunc("PUT /api/v1/monitor/{id}", a.updateMonitor)
+	mux.HandleFunc("DELETE /api/v1/monitor/{id}", a.deleteMonitor)
+	mux.HandleFunc("POST /api/v1/monitor/{id}/validate", a.validateExistingMonitor)
+
+	mux.HandleFunc("GET /api/v1/slo", a.listSLOs)
+	mux.HandleFunc("POST /api/v1/slo", a.createSLO)
+	mux.HandleFunc("GET /api/v1/slo/{id}", a.getSLO)
+	mux.HandleFunc("PUT /api/v1/slo/{id}", a.updateSLO)
+	mux.HandleFunc("DELETE /api/v1/slo/{id}", a.deleteSLO)
+	mux.HandleFunc("GET /api/v1/slo/{id}/history", a.getSLOHistory)
+
+	mux.HandleFunc("GET /api/v1/dashboard", a.listDashboards)
+	mux.HandleFunc("POST /api/v1/dashboard", a.createDashboard)
+	mux.HandleFunc("GET /api/v1/dashboard/{id}", a.getDashboard)
+	mux.HandleFunc("PUT /api/v1/dashboard/{id}", a.updateDashboard)
+	mux.HandleFunc("DELETE /api/v1/dashboard/{id}", a.deleteDashboard)
+
+	mux.HandleFunc("GET /api/v2/downtime", a.listDowntimes)
+	mux.HandleFunc("POST /api/v2/downtime", a.createDowntime)
+	mux.HandleFunc("GET /api/v2/downtime/{id}", a.getDowntime)
+	mux.HandleFunc("PATCH /api/v2/downtime/{id}", a.updateDowntime)
+	mux.HandleFunc("DELETE /api/v2/downtime/{id}", a.cancelDowntime)
+
+	return mux
+}
+
+// newID returns a new numeric ID.
+func (a *API) newID() int64 {
+	a.nextID++
+	return a.nextID
+}
+
+func creator() map[string]interface{} {
+	return map[string]interface{}{"email": CreatorEmail, "handle": CreatorHandle, "name": CreatorHandle}
+}
+
+// Monitors
+
+func (a *API) listMonitors(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	writeJSON(w, http.StatusOK, a.monitors.list())
+}
+
+func (a *API) createMonitor(w http.ResponseWriter, r *http.Request) {
+	monitor, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	if errs := missingFields(monitor, "query", "type"); len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := a.newID()
+	now := timestamp(time.Now())
+	monitor["id"] = id
+	monitor["created"] = now
+	monitor["modified"] = now
+	monitor["creator"] = creator()
+	monitor["overall_state"] = string(datadogV1.MONITOROVERALLSTATES_NO_DATA)
+	a.monitors.put(strconv.FormatInt(id, 10), monitor)
+
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (a *API) getMonitor(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	monitor, found := a.monitors.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (a *API) updateMonitor(w http.ResponseWriter, r *http.Request) {
+	update, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	monitor, found := a.monitors.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+	merge(monitor, update)
+	monitor["modified"] = timestamp(time.Now())
+
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+func (a *API) deleteMonitor(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := r.PathValue("id")
+	if !a.monitors.delete(id) {
+		writeError(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+	monitorID, _ := strconv.ParseInt(id, 10, 64)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"deleted_monitor_id": monitorID})
+}
+
+func (a *API) validateMonitor(w http.ResponseWriter, r *http.Request) {
+	monitor, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	if errs := missingFields(monitor, "query", "type"); len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, errs...)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func (a *API) validateExistingMonitor(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	_, found := a.monitors.get(r.PathValue("id"))
+	a.mu.Unlock()
+	if !found {
+		writeError(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+	a.validateMonitor(w, r)
+}
+
+// Monitor returns the monitor with the given ID, and false if it doesn't exist.
+func (a *API) Monitor(id int64) (datadogV1.Monitor, bool) {
+	var monitor datadogV1.Monitor
+	return monitor, a.getAs(a.monitors, strconv.FormatInt(id, 10), &monitor)
+}
+
+// AddMonitor adds a monitor, or replaces the monitor with the same ID, like a monitor created or changed outside of
+// Kubernetes. It returns the ID of the monitor, a new one if it isn't set.
+func (a *API) AddMonitor(monitor datadogV1.Monitor) int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if monitor.Id == nil {
+		monitor.SetId(a.newID())
+	}
+	if monitor.Modified == nil {
+		monitor.SetModified(time.Now())
+	}
+	a.putAs(a.monitors, strconv.FormatInt(monitor.GetId(), 10), monitor)
+	return monitor.GetId()
+}
+
+// SLOs
+
+func (a *API) listSLOs(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": a.slos.list()})
+}
+
+func (a *API) createSLO(w http.ResponseWriter, r *http.Request) {
+	slo, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	if errs := missingFields(slo, "name", "type", "thresholds"); len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := fmt.Sprintf("%032x", a.newID())
+	now := time.Now().Unix()
+	slo["id"] = id
+	slo["created_at"] = now
+	slo["modified_at"] = now
+	slo["creator"] = creator()
+	a.slos.put(id, slo)
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []interface{}{slo}})
+}
+
+func (a *API) getSLO(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	slo, found := a.slos.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "SLO not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": slo})
+}
+
+func (a *API) updateSLO(w http.ResponseWriter, r *http.Request) {
+	update, ok := decode(w, r)
+	if !ok {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	slo, found := a.slos.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "SLO not found")
+		return
+	}
+	merge(slo, update)
+	slo["modified_at"] = time.Now().Unix()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []interface{}{slo}})
+}
+
+func (a *API) deleteSLO(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := r.PathValue("id")
+	if !a.slos.delete(id) {
+		writeError(w, http.StatusNotFound, "SLO not found")
+		return
+	}
+	delete(a.sliValues, id)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []string{id}})
+}
+
+func (a *API) getSLOHistory(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := r.PathValue("id")
+	slo, found := a.slos.get(id)
+	if !found {
+		writeError(w, http.StatusNotFound, "SLO not found")
+		return
+	}
+
+	sliValue, found := a.sliValues[id]
+	if !found {
+		sliValue = defaultSLIValue
+	}
+	// The remaining error budget is reported by timeframe, assuming the SLI value is the same for all of them
+	errorBudgetRemaining := map[string]interface{}{}
+	thresholds, _ := slo["thresholds"].([]interface{})
+	for _, threshold := range thresholds {
+		threshold, _ := threshold.(map[string]interface{})
+		target, _ := threshold["target"].(float64)
+		timeframe, _ := threshold["timeframe"].(string)
+		if target < 100 {
+			errorBudgetRemaining[timeframe] = 100 * (sliValue - target) / (100 - target)
+		}
+	}
+
+	from, _ := strconv.ParseInt(r.URL.Query().Get("from_ts"), 10, 64)
+	to, _ := strconv.ParseInt(r.URL.Query().Get("to_ts"), 10, 64)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{
+			"from_ts": from,
+			"to_ts":   to,
+			"type":    slo["type"],
+			"overall": map[string]interface{}{
+				"sli_value":              sliValue,
+				"span_precision":         2,
+				"error_budget_remaining": errorBudgetRemaining,
+			},
+		},
+	})
+}
+
+// SLO returns the SLO with the given ID, and false if it doesn't exist.
+func (a *API) SLO(id string) (datadogV1.ServiceLevelObjective, bool) {
+	var slo datadogV1.ServiceLevelObjective
+	return slo, a.getAs(a.slos, id, &slo)
+}
+
+// AddSLO adds a SLO, or replaces the SLO with the same ID, like a SLO created or changed outside of Kubernetes. It
+// returns the ID of the SLO, a new one if it isn't set.
+func (a *API) AddSLO(slo datadogV1.ServiceLevelObjective) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if slo.Id == nil {
+		slo.SetId(fmt.Sprintf("%032x", a.newID()))
+	}
+	if slo.ModifiedAt == nil {
+		slo.SetModifiedAt(time.Now().Unix())
+	}
+	a.putAs(a.slos, slo.GetId(), slo)
+	return slo.GetId()
+}
+
+// SetSLIValue sets the SLI value, in percent, reported by the history of the SLO with the given ID.
+func (a *API) SetSLIValue(id string, sliValue float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sliValues[id] = sliValue
+}
+
+// Dashboards
+
+func (a *API) listDashboards(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	summaries := []map[string]interface{}{}
+	for _, dashboard := range a.dashboards.list() {
+		summary := map[string]interface{}{}
+		for _, field := range []string{"id", "title", "description", "layout_type", "url", "author_handle", "created_at", "modified_at"} {
+			if value, found := dashboard[field]; found {
+				summary[field] = value
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"dashboards": summaries})
+}
+
+func (a *API) createDashboard(w http.ResponseWriter, r *http.Request) {
+	dashboard, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	if errs := missingFields(dashboard, "title", "layout_type", "widgets"); len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := dashboardID(a.newID())
+	now := timestamp(time.Now())
+	dashboard["id"] = id
+	dashboard["url"] = "/dashboard/" + id
+	dashboard["created_at"] = now
+	dashboard["modified_at"] = now
+	dashboard["author_handle"] = CreatorHandle
+	a.dashboards.put(id, dashboard)
+
+	writeJSON(w, http.StatusOK, dashboard)
+}
+
+func (a *API) getDashboard(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	dashboard, found := a.dashboards.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "Dashboard not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, dashboard)
+}
+
+func (a *API) updateDashboard(w http.ResponseWriter, r *http.Request) {
+	update, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	if errs := missingFields(update, "title", "layout_type", "widgets"); len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	dashboard, found := a.dashboards.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "Dashboard not found")
+		return
+	}
+	merge(dashboard, update)
+	dashboard["modified_at"] = timestamp(time.Now())
+
+	writeJSON(w, http.StatusOK, dashboard)
+}
+
+func (a *API) deleteDashboard(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := r.PathValue("id")
+	if !a.dashboards.delete(id) {
+		writeError(w, http.StatusNotFound, "Dashboard not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"deleted_dashboard_id": id})
+}
+
+// dashboardID returns a dashboard ID in the format of Datadog, like abc-def-ghi, from a numeric ID.
+func dashboardID(n int64) string {
+	letters := make([]byte, 9)
+	for i := len(letters) - 1; i >= 0; i-- {
+		letters[i] = byte('a' + n%26)
+		n /= 26
+	}
+	return fmt.Sprintf("%s-%s-%s", letters[0:3], letters[3:6], letters[6:9])
+}
+
+// Dashboard returns the dashboard with the given ID, and false if it doesn't exist.
+func (a *API) Dashboard(id string) (datadogV1.Dashboard, bool) {
+	var dashboard datadogV1.Dashboard
+	return dashboard, a.getAs(a.dashboards, id, &dashboard)
+}
+
+// AddDashboard adds a dashboard, or replaces the dashboard with the same ID, like a dashboard created or changed
+// outside of Kubernetes. It returns the ID of the dashboard, a new one if it isn't set.
+func (a *API) AddDashboard(dashboard datadogV1.Dashboard) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if dashboard.Id == nil {
+		dashboard.SetId(dashboardID(a.newID()))
+	}
+	if dashboard.ModifiedAt == nil {
+		dashboard.SetModifiedAt(time.Now())
+	}
+	a.putAs(a.dashboards, dashboard.GetId(), dashboard)
+	return dashboard.GetId()
+}
+
+// Downtimes
+
+// listDowntimes lists the downtimes, only the ones not canceled with current_only, by pages of page[limit] downtimes
+// from page[offset].
+func (a *API) listDowntimes(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	currentOnly, _ := strconv.ParseBool(query.Get("current_only"))
+	offset, _ := strconv.Atoi(query.Get("page[offset]"))
+	limit, err := strconv.Atoi(query.Get("page[limit]"))
+	if err != nil {
+		limit = 30
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	downtimes := []map[string]interface{}{}
+	for _, downtime := range a.downtimes.list() {
+		attributes := downtime["attributes"].(map[string]interface{})
+		if !currentOnly || attributes["status"] != string(datadogV2.DOWNTIMESTATUS_CANCELED) {
+			downtimes = append(downtimes, downtime)
+		}
+	}
+	downtimes = downtimes[min(offset, len(downtimes)):min(offset+limit, len(downtimes))]
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": downtimes})
+}
+
+func (a *API) createDowntime(w http.ResponseWriter, r *http.Request) {
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	data, _ := body["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if errs := missingFields(attributes, "scope", "monitor_identifier"); len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := fmt.Sprintf("00000000-0000-0000-0000-%012d", a.newID())
+	now := time.Now()
+	attributes["created"] = timestamp(now)
+	attributes["modified"] = timestamp(now)
+	attributes["status"] = string(datadogV2.DOWNTIMESTATUS_ACTIVE)
+	setDowntimeStart(attributes, now)
+	downtime := map[string]interface{}{"id": id, "type": "downtime", "attributes": attributes}
+	a.downtimes.put(id, downtime)
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": downtime})
+}
+
+func (a *API) getDowntime(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	downtime, found := a.downtimes.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "Downtime not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": downtime})
+}
+
+func (a *API) updateDowntime(w http.ResponseWriter, r *http.Request) {
+	body, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	data, _ := body["data"].(map[string]interface{})
+	update, _ := data["attributes"].(map[string]interface{})
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	downtime, found := a.downtimes.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "Downtime not found")
+		return
+	}
+	attributes := downtime["attributes"].(map[string]interface{})
+	if attributes["status"] == string(datadogV2.DOWNTIMESTATUS_CANCELED) {
+		writeError(w, http.StatusBadRequest, "Canceled downtimes can't be updated")
+		return
+	}
+	merge(attributes, update)
+	now := time.Now()
+	attributes["modified"] = timestamp(now)
+	setDowntimeStart(attributes, now)
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": downtime})
+}
+
+func (a *API) cancelDowntime(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	downtime, found := a.downtimes.get(r.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, "Downtime not found")
+		return
+	}
+	// Canceled downtimes are kept, like in Datadog
+	attributes := downtime["attributes"].(map[string]interface{})
+	now := timestamp(time.Now())
+	attributes["canceled"] = now
+	attributes["modified"] = now
+	attributes["status"] = string(datadogV2.DOWNTIMESTATUS_CANCELED)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setDowntimeStart sets the start of a one-time downtime schedule to now if it's not set, as it's always set in the
+// responses.
+func setDowntimeStart(attributes map[string]interface{}, now time.Time) {
+	schedule, found := attributes["schedule"].(map[string]interface{})
+	if !found {
+		schedule = map[string]interface{}{}
+		attributes["schedule"] = schedule
+	}
+	if _, recurring := schedule["recurrences"]; recurring {
+		return
+	}
+	if start, found := schedule["start"]; !found || start == nil {
+		schedule["start"] = timestamp(now)
+	}
+}
+
+// Downtime returns the downtime with the given ID, and false if it doesn't exist. Canceled downtimes are kept, with
+// the canceled status.
+func (a *API) Downtime(id string) (datadogV2.DowntimeResponseData, bool) {
+	var downtime datadogV2.DowntimeResponseData
+	return downtime, a.getAs(a.downtimes, id, &downtime)
+}
+
+// getAs converts the object of the collection with the given ID to a Datadog API client model, and returns false if it
+// doesn't exist.
+func (a *API) getAs(c *collection, id string, model interface{}) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	item, found := c.get(id)
+	if !found {
+		return false
+	}
+	return convert(item, model) == nil
+}
+
+// putAs adds a Datadog API client model to the collection. The caller must hold the lock.
+func (a *API) putAs(c *collection, id string, model interface{}) {
+	item := map[string]interface{}{}
+	if err := convert(model, &item); err != nil {
+		panic(fmt.Sprintf("invalid Datadog object %s: %v", id, err))
+	}
+	c.put(id, item)
+}
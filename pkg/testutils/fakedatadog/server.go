@@ -0,0 +1,352 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package fakedatadog provides an in-memory fake of the Datadog API endpoints used by the Operator controllers:
+// monitors (including their validation), SLOs, dashboards and downtimes. It's stateful, so that the controllers can
+// run against it as against Datadog, and can add latency, errors and rate limits to the requests.
+//
+// The controllers use it when the DD_URL environment variable is set to its URL.
+package fakedatadog
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	apiKeyHeader = "DD-API-KEY"
+	appKeyHeader = "DD-APPLICATION-KEY"
+
+	// CreatorEmail is the email of the creator of the Datadog objects created with the fake API.
+	CreatorEmail = "fake@datadoghq.com"
+	// CreatorHandle is the handle of the creator of the Datadog objects created with the fake API.
+	CreatorHandle = "fake"
+)
+
+// Option configures an API.
+type Option func(*API)
+
+// WithLatency adds latency to every request.
+func WithLatency(latency time.Duration) Option {
+	return func(a *API) {
+		a.latency = latency
+	}
+}
+
+// WithRateLimit limits the requests to limit per period and per endpoint, like monitors or SLOs. The rate limit is
+// reported in the X-RateLimit headers of the responses, and the requests exceeding it fail with a 429 status code.
+func WithRateLimit(limit int, period time.Duration) Option {
+	return func(a *API) {
+		a.rateLimit = limit
+		a.ratePeriod = period
+	}
+}
+
+// WithCredentials only accepts the requests made with the given API and application keys. Any keys are accepted by
+// default, as long as they are set.
+func WithCredentials(apiKey, appKey string) Option {
+	return func(a *API) {
+		a.apiKey = apiKey
+		a.appKey = appKey
+	}
+}
+
+// API is an http.Handler serving the fake Datadog API.
+type API struct {
+	latency    time.Duration
+	rateLimit  int
+	ratePeriod time.Duration
+	apiKey     string
+	appKey     string
+	mux        *http.ServeMux
+
+	mu         sync.Mutex
+	windows    map[string]*rateWindow
+	faults     []*fault
+	requests   []Request
+	monitors   *collection
+	slos       *collection
+	sliValues  map[string]float64
+	dashboards *collection
+	downtimes  *collection
+	nextID     int64
+}
+
+// Request is a request received by the fake API.
+type Request struct {
+	Method string
+	Path   string
+}
+
+// rateWindow is the current rate limit window of an endpoint.
+type rateWindow struct {
+	start    time.Time
+	requests int
+}
+
+// fault makes the requests matching a method and a path prefix fail.
+type fault struct {
+	method     string
+	pathPrefix string
+	code       int
+	remaining  int
+}
+
+// NewAPI returns a new fake Datadog API, without any Datadog object.
+func NewAPI(opts ...Option) *API {
+	a := &API{
+		windows:    map[string]*rateWindow{},
+		monitors:   newCollection(),
+		slos:       newCollection(),
+		sliValues:  map[string]float64{},
+		dashboards: newCollection(),
+		downtimes:  newCollection(),
+		nextID:     1000,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	a.mux = a.routes()
+	return a
+}
+
+// Server is an httptest.Server serving a fake Datadog API.
+type Server struct {
+	*httptest.Server
+	*API
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	api := NewAPI(opts...)
+	return &Server{Server: httptest.NewServer(api), API: api}
+}
+
+// FailRequests makes the next count requests whose method and path start with method and pathPrefix fail with the
+// given status code, or all of them if count isn't positive. An empty method matches all the methods.
+func (a *API) FailRequests(method, pathPrefix string, code, count int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.faults = append(a.faults, &fault{method: method, pathPrefix: pathPrefix, code: code, remaining: count})
+}
+
+// ClearFailures stops failing the requests configured with FailRequests.
+func (a *API) ClearFailures() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.faults = nil
+}
+
+// Requests returns the requests received by the fake API, in order.
+func (a *API) Requests() []Request {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]Request(nil), a.requests...)
+}
+
+// RequestCount returns the number of requests received whose method and path start with method and pathPrefix. An
+// empty method matches all the methods.
+func (a *API) RequestCount(method, pathPrefix string) int {
+	count := 0
+	for _, req := range a.Requests() {
+		if (method == "" || req.Method == method) && strings.HasPrefix(req.Path, pathPrefix) {
+			count++
+		}
+	}
+	return count
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if a.latency > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(a.latency):
+		}
+	}
+
+	a.mu.Lock()
+	a.requests = append(a.requests, Request{Method: r.Method, Path: r.URL.Path})
+	a.mu.Unlock()
+
+	if r.Header.Get(apiKeyHeader) == "" || r.Header.Get(appKeyHeader) == "" ||
+		(a.apiKey != "" && (r.Header.Get(apiKeyHeader) != a.apiKey || r.Header.Get(appKeyHeader) != a.appKey)) {
+		writeError(w, http.StatusForbidden, "Forbidden")
+		return
+	}
+	if !a.allow(w, r) {
+		writeError(w, http.StatusTooManyRequests, "Too many requests")
+		return
+	}
+
+	a.mu.Lock()
+	code := a.fault(r)
+	a.mu.Unlock()
+	if code != 0 {
+		writeError(w, code, "Fake error")
+		return
+	}
+
+	a.mux.ServeHTTP(w, r)
+}
+
+// fault returns the status code of the first fault matching the request, or 0 if the request shouldn't fail.
+func (a *API) fault(r *http.Request) int {
+	for i, f := range a.faults {
+		if (f.method != "" && f.method != r.Method) || !strings.HasPrefix(r.URL.Path, f.pathPrefix) {
+			continue
+		}
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				a.faults = append(a.faults[:i], a.faults[i+1:]...)
+			}
+		}
+		return f.code
+	}
+	return 0
+}
+
+// allow returns true if the request is allowed by the rate limit of its endpoint, and sets the X-RateLimit headers.
+func (a *API) allow(w http.ResponseWriter, r *http.Request) bool {
+	if a.rateLimit <= 0 {
+		return true
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	endpoint := endpointName(r.URL.Path)
+	window, found := a.windows[endpoint]
+	if !found || now.Sub(window.start) >= a.ratePeriod {
+		window = &rateWindow{start: now}
+		a.windows[endpoint] = window
+	}
+	window.requests++
+
+	remaining := a.rateLimit - window.requests
+	if remaining < 0 {
+		remaining = 0
+	}
+	reset := int(math.Ceil(window.start.Add(a.ratePeriod).Sub(now).Seconds()))
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(a.rateLimit))
+	w.Header().Set("X-RateLimit-Period", strconv.Itoa(int(math.Ceil(a.ratePeriod.Seconds()))))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.Itoa(reset))
+
+	return window.requests <= a.rateLimit
+}
+
+// endpointName returns the name of the endpoint of a path, made of the API version and the resource, such as
+// v1/monitor.
+func endpointName(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) >= 3 && segments[0] == "api" {
+		return segments[1] + "/" + segments[2]
+	}
+	return strings.Join(segments, "/")
+}
+
+// collection is a collection of Datadog objects, stored as JSON objects by ID, in creation order.
+type collection struct {
+	ids   []string
+	items map[string]map[string]interface{}
+}
+
+func newCollection() *collection {
+	return &collection{items: map[string]map[string]interface{}{}}
+}
+
+func (c *collection) get(id string) (map[string]interface{}, bool) {
+	item, found := c.items[id]
+	return item, found
+}
+
+func (c *collection) put(id string, item map[string]interface{}) {
+	if _, found := c.items[id]; !found {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = item
+}
+
+func (c *collection) delete(id string) bool {
+	if _, found := c.items[id]; !found {
+		return false
+	}
+	delete(c.items, id)
+	for i := range c.ids {
+		if c.ids[i] == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (c *collection) list() []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(c.ids))
+	for _, id := range c.ids {
+		items = append(items, c.items[id])
+	}
+	return items
+}
+
+// decode decodes the JSON body of the request into a JSON object, and writes a 400 response if it's invalid.
+func decode(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	body := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON: "+err.Error())
+		return nil, false
+	}
+	return body, true
+}
+
+// convert converts a value to another type through JSON, like a Datadog API client model to a JSON object.
+func convert(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+// merge sets the fields of update in item.
+func merge(item, update map[string]interface{}) {
+	for key, value := range update {
+		item[key] = value
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, code int, errs ...string) {
+	writeJSON(w, code, map[string]interface{}{"errors": errs})
+}
+
+// missingFields returns an error message for each field missing from the JSON object.
+func missingFields(item map[string]interface{}, fields ...string) []string {
+	var errs []string
+	for _, field := range fields {
+		if value, found := item[field]; !found || value == nil || value == "" {
+			errs = append(errs, "Missing required field: "+field)
+		}
+	}
+	return errs
+}
+
+func timestamp(now time.Time) string {
+	return now.UTC().Format(time.RFC3339Nano)
+}
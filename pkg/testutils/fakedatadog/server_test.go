@@ -0,0 +1,359 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package fakedatadog
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadogV2 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-operator/pkg/config"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+var testCreds = config.Creds{APIKey: "api-key", AppKey: "app-key"}
+
+func newTestServer(t *testing.T, opts ...Option) *Server {
+	server := NewServer(opts...)
+	t.Cleanup(server.Close)
+	t.Setenv(config.DDURLEnvVar, server.URL)
+	return server
+}
+
+// do sends a request to the server, with the test credentials unless noAuth is set, and returns the response.
+func do(t *testing.T, server *Server, method, path, body string, noAuth bool) *http.Response {
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	if !noAuth {
+		req.Header.Set(apiKeyHeader, testCreds.APIKey)
+		req.Header.Set(appKeyHeader, testCreds.AppKey)
+	}
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp
+}
+
+func TestServer_monitors(t *testing.T) {
+	server := newTestServer(t)
+	client, err := datadogclient.InitDatadogMonitorClient(logr.Discard(), testCreds)
+	require.NoError(t, err)
+
+	monitor := datadogV1.NewMonitor("avg(last_5m):avg:system.cpu.user{*} > 90", datadogV1.MONITORTYPE_METRIC_ALERT)
+	monitor.SetName("CPU")
+
+	_, _, err = client.Client.ValidateMonitor(client.Auth, *monitor)
+	assert.NoError(t, err)
+	_, _, err = client.Client.ValidateMonitor(client.Auth, datadogV1.Monitor{Type: datadogV1.MONITORTYPE_METRIC_ALERT})
+	assert.Error(t, err)
+
+	created, _, err := client.Client.CreateMonitor(client.Auth, *monitor)
+	require.NoError(t, err)
+	assert.NotZero(t, created.GetId())
+	assert.Equal(t, CreatorEmail, created.Creator.GetEmail())
+	assert.Equal(t, datadogV1.MONITOROVERALLSTATES_NO_DATA, created.GetOverallState())
+
+	update := datadogV1.MonitorUpdateRequest{}
+	update.SetName("CPU usage")
+	updated, _, err := client.Client.UpdateMonitor(client.Auth, created.GetId(), update)
+	require.NoError(t, err)
+	assert.Equal(t, "CPU usage", updated.GetName())
+	assert.Equal(t, monitor.Query, updated.GetQuery())
+
+	got, _, err := client.Client.GetMonitor(client.Auth, created.GetId())
+	require.NoError(t, err)
+	assert.Equal(t, "CPU usage", got.GetName())
+	stored, found := server.Monitor(created.GetId())
+	assert.True(t, found)
+	assert.Equal(t, "CPU usage", stored.GetName())
+
+	// Drift made outside of Kubernetes
+	stored.SetName("Changed in Datadog")
+	assert.Equal(t, created.GetId(), server.AddMonitor(stored))
+	got, _, err = client.Client.GetMonitor(client.Auth, created.GetId())
+	require.NoError(t, err)
+	assert.Equal(t, "Changed in Datadog", got.GetName())
+
+	_, _, err = client.Client.ValidateExistingMonitor(client.Auth, created.GetId(), *monitor)
+	assert.NoError(t, err)
+
+	_, _, err = client.Client.DeleteMonitor(client.Auth, created.GetId())
+	require.NoError(t, err)
+	_, resp, err := client.Client.GetMonitor(client.Auth, created.GetId())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	_, found = server.Monitor(created.GetId())
+	assert.False(t, found)
+}
+
+func TestServer_slos(t *testing.T) {
+	server := newTestServer(t)
+	client, err := datadogclient.InitDatadogSLOClient(logr.Discard(), testCreds)
+	require.NoError(t, err)
+
+	slo := datadogV1.NewServiceLevelObjectiveRequest("Availability", []datadogV1.SLOThreshold{
+		{Target: 99, Timeframe: datadogV1.SLOTIMEFRAME_SEVEN_DAYS},
+	}, datadogV1.SLOTYPE_METRIC)
+	slo.SetQuery(*datadogV1.NewServiceLevelObjectiveQuery("sum:requests.bad{*}", "sum:requests.total{*}"))
+
+	created, _, err := client.Client.CreateSLO(client.Auth, *slo)
+	require.NoError(t, err)
+	require.Len(t, created.Data, 1)
+	id := created.Data[0].GetId()
+	assert.NotEmpty(t, id)
+
+	got, _, err := client.Client.GetSLO(client.Auth, id)
+	require.NoError(t, err)
+	assert.Equal(t, "Availability", got.Data.GetName())
+
+	update := datadogV1.ServiceLevelObjective{Name: "Availability of the API", Thresholds: slo.Thresholds, Type: slo.Type}
+	updated, _, err := client.Client.UpdateSLO(client.Auth, id, update)
+	require.NoError(t, err)
+	require.Len(t, updated.Data, 1)
+	assert.Equal(t, "Availability of the API", updated.Data[0].GetName())
+
+	server.SetSLIValue(id, 99.5)
+	history, _, err := client.Client.GetSLOHistory(client.Auth, id, 0, time.Now().Unix())
+	require.NoError(t, err)
+	assert.Equal(t, 99.5, history.Data.Overall.GetSliValue())
+	assert.InDelta(t, 50, history.Data.Overall.GetErrorBudgetRemaining()["7d"], 0.001)
+
+	_, _, err = client.Client.DeleteSLO(client.Auth, id)
+	require.NoError(t, err)
+	_, found := server.SLO(id)
+	assert.False(t, found)
+	_, _, err = client.Client.GetSLO(client.Auth, id)
+	assert.Error(t, err)
+}
+
+func TestServer_dashboards(t *testing.T) {
+	server := newTestServer(t)
+	client, err := datadogclient.InitDatadogDashboardClient(logr.Discard(), testCreds)
+	require.NoError(t, err)
+
+	dashboard := datadogV1.NewDashboard(datadogV1.DASHBOARDLAYOUTTYPE_ORDERED, "Overview", []datadogV1.Widget{
+		*datadogV1.NewWidget(datadogV1.NoteWidgetDefinitionAsWidgetDefinition(
+			datadogV1.NewNoteWidgetDefinition("Hello", datadogV1.NOTEWIDGETDEFINITIONTYPE_NOTE))),
+	})
+
+	created, _, err := client.Client.CreateDashboard(client.Auth, *dashboard)
+	require.NoError(t, err)
+	assert.Regexp(t, "^[a-z]{3}-[a-z]{3}-[a-z]{3}$", created.GetId())
+	assert.Equal(t, CreatorHandle, created.GetAuthorHandle())
+	require.Len(t, created.Widgets, 1)
+
+	dashboard.SetTitle("Service overview")
+	updated, _, err := client.Client.UpdateDashboard(client.Auth, created.GetId(), *dashboard)
+	require.NoError(t, err)
+	assert.Equal(t, "Service overview", updated.GetTitle())
+
+	list, _, err := client.Client.ListDashboards(client.Auth)
+	require.NoError(t, err)
+	require.Len(t, list.Dashboards, 1)
+	assert.Equal(t, "Service overview", list.Dashboards[0].GetTitle())
+
+	_, _, err = client.Client.DeleteDashboard(client.Auth, created.GetId())
+	require.NoError(t, err)
+	_, found := server.Dashboard(created.GetId())
+	assert.False(t, found)
+}
+
+func TestServer_downtimes(t *testing.T) {
+	server := newTestServer(t)
+	client, err := datadogclient.InitDatadogDowntimeClient(logr.Discard(), testCreds)
+	require.NoError(t, err)
+
+	downtime := datadogV2.DowntimeCreateRequest{
+		Data: datadogV2.DowntimeCreateRequestData{
+			Type: datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME,
+			Attributes: datadogV2.DowntimeCreateRequestAttributes{
+				Scope: "env:prod",
+				MonitorIdentifier: datadogV2.DowntimeMonitorIdentifierTagsAsDowntimeMonitorIdentifier(
+					datadogV2.NewDowntimeMonitorIdentifierTags([]string{"team:foo"})),
+			},
+		},
+	}
+
+	created, _, err := client.Client.CreateDowntime(client.Auth, downtime)
+	require.NoError(t, err)
+	id := created.Data.GetId()
+	assert.NotEmpty(t, id)
+	assert.Equal(t, datadogV2.DOWNTIMESTATUS_ACTIVE, created.Data.Attributes.GetStatus())
+
+	update := datadogV2.DowntimeUpdateRequest{
+		Data: datadogV2.DowntimeUpdateRequestData{
+			Id:         id,
+			Type:       datadogV2.DOWNTIMERESOURCETYPE_DOWNTIME,
+			Attributes: datadogV2.DowntimeUpdateRequestAttributes{Scope: datadogapi.PtrString("env:staging")},
+		},
+	}
+	updated, _, err := client.Client.UpdateDowntime(client.Auth, id, update)
+	require.NoError(t, err)
+	assert.Equal(t, "env:staging", updated.Data.Attributes.GetScope())
+
+	_, err = client.Client.CancelDowntime(client.Auth, id)
+	require.NoError(t, err)
+	canceled, found := server.Downtime(id)
+	require.True(t, found)
+	assert.Equal(t, datadogV2.DOWNTIMESTATUS_CANCELED, canceled.Attributes.GetStatus())
+	assert.True(t, canceled.Attributes.Canceled.IsSet())
+
+	list, _, err := client.Client.ListDowntimes(client.Auth)
+	require.NoError(t, err)
+	assert.Len(t, list.Data, 1)
+	list, _, err = client.Client.ListDowntimes(client.Auth, *datadogV2.NewListDowntimesOptionalParameters().WithCurrentOnly(true))
+	require.NoError(t, err)
+	assert.Empty(t, list.Data)
+}
+
+func TestServer_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		setup    func(*testing.T, *Server)
+		noAuth   bool
+		requests int
+		wantCode int
+	}{
+		{
+			name:     "ok",
+			requests: 1,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "missing keys",
+			noAuth:   true,
+			requests: 1,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "wrong keys",
+			opts:     []Option{WithCredentials("other", "keys")},
+			requests: 1,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name: "failing requests",
+			setup: func(_ *testing.T, s *Server) {
+				s.FailRequests(http.MethodGet, "/api/v1/monitor", http.StatusInternalServerError, 2)
+			},
+			requests: 2,
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name: "failed requests count",
+			setup: func(_ *testing.T, s *Server) {
+				s.FailRequests(http.MethodGet, "/api/v1/monitor", http.StatusInternalServerError, 2)
+			},
+			requests: 3,
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "other requests",
+			setup: func(_ *testing.T, s *Server) {
+				s.FailRequests(http.MethodPost, "/api/v1/monitor", http.StatusInternalServerError, 0)
+			},
+			requests: 1,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "cleared failures",
+			setup:    func(_ *testing.T, s *Server) { s.FailRequests("", "/api", http.StatusBadGateway, 0); s.ClearFailures() },
+			requests: 1,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "within the rate limit",
+			opts:     []Option{WithRateLimit(2, time.Minute)},
+			requests: 2,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "rate limited",
+			opts:     []Option{WithRateLimit(2, time.Minute)},
+			requests: 3,
+			wantCode: http.StatusTooManyRequests,
+		},
+		{
+			name: "rate limited by endpoint",
+			opts: []Option{WithRateLimit(1, time.Minute)},
+			setup: func(t *testing.T, s *Server) {
+				do(t, s, http.MethodGet, "/api/v1/slo", "", false)
+			},
+			requests: 1,
+			wantCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, tt.opts...)
+			if tt.setup != nil {
+				tt.setup(t, server)
+			}
+
+			var resp *http.Response
+			for i := 0; i < tt.requests; i++ {
+				resp = do(t, server, http.MethodGet, "/api/v1/monitor", "", tt.noAuth)
+			}
+			assert.Equal(t, tt.wantCode, resp.StatusCode)
+			assert.Equal(t, tt.requests, server.RequestCount(http.MethodGet, "/api/v1/monitor"))
+		})
+	}
+}
+
+func TestServer_rateLimitHeaders(t *testing.T) {
+	server := newTestServer(t, WithRateLimit(1, time.Minute))
+
+	resp := do(t, server, http.MethodGet, "/api/v1/monitor", "", false)
+	assert.Equal(t, "1", resp.Header.Get("X-RateLimit-Limit"))
+	assert.Equal(t, "60", resp.Header.Get("X-RateLimit-Period"))
+	assert.Equal(t, "0", resp.Header.Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "60", resp.Header.Get("X-RateLimit-Reset"))
+
+	resp = do(t, server, http.MethodGet, "/api/v1/monitor/1", "", false)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
+
+func TestServer_latency(t *testing.T) {
+	server := newTestServer(t, WithLatency(50*time.Millisecond))
+
+	start := time.Now()
+	resp := do(t, server, http.MethodGet, "/api/v1/monitor", "", false)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func Test_endpointName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/api/v1/monitor", want: "v1/monitor"},
+		{path: "/api/v1/monitor/123/validate", want: "v1/monitor"},
+		{path: "/api/v2/downtime/abc", want: "v2/downtime"},
+		{path: "/other", want: "other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, endpointName(tt.path))
+		})
+	}
+}
+
+func Test_dashboardID(t *testing.T) {
+	assert.Equal(t, "aaa-aaa-aab", dashboardID(1))
+	assert.Equal(t, "aaa-aaa-aba", dashboardID(26))
+}
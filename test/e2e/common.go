@@ -55,6 +55,9 @@ alias kx="kubectl exec"
 	defaultMgrImageName        = "gcr.io/datadoghq/operator"
 	defaultMgrImgTag           = "latest"
 	defaultMgrFileName         = "e2e-manager.yaml"
+	fakeDatadogAPIFileName     = "fake-datadog-api.yaml"
+	fakeDatadogAPIPatchName    = "fake-datadog-api-patch.yaml"
+	fakeDatadogAPIImageName    = "fake-datadog-api"
 	nodeAgentSelector          = "agent.datadoghq.com/component=agent"
 	clusterAgentSelector       = "agent.datadoghq.com/component=cluster-agent"
 	clusterCheckRunnerSelector = "agent.datadoghq.com/component=cluster-checks-runner"
@@ -64,6 +67,9 @@ var (
 	namespaceName   = "system"
 	k8sVersion      = getEnv("K8S_VERSION", "1.26")
 	imgPullPassword = getEnv("IMAGE_PULL_PASSWORD", "")
+	// fakeDatadogAPIImg is the image of the fake Datadog API. When set, the manager runs the Datadog API resource
+	// controllers against it instead of Datadog.
+	fakeDatadogAPIImg = getEnv("FAKE_DATADOG_API_IMG", "")
 
 	kubeConfigPath string
 	kubectlOptions *k8s.KubectlOptions
@@ -172,6 +178,10 @@ func updateKustomization(kustomizeDirPath string, kustomizeResourcePaths []strin
 		return err
 	}
 
+	if fakeDatadogAPIImg != "" {
+		kustomizeResourcePaths = append(kustomizeResourcePaths, fakeDatadogAPIFileName)
+	}
+
 	// Update resources with target e2e-manager resource yaml
 	for _, res := range kustomizeResourcePaths {
 		exists := false
@@ -200,6 +210,33 @@ func updateKustomization(kustomizeDirPath string, kustomizeResourcePaths []strin
 		}
 	}
 
+	// Run the controllers against the fake Datadog API
+	if fakeDatadogAPIImg != "" {
+		exists := false
+		for _, p := range k.Patches {
+			if p.Path == fakeDatadogAPIPatchName {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			k.Patches = append(k.Patches, types.Patch{Path: fakeDatadogAPIPatchName})
+		}
+
+		fakeImgName, fakeImgTag := common.SplitImageString(fakeDatadogAPIImg)
+		exists = false
+		for i, img := range k.Images {
+			if img.Name == fakeDatadogAPIImageName {
+				k.Images[i].NewName = fakeImgName
+				k.Images[i].NewTag = fakeImgTag
+				exists = true
+			}
+		}
+		if !exists {
+			k.Images = append(k.Images, types.Image{Name: fakeDatadogAPIImageName, NewName: fakeImgName, NewTag: fakeImgTag})
+		}
+	}
+
 	if err := saveKustomization(kustomizationFilePath, k); err != nil {
 		return err
 	}